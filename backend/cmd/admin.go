@@ -0,0 +1,308 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/leebenson/conform"
+)
+
+// createUser registers a user the same way the register endpoint does.
+func createUser(a *app, args []string) error {
+	flags := flag.NewFlagSet("create-user", flag.ContinueOnError)
+	name := flags.String("name", "", "name of the user")
+	email := flags.String("email", "", "email address of the user")
+	password := flags.String("password", "", "password, read from stdin when empty")
+	role := flags.String("role", string(domain.RoleUser), "user or admin")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if err := validate(a, domain.UserRequest{Role: *role}); err != nil {
+		return err
+	}
+
+	if *password == "" {
+		var err error
+		if *password, err = readPassword(); err != nil {
+			return err
+		}
+	}
+
+	req := domain.AuthRegisterRequest{
+		Name:     *name,
+		Email:    *email,
+		Password: *password,
+	}
+	if err := conform.Strings(&req); err != nil {
+		return err
+	}
+	if err := validate(a, req); err != nil {
+		return err
+	}
+
+	user, err := a.authService.Register(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	if domain.Role(*role) != domain.RoleUser {
+		if user, err = a.updateUser(user, domain.UserRequest{Role: *role}); err != nil {
+			return err
+		}
+	}
+
+	slog.Info("created user", "id", user.ID, "name", user.Name, "email", user.Email, "role", *role)
+	return nil
+}
+
+// resetPassword sets a new password and ends the sessions started with the
+// old one.
+func resetPassword(a *app, args []string) error {
+	flags := flag.NewFlagSet("reset-password", flag.ContinueOnError)
+	ref := flags.String("user", "", "id or email address of the user")
+	password := flags.String("password", "", "new password, read from stdin when empty")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	user, err := a.lookupUser(*ref)
+	if err != nil {
+		return err
+	}
+
+	if *password == "" {
+		if *password, err = readPassword(); err != nil {
+			return err
+		}
+	}
+
+	req := domain.UserRequest{Password: *password}
+	if err := validate(a, req); err != nil {
+		return err
+	}
+
+	if _, err := a.updateUser(user, req); err != nil {
+		return err
+	}
+	if err := a.revoke(user); err != nil {
+		return err
+	}
+
+	slog.Info("reset password", "id", user.ID, "name", user.Name)
+	return nil
+}
+
+func setRole(a *app, args []string) error {
+	flags := flag.NewFlagSet("set-role", flag.ContinueOnError)
+	ref := flags.String("user", "", "id or email address of the user")
+	role := flags.String("role", "", "user or admin")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	req := domain.UserRequest{Role: *role}
+	if *role == "" {
+		return errors.New("role is required")
+	}
+	if err := validate(a, req); err != nil {
+		return err
+	}
+
+	user, err := a.lookupUser(*ref)
+	if err != nil {
+		return err
+	}
+
+	if _, err := a.updateUser(user, req); err != nil {
+		return err
+	}
+
+	slog.Info("set role", "id", user.ID, "name", user.Name, "role", *role)
+	return nil
+}
+
+// revokeSessions drops the refresh token of a user. Access tokens already
+// handed out stay valid until they expire.
+func revokeSessions(a *app, args []string) error {
+	flags := flag.NewFlagSet("revoke-sessions", flag.ContinueOnError)
+	ref := flags.String("user", "", "id or email address of the user")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	user, err := a.lookupUser(*ref)
+	if err != nil {
+		return err
+	}
+
+	if err := a.revoke(user); err != nil {
+		return err
+	}
+
+	slog.Info("revoked sessions", "id", user.ID, "name", user.Name)
+	return nil
+}
+
+// purgeTrash does what a run of the trash worker does.
+func purgeTrash(a *app, args []string) error {
+	if len(args) > 0 {
+		return errors.New("usage: main purge-trash")
+	}
+
+	notes, err := a.noteService.Purge(context.Background(), a.cfg.Trash.NoteRetention)
+	if err != nil {
+		return err
+	}
+	slog.Info("purged trashed notes", "count", notes)
+
+	users, err := a.userService.Purge(context.Background(), a.cfg.Trash.UserGracePeriod)
+	if err != nil {
+		return err
+	}
+	slog.Info("purged deleted users", "count", users)
+
+	attachments, err := a.attachmentService.Purge(context.Background())
+	if err != nil {
+		return err
+	}
+	slog.Info("purged orphaned attachments", "count", attachments)
+
+	return nil
+}
+
+// exportUser writes the notes of a user in the format of the export endpoint,
+// with the profile of the user next to them in user.json.
+func exportUser(a *app, args []string) error {
+	flags := flag.NewFlagSet("export-user", flag.ContinueOnError)
+	ref := flags.String("user", "", "id or email address of the user")
+	output := flags.String("output", "", "file to write, <name>.zip when empty")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	user, err := a.lookupUser(*ref)
+	if err != nil {
+		return err
+	}
+
+	if *output == "" {
+		*output = user.Name + ".zip"
+	}
+
+	file, err := os.OpenFile(*output, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	archive := util.NewNoteArchive(file)
+
+	profile, err := json.MarshalIndent(struct {
+		ID        uint        `json:"id"`
+		Name      string      `json:"name"`
+		Email     string      `json:"email"`
+		Bio       string      `json:"bio,omitempty"`
+		AvatarURL string      `json:"avatar_url,omitempty"`
+		Role      domain.Role `json:"role"`
+		CreatedAt time.Time   `json:"created_at"`
+		UpdatedAt time.Time   `json:"updated_at"`
+	}{user.ID, user.Name, user.Email, user.Bio, user.AvatarURL, user.Role, user.CreatedAt, user.UpdatedAt}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := archive.AddFile("user.json", user.UpdatedAt, profile); err != nil {
+		return err
+	}
+
+	var count int
+	if err := a.noteService.Export(context.Background(), domain.Claims{UserID: user.ID}, func(notes []domain.Note) error {
+		for _, note := range notes {
+			if err := archive.Add(note); err != nil {
+				return err
+			}
+		}
+		count += len(notes)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := archive.Close(); err != nil {
+		return err
+	}
+
+	slog.Info("exported user", "id", user.ID, "name", user.Name, "notes", count, "file", *output)
+	return file.Close()
+}
+
+// lookupUser finds a user by id, or by email address when ref is not a number.
+func (a *app) lookupUser(ref string) (*domain.User, error) {
+	if ref == "" {
+		return nil, errors.New("user is required")
+	}
+
+	if id, err := strconv.ParseUint(ref, 10, 32); err == nil {
+		return a.userService.GetByID(context.Background(), uint(id))
+	}
+
+	return a.authRepository.GetByEmail(context.Background(), ref)
+}
+
+// updateUser changes a user through the user service as if the user did it
+// themselves. The profile fields are carried over because an update replaces
+// them.
+func (a *app) updateUser(user *domain.User, req domain.UserRequest) (*domain.User, error) {
+	req.ID = user.ID
+	req.Bio = user.Bio
+	req.AvatarURL = user.AvatarURL
+
+	return a.userService.Update(context.Background(), req, domain.Claims{UserID: user.ID})
+}
+
+func (a *app) revoke(user *domain.User) error {
+	err := a.authService.Logout(context.Background(), user.ID)
+
+	var e *fiber.Error
+	if errors.As(err, &e) && e.Code == fiber.StatusNotFound {
+		// not logged in anywhere
+		return nil
+	}
+	return err
+}
+
+func validate(a *app, data interface{}) error {
+	res := a.validator.Validate(data)
+	if res == nil {
+		return nil
+	}
+
+	var messages []string
+	for _, e := range res.Error.([]domain.ValidationError) {
+		messages = append(messages, e.Error)
+	}
+	return errors.New(strings.Join(messages, ", "))
+}
+
+func readPassword() (string, error) {
+	fmt.Fprint(os.Stderr, "password: ")
+
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		return "", fmt.Errorf("failed to read password: %w", err)
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
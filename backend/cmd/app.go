@@ -0,0 +1,102 @@
+package main
+
+import (
+	"github.com/shironxn/blanknotes/internal/adapter/importer"
+	"github.com/shironxn/blanknotes/internal/adapter/metrics"
+	"github.com/shironxn/blanknotes/internal/adapter/repository"
+	"github.com/shironxn/blanknotes/internal/adapter/storage"
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/core/service"
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"gorm.io/gorm"
+)
+
+// app holds what every command shares, the services and the repositories
+// behind them. Nothing is started until a command asks for it.
+type app struct {
+	cfg *config.Config
+	db  *gorm.DB
+
+	validator  *util.Validator
+	bcrypt     util.Bcrypt
+	jwt        util.JWT
+	pagination util.Pagination
+	images     util.Images
+	markdown   *util.Markdown
+	importers  []port.Importer
+	metrics    *metrics.Metrics
+
+	userRepository       port.UserRepository
+	authRepository       port.AuthRepository
+	noteRepository       port.NoteRepository
+	noteShareRepository  port.NoteShareRepository
+	shareLinkRepository  port.ShareLinkRepository
+	uploadRepository     port.UploadRepository
+	attachmentRepository port.AttachmentRepository
+
+	userService       port.UserService
+	authService       port.AuthService
+	eventService      port.EventService
+	noteService       port.NoteService
+	noteShareService  port.NoteShareService
+	shareLinkService  port.ShareLinkService
+	uploadService     port.UploadService
+	attachmentService port.AttachmentService
+	collabService     port.CollabService
+}
+
+func newApp(cfg *config.Config, db *gorm.DB) (*app, error) {
+	fileStorage, err := storage.NewStorage(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := util.NewValidator()
+	if err != nil {
+		return nil, err
+	}
+
+	appMetrics, err := metrics.NewMetrics(db)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &app{
+		cfg:        cfg,
+		db:         db,
+		validator:  validator,
+		bcrypt:     util.NewBcrypt(),
+		jwt:        util.NewJWT(cfg),
+		pagination: util.NewPagination(validator, cfg),
+		markdown:   util.NewMarkdown(cfg.Markdown.CacheSize),
+		importers: []port.Importer{
+			importer.NewENEXImporter(cfg),
+			importer.NewKeepImporter(cfg),
+			importer.NewMarkdownImporter(cfg),
+		},
+		metrics: appMetrics,
+	}
+
+	a.userRepository = repository.NewUserRepository(db, a.pagination)
+	a.authRepository = repository.NewAuthRepository(db)
+	a.noteRepository = repository.NewNoteRepository(db, a.pagination)
+	a.noteShareRepository = repository.NewNoteShareRepository(db, a.pagination)
+	a.shareLinkRepository = repository.NewShareLinkRepository(db)
+	a.uploadRepository = repository.NewUploadRepository(db)
+	a.attachmentRepository = repository.NewAttachmentRepository(db)
+	a.images = util.NewImages(cfg, a.uploadRepository)
+
+	a.userService = service.NewUserService(a.userRepository, fileStorage, a.bcrypt)
+	a.authService = service.NewAuthService(a.authRepository, a.bcrypt, a.jwt, a.metrics, cfg)
+	a.eventService = service.NewEventService(cfg)
+	a.noteService = service.NewNoteService(a.noteRepository, a.noteShareRepository, fileStorage, a.eventService, a.metrics, a.markdown)
+	a.noteShareService = service.NewNoteShareService(a.noteShareRepository, a.noteRepository, a.userRepository)
+	a.shareLinkService = service.NewShareLinkService(a.shareLinkRepository, a.noteRepository, a.bcrypt)
+	a.uploadService = service.NewUploadService(a.uploadRepository, fileStorage, cfg)
+	a.attachmentService = service.NewAttachmentService(a.attachmentRepository, a.noteService, fileStorage, cfg)
+	a.collabService = service.NewCollabService(a.noteService, a.userRepository, cfg)
+
+	return a, nil
+}
@@ -1,71 +1,101 @@
 package main
 
 import (
-	"github.com/shironxn/blanknotes/internal/adapter/http/handler"
-	"github.com/shironxn/blanknotes/internal/adapter/http/middleware"
-	"github.com/shironxn/blanknotes/internal/adapter/http/route"
-	"github.com/shironxn/blanknotes/internal/adapter/repository"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"sort"
+
+	"github.com/shironxn/blanknotes/internal/adapter/logging"
 	"github.com/shironxn/blanknotes/internal/config"
-	"github.com/shironxn/blanknotes/internal/core/domain"
-	"github.com/shironxn/blanknotes/internal/core/service"
-	"github.com/shironxn/blanknotes/internal/util"
 
 	_ "github.com/shironxn/blanknotes/docs"
-
-	"github.com/charmbracelet/log"
 )
 
+type command struct {
+	run   func(a *app, args []string) error
+	usage string
+}
+
+var commands = map[string]command{
+	"serve":           {serve, "start the HTTP server, the default"},
+	"migrate":         {migrate, "apply, roll back or list database migrations"},
+	"create-user":     {createUser, "create a user"},
+	"reset-password":  {resetPassword, "set a new password for a user"},
+	"set-role":        {setRole, "make a user an admin, who can edit and delete any user, or a regular user"},
+	"revoke-sessions": {revokeSessions, "log a user out everywhere"},
+	"purge-trash":     {purgeTrash, "delete expired trash right away"},
+	"export-user":     {exportUser, "write the profile and notes of a user to a zip"},
+}
+
 // @title gocrud
 // @version 1.0
 // @description golang crud api
 // @BasePath /api/v1
 func main() {
-	cfg, err := config.NewConfig()
+	// config flags come before the command, its own flags after it
+	cfg, args, err := config.Load(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		usage()
+		os.Exit(2)
+	}
 	if err != nil {
-		log.Fatal(err)
+		fatal(err)
+	}
+	slog.SetDefault(logging.NewLogger(os.Stdout, cfg))
+
+	name := "serve"
+	if len(args) > 0 {
+		name, args = args[0], args[1:]
+	}
+
+	cmd, ok := commands[name]
+	if !ok {
+		usage()
+		os.Exit(2)
 	}
 
 	db, err := config.NewGorm(cfg).Connection()
 	if err != nil {
-		log.Fatal(err)
+		fatal(err)
 	}
-	db.AutoMigrate(&domain.User{}, &domain.Note{}, &domain.RefreshToken{})
+	db.Logger = logging.NewGormLogger()
 
-	validator, err := util.NewValidator()
+	a, err := newApp(cfg, db)
 	if err != nil {
-		log.Fatal(err)
+		fatal(err)
 	}
 
-	app := config.NewFiber()
-	bcrypt := util.NewBcrypt()
-	jwt := util.NewJWT(cfg)
-	pagination := util.NewPagination(validator)
-
-	userRepository := repository.NewUserRepository(db, pagination)
-	userService := service.NewUserService(userRepository, bcrypt)
-	userHandler := handler.NewUserHandler(userService, validator, jwt)
-
-	authRepository := repository.NewAuthRepository(db)
-	authService := service.NewAuthService(authRepository, bcrypt, jwt, cfg)
-	authHandler := handler.NewAuthHandler(authService, jwt, validator, cfg)
+	err = cmd.run(a, args)
 
-	noteRepository := repository.NewNoteRepository(db, pagination)
-	noteService := service.NewNoteService(noteRepository)
-	noteHandler := handler.NewNoteHandler(noteService, validator, jwt, cfg)
+	if pool, poolErr := db.DB(); poolErr == nil {
+		if closeErr := pool.Close(); closeErr != nil {
+			slog.Error("failed to close the database", "err", closeErr)
+		}
+	}
 
-	authMiddleware := middleware.NewAuthMiddleware(authService, jwt, cfg)
+	if err != nil {
+		fatal(err)
+	}
+}
 
-	initRoute := route.NewInitRoute(cfg)
-	authRoute := route.NewAuthRoute(authHandler, authMiddleware)
-	userRoute := route.NewUserRoute(userHandler, authMiddleware)
-	noteRoute := route.NewNoteRoute(noteHandler, authMiddleware)
+func fatal(err error) {
+	slog.Error(err.Error())
+	os.Exit(1)
+}
 
-	initRoute.Route(app)
-	authRoute.Route(app)
-	userRoute.Route(app)
-	noteRoute.Route(app)
+func usage() {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
 
-	if err = app.Listen(cfg.Server.Host + ":" + cfg.Server.Port); err != nil {
-		log.Fatal(err)
+	fmt.Fprintln(os.Stderr, "usage: main [config flags] <command> [flags]")
+	fmt.Fprintln(os.Stderr, "\ncommands:")
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-16s %s\n", name, commands[name].usage)
 	}
 }
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/adapter/migration"
+)
+
+const migrateUsage = "usage: main migrate up | down [steps] | status"
+
+// migrate applies, rolls back or lists migrations of the database.
+func migrate(a *app, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	migrator, err := migration.NewMigrator(a.db)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up()
+		for _, m := range applied {
+			slog.Info("applied migration", "version", m.Version, "name", m.Name)
+		}
+		if err == nil && len(applied) == 0 {
+			slog.Info("database schema is up to date")
+		}
+		return err
+
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				return errors.New("steps must be a positive number")
+			}
+		}
+
+		reverted, err := migrator.Down(steps)
+		for _, m := range reverted {
+			slog.Info("reverted migration", "version", m.Version, "name", m.Name)
+		}
+		return err
+
+	case "status":
+		status, err := migrator.Status()
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, s := range status {
+			applied := "pending"
+			if s.AppliedAt != nil {
+				applied = s.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\n", s.Version, s.Name, applied)
+		}
+		return w.Flush()
+
+	default:
+		return errors.New(migrateUsage)
+	}
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/adapter/health"
+	"github.com/shironxn/blanknotes/internal/adapter/http/handler"
+	"github.com/shironxn/blanknotes/internal/adapter/http/middleware"
+	"github.com/shironxn/blanknotes/internal/adapter/http/route"
+	"github.com/shironxn/blanknotes/internal/adapter/logging"
+	"github.com/shironxn/blanknotes/internal/adapter/tracing"
+	"github.com/shironxn/blanknotes/internal/adapter/worker"
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// serve starts the workers and the HTTP server and runs until SIGINT or
+// SIGTERM. On a signal the server stops accepting connections and waits for
+// in-flight requests, then the workers are stopped in reverse order so the
+// collab documents are saved while the database is still open.
+func serve(a *app, args []string) error {
+	if len(args) > 0 {
+		return errors.New("usage: main serve")
+	}
+
+	if a.cfg.Database.Migrate {
+		if err := migrate(a, []string{"up"}); err != nil {
+			return err
+		}
+	}
+
+	shutdownTracing, err := tracing.NewProvider(a.cfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// spans still buffered are sent before exiting
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			slog.Error("failed to flush traces", "err", err)
+		}
+	}()
+	if err := tracing.Instrument(a.db); err != nil {
+		return err
+	}
+
+	server := config.NewFiber()
+
+	userHandler := handler.NewUserHandler(a.userService, a.validator, a.jwt, a.images)
+	authHandler := handler.NewAuthHandler(a.authService, a.jwt, a.validator, a.cfg)
+	eventHandler := handler.NewEventHandler(a.eventService, a.images, a.cfg)
+	noteHandler := handler.NewNoteHandler(a.noteService, a.attachmentService, a.validator, a.jwt, a.images, a.cfg, a.importers)
+	noteShareHandler := handler.NewNoteShareHandler(a.noteShareService, a.validator, a.images)
+	shareLinkHandler := handler.NewShareLinkHandler(a.shareLinkService, a.validator, a.images)
+	uploadHandler := handler.NewUploadHandler(a.uploadService, a.images)
+	attachmentHandler := handler.NewAttachmentHandler(a.attachmentService, a.jwt, a.cfg)
+	collabHandler := handler.NewCollabHandler(a.noteService, a.collabService, a.cfg)
+
+	// ready turns off first on shutdown so no new requests are routed here
+	ready := health.NewSwitch("workers are not running")
+	healthHandler := handler.NewHealthHandler(map[string]port.HealthCheck{
+		"database":   health.NewDatabaseCheck(a.db),
+		"migrations": health.NewMigrationCheck(a.db),
+		"workers":    ready,
+	}, a.cfg)
+
+	workers := []port.Worker{
+		a.collabService,
+		worker.NewTrashWorker(a.noteService, a.userService, a.attachmentService, a.cfg),
+		worker.NewImageWorker(a.uploadService, a.cfg),
+	}
+	for _, w := range workers {
+		w.Start()
+	}
+	ready.Set(true)
+	defer func() {
+		for i := len(workers) - 1; i >= 0; i-- {
+			workers[i].Stop()
+		}
+	}()
+
+	authMiddleware := middleware.NewAuthMiddleware(a.authService, a.jwt, a.cfg)
+
+	healthRoute := route.NewHealthRoute(healthHandler)
+	initRoute := route.NewInitRoute(a.cfg)
+	authRoute := route.NewAuthRoute(authHandler, authMiddleware)
+	userRoute := route.NewUserRoute(userHandler, authMiddleware)
+	noteRoute := route.NewNoteRoute(noteHandler, authMiddleware)
+	noteShareRoute := route.NewNoteShareRoute(noteShareHandler, authMiddleware)
+	shareLinkRoute := route.NewShareLinkRoute(shareLinkHandler, authMiddleware)
+	collabRoute := route.NewCollabRoute(collabHandler, authMiddleware)
+	eventRoute := route.NewEventRoute(eventHandler, authMiddleware)
+	uploadRoute := route.NewUploadRoute(uploadHandler, authMiddleware)
+	attachmentRoute := route.NewAttachmentRoute(attachmentHandler, authMiddleware)
+
+	// measures, traces and logs every request, the probes and those rejected
+	// by the middlewares of the init route included
+	server.Use(a.metrics.Middleware())
+	server.Use(tracing.Middleware())
+	server.Use(middleware.RequestID())
+	server.Use(logging.Middleware(slog.Default()))
+	server.Use(middleware.Timeout(a.cfg.Server.RequestTimeout))
+
+	healthRoute.Route(server)
+	initRoute.Route(server)
+	authRoute.Route(server)
+	userRoute.Route(server)
+	noteShareRoute.Route(server)
+	eventRoute.Route(server)
+	noteRoute.Route(server)
+	shareLinkRoute.Route(server)
+	collabRoute.Route(server)
+	uploadRoute.Route(server)
+	attachmentRoute.Route(server)
+
+	metricsServer := server
+	if a.cfg.Server.MetricsPort != 0 {
+		metricsServer = fiber.New(fiber.Config{DisableStartupMessage: true})
+	}
+	metricsServer.Get("/metrics", a.metrics.Handler())
+
+	if metricsServer != server {
+		// listen right away so a port in use fails the start
+		ln, err := net.Listen("tcp", net.JoinHostPort(a.cfg.Server.Host, strconv.Itoa(a.cfg.Server.MetricsPort)))
+		if err != nil {
+			return err
+		}
+		go func() {
+			if err := metricsServer.Listener(ln); err != nil {
+				slog.Error("metrics listener stopped", "err", err)
+			}
+		}()
+		defer metricsServer.Shutdown()
+		slog.Info("serving metrics", "addr", ln.Addr())
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	addr := net.JoinHostPort(a.cfg.Server.Host, strconv.Itoa(a.cfg.Server.Port))
+	listen := make(chan error, 1)
+	go func() {
+		listen <- server.Listen(addr)
+	}()
+	slog.Info("serving", "addr", addr)
+
+	select {
+	case err := <-listen:
+		return err
+	case <-ctx.Done():
+	}
+	// a second signal kills the process right away
+	stop()
+	ready.Set(false)
+
+	slog.Info("shutting down", "timeout", a.cfg.Server.ShutdownTimeout.String())
+	if err := server.ShutdownWithTimeout(a.cfg.Server.ShutdownTimeout); err != nil {
+		// open websockets and event streams are cut off, that is fine
+		slog.Warn("requests did not finish in time", "err", err)
+	}
+
+	return <-listen
+}
@@ -0,0 +1,85 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/shironxn/blanknotes/internal/adapter/migration"
+	"github.com/shironxn/blanknotes/internal/core/port"
+
+	"gorm.io/gorm"
+)
+
+type DatabaseCheck struct {
+	db *gorm.DB
+}
+
+// NewDatabaseCheck pings the database.
+func NewDatabaseCheck(db *gorm.DB) port.HealthCheck {
+	return &DatabaseCheck{
+		db: db,
+	}
+}
+
+func (c *DatabaseCheck) Check(ctx context.Context) error {
+	pool, err := c.db.DB()
+	if err != nil {
+		return err
+	}
+	return pool.PingContext(ctx)
+}
+
+type MigrationCheck struct {
+	db *gorm.DB
+}
+
+// NewMigrationCheck fails while the database is behind the migrations of
+// this version, when another replica is still migrating for example.
+func NewMigrationCheck(db *gorm.DB) port.HealthCheck {
+	return &MigrationCheck{
+		db: db,
+	}
+}
+
+func (c *MigrationCheck) Check(ctx context.Context) error {
+	migrator, err := migration.NewMigrator(c.db.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	pending, err := migrator.Pending()
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%d migrations pending, the first is %d_%s", len(pending), pending[0].Version, pending[0].Name)
+	}
+
+	return nil
+}
+
+// Switch fails until it is turned on. serve turns it on once the workers run
+// and off again when it shuts down, so no new requests are routed to it.
+type Switch struct {
+	on     atomic.Bool
+	reason string
+}
+
+func NewSwitch(reason string) *Switch {
+	return &Switch{
+		reason: reason,
+	}
+}
+
+func (s *Switch) Set(on bool) {
+	s.on.Store(on)
+}
+
+func (s *Switch) Check(ctx context.Context) error {
+	if !s.on.Load() {
+		return errors.New(s.reason)
+	}
+	return nil
+}
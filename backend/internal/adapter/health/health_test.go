@@ -0,0 +1,45 @@
+package health
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/shironxn/blanknotes/internal/adapter/migration"
+	"github.com/shironxn/blanknotes/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm/logger"
+)
+
+func TestChecks(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Database.Driver = "sqlite"
+	cfg.Database.Name = filepath.Join(t.TempDir(), "test.db")
+
+	db, err := config.NewGorm(cfg).Connection()
+	require.NoError(t, err)
+	db.Logger = logger.Discard
+
+	ctx := context.Background()
+	assert.NoError(t, NewDatabaseCheck(db).Check(ctx))
+
+	migrations := NewMigrationCheck(db)
+	assert.Error(t, migrations.Check(ctx), "schema_migrations does not exist yet")
+
+	migrator, err := migration.NewMigrator(db)
+	require.NoError(t, err)
+	_, err = migrator.Up()
+	require.NoError(t, err)
+	assert.NoError(t, migrations.Check(ctx))
+
+	_, err = migrator.Down(1)
+	require.NoError(t, err)
+	assert.EqualError(t, migrations.Check(ctx), "1 migrations pending, the first is 4_note_tags")
+
+	s := NewSwitch("workers are not running")
+	assert.EqualError(t, s.Check(ctx), "workers are not running")
+	s.Set(true)
+	assert.NoError(t, s.Check(ctx))
+}
@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"fmt"
+	"mime"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type AttachmentHandler struct {
+	service port.AttachmentService
+	jwt     util.JWT
+	cfg     *config.Config
+}
+
+func NewAttachmentHandler(service port.AttachmentService, jwt util.JWT, cfg *config.Config) port.AttachmentHandler {
+	return &AttachmentHandler{
+		service: service,
+		jwt:     jwt,
+		cfg:     cfg,
+	}
+}
+
+// @Summary Attach a file to a note
+// @Description Upload a file as an attachment of a note the user can edit, counting towards the storage quota of the user
+// @Tags attachment
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "Note ID"
+// @Param file formData file true "File"
+// @Success 201 {object} domain.AttachmentResponse "Successfully attached a file"
+// @Router /notes/{id}/attachments [post]
+func (h *AttachmentHandler) Create(ctx *fiber.Ctx) error {
+	var req domain.AttachmentRequest
+
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	claims, ok := ctx.Locals("claims").(*domain.Claims)
+	if !ok {
+		return fiber.NewError(fiber.StatusBadRequest, "failed to retrieve claims from context")
+	}
+
+	header, err := ctx.FormFile("file")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "file is required")
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	result, err := h.service.Create(ctx.UserContext(), req.NoteID, header.Filename, header.Header.Get(fiber.HeaderContentType), file, header.Size, *claims)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(attachmentResponse(result))
+}
+
+// @Summary Get all attachments of a note
+// @Description Get the attachments of a note the user can read
+// @Tags attachment
+// @Produce json
+// @Param id path int true "Note ID"
+// @Success 200 {object} []domain.AttachmentResponse "Successfully retrieved attachments"
+// @Router /notes/{id}/attachments [get]
+func (h *AttachmentHandler) GetAll(ctx *fiber.Ctx) error {
+	var req domain.AttachmentRequest
+	var data []domain.AttachmentResponse
+
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.service.GetAll(ctx.UserContext(), req.NoteID, h.claims(ctx))
+	if err != nil {
+		return err
+	}
+
+	for i := range result {
+		data = append(data, attachmentResponse(&result[i]))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(data)
+}
+
+// @Summary Download an attachment
+// @Description Download an attachment of a note the user can read
+// @Tags attachment
+// @Param id path int true "Note ID"
+// @Param attachment_id path int true "Attachment ID"
+// @Success 200 {file} file "Attachment"
+// @Router /notes/{id}/attachments/{attachment_id} [get]
+func (h *AttachmentHandler) Download(ctx *fiber.Ctx) error {
+	var req domain.AttachmentRequest
+
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	attachment, file, err := h.service.Open(ctx.UserContext(), req.NoteID, req.ID, h.claims(ctx))
+	if err != nil {
+		return err
+	}
+
+	// attachments are always downloaded, never rendered by the browser
+	ctx.Set(fiber.HeaderContentType, attachment.ContentType)
+	ctx.Set(fiber.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Name}))
+	ctx.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+	ctx.Set(fiber.HeaderCacheControl, "private, no-cache")
+
+	return ctx.Status(fiber.StatusOK).SendStream(file, int(attachment.Size))
+}
+
+// @Summary Delete an attachment
+// @Description Delete an attachment of a note the user can edit
+// @Tags attachment
+// @Produce json
+// @Param id path int true "Note ID"
+// @Param attachment_id path int true "Attachment ID"
+// @Success 200 "Successfully deleted an attachment"
+// @Router /notes/{id}/attachments/{attachment_id} [delete]
+func (h *AttachmentHandler) Delete(ctx *fiber.Ctx) error {
+	var req domain.AttachmentRequest
+
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	claims, ok := ctx.Locals("claims").(*domain.Claims)
+	if !ok {
+		return fiber.NewError(fiber.StatusBadRequest, "failed to retrieve claims from context")
+	}
+
+	if err := h.service.Delete(ctx.UserContext(), req.NoteID, req.ID, *claims); err != nil {
+		return err
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON("successfully deleted attachment")
+}
+
+// claims returns the claims of the access token cookie, if any. Attachments
+// of public notes can be read without logging in.
+func (h *AttachmentHandler) claims(ctx *fiber.Ctx) *domain.Claims {
+	cookie := ctx.Cookies("access-token")
+	if cookie == "" {
+		return nil
+	}
+
+	claims, err := h.jwt.ValidateToken(cookie, h.cfg.JWT.Access)
+	if err != nil {
+		return nil
+	}
+
+	return claims
+}
+
+func attachmentResponse(attachment *domain.Attachment) domain.AttachmentResponse {
+	return domain.AttachmentResponse{
+		ID:          attachment.ID,
+		NoteID:      attachment.NoteID,
+		Name:        attachment.Name,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		URL:         fmt.Sprintf("/api/v1/notes/%d/attachments/%d", attachment.NoteID, attachment.ID),
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
@@ -0,0 +1,208 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http/httptest"
+	"net/textproto"
+	"testing"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/mocks"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var attachmentEntity = &domain.Attachment{
+	ID:          1,
+	NoteID:      1,
+	UserID:      1,
+	Key:         "attachments/1/c2hpcm9u",
+	Name:        "laporan bulanan.pdf",
+	ContentType: "application/pdf",
+	Size:        4,
+}
+
+func TestAttachmentHandler_Create(t *testing.T) {
+	type fields struct {
+		service port.AttachmentService
+	}
+
+	type args struct {
+		file   []byte
+		claims domain.Claims
+	}
+
+	mockAttachmentService := mocks.NewAttachmentService(t)
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		code   int
+	}{
+		{
+			name: "success",
+			fields: fields{
+				service: func() port.AttachmentService {
+					mockAttachmentService.EXPECT().Create(mock.Anything, noteEntity.ID, "laporan bulanan.pdf", "application/pdf", mock.Anything, int64(4), mock.AnythingOfType("domain.Claims")).Return(attachmentEntity, nil).Once()
+					return mockAttachmentService
+				}(),
+			},
+			args: args{
+				file: []byte("%PDF"),
+			},
+			code: fiber.StatusCreated,
+		},
+		{
+			name: "quota exceeded",
+			fields: fields{
+				service: func() port.AttachmentService {
+					mockAttachmentService.EXPECT().Create(mock.Anything, noteEntity.ID, mock.Anything, mock.Anything, mock.Anything, int64(4), mock.AnythingOfType("domain.Claims")).Return(nil, fiber.NewError(fiber.StatusRequestEntityTooLarge, "storage quota exceeded")).Once()
+					return mockAttachmentService
+				}(),
+			},
+			args: args{
+				file: []byte("%PDF"),
+			},
+			code: fiber.StatusRequestEntityTooLarge,
+		},
+		{
+			name: "missing file",
+			fields: fields{
+				service: mockAttachmentService,
+			},
+			code: fiber.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &AttachmentHandler{
+				service: tt.fields.service,
+			}
+
+			app := config.NewFiber()
+			app.Use(func(ctx *fiber.Ctx) error {
+				ctx.Locals("claims", &tt.args.claims)
+				return ctx.Next()
+			})
+			app.Post("/api/v1/notes/:id/attachments", h.Create)
+
+			body := new(bytes.Buffer)
+			writer := multipart.NewWriter(body)
+			if tt.args.file != nil {
+				header := make(textproto.MIMEHeader)
+				header.Set("Content-Disposition", `form-data; name="file"; filename="laporan bulanan.pdf"`)
+				header.Set("Content-Type", "application/pdf")
+				part, err := writer.CreatePart(header)
+				assert.NoError(t, err)
+				part.Write(tt.args.file)
+			}
+			assert.NoError(t, writer.Close())
+
+			req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/notes/%v/attachments", noteEntity.ID), body)
+			req.Header.Set("Content-Type", writer.FormDataContentType())
+
+			res, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, res.StatusCode)
+		})
+	}
+}
+
+func TestAttachmentHandler_Download(t *testing.T) {
+	mockAttachmentService := mocks.NewAttachmentService(t)
+	mockAttachmentService.EXPECT().Open(mock.Anything, noteEntity.ID, attachmentEntity.ID, (*domain.Claims)(nil)).Return(attachmentEntity, io.NopCloser(bytes.NewReader([]byte("%PDF"))), nil).Once()
+
+	h := &AttachmentHandler{
+		service: mockAttachmentService,
+	}
+
+	app := config.NewFiber()
+	app.Get("/api/v1/notes/:id/attachments/:attachment_id", h.Download)
+
+	res, err := app.Test(httptest.NewRequest(fiber.MethodGet, fmt.Sprintf("/api/v1/notes/%v/attachments/%v", noteEntity.ID, attachmentEntity.ID), nil))
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, res.StatusCode)
+	assert.Equal(t, "application/pdf", res.Header.Get(fiber.HeaderContentType))
+	assert.Equal(t, `attachment; filename="laporan bulanan.pdf"`, res.Header.Get(fiber.HeaderContentDisposition))
+
+	data, err := io.ReadAll(res.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "%PDF", string(data))
+}
+
+func TestAttachmentHandler_Delete(t *testing.T) {
+	type fields struct {
+		service port.AttachmentService
+	}
+
+	type args struct {
+		id     uint
+		claims domain.Claims
+	}
+
+	mockAttachmentService := mocks.NewAttachmentService(t)
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		code   int
+	}{
+		{
+			name: "success",
+			fields: fields{
+				service: func() port.AttachmentService {
+					mockAttachmentService.EXPECT().Delete(mock.Anything, noteEntity.ID, attachmentEntity.ID, mock.AnythingOfType("domain.Claims")).Return(nil).Once()
+					return mockAttachmentService
+				}(),
+			},
+			args: args{
+				id: attachmentEntity.ID,
+			},
+			code: fiber.StatusOK,
+		},
+		{
+			name: "not found",
+			fields: fields{
+				service: func() port.AttachmentService {
+					mockAttachmentService.EXPECT().Delete(mock.Anything, noteEntity.ID, uint(2), mock.AnythingOfType("domain.Claims")).Return(fiber.NewError(fiber.StatusNotFound, "attachment not found")).Once()
+					return mockAttachmentService
+				}(),
+			},
+			args: args{
+				id: 2,
+			},
+			code: fiber.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &AttachmentHandler{
+				service: tt.fields.service,
+			}
+
+			app := config.NewFiber()
+			app.Use(func(ctx *fiber.Ctx) error {
+				ctx.Locals("claims", &tt.args.claims)
+				return ctx.Next()
+			})
+			app.Delete("/api/v1/notes/:id/attachments/:attachment_id", h.Delete)
+
+			req := httptest.NewRequest(fiber.MethodDelete, fmt.Sprintf("/api/v1/notes/%v/attachments/%v", noteEntity.ID, tt.args.id), nil)
+
+			res, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, res.StatusCode)
+		})
+	}
+}
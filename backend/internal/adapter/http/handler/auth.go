@@ -61,7 +61,7 @@ func (h *AuthHandler) Register(ctx *fiber.Ctx) error {
 
 	req.Name = strings.ReplaceAll(req.Name, " ", "")
 
-	result, err := h.service.Register(req)
+	result, err := h.service.Register(ctx.UserContext(), req)
 	if err != nil {
 		return err
 	}
@@ -101,7 +101,7 @@ func (h *AuthHandler) Login(ctx *fiber.Ctx) error {
 		return ctx.Status(fiber.StatusBadRequest).JSON(err)
 	}
 
-	result, tokens, err := h.service.Login(req)
+	result, tokens, err := h.service.Login(ctx.UserContext(), req)
 	if err != nil {
 		return err
 	}
@@ -112,8 +112,8 @@ func (h *AuthHandler) Login(ctx *fiber.Ctx) error {
 		Path:     "/",
 		HTTPOnly: true,
 		Expires:  time.Now().Add(24 * time.Hour),
-		SameSite: func(dev string) string {
-			if dev == "true" {
+		SameSite: func(dev bool) string {
+			if dev {
 				return fiber.CookieSameSiteLaxMode
 			}
 			return fiber.CookieSameSiteNoneMode
@@ -126,8 +126,8 @@ func (h *AuthHandler) Login(ctx *fiber.Ctx) error {
 		Path:     "/",
 		HTTPOnly: true,
 		Expires:  time.Now().Add(10 * time.Minute),
-		SameSite: func(dev string) string {
-			if dev == "true" {
+		SameSite: func(dev bool) string {
+			if dev {
 				return fiber.CookieSameSiteLaxMode
 			}
 			return fiber.CookieSameSiteNoneMode
@@ -172,7 +172,7 @@ func (h *AuthHandler) Logout(ctx *fiber.Ctx) error {
 	}
 	req.ID = uint(claims.UserID)
 
-	if err := h.service.Logout(req.ID); err != nil {
+	if err := h.service.Logout(ctx.UserContext(), req.ID); err != nil {
 		return err
 	}
 
@@ -189,7 +189,7 @@ func (h *AuthHandler) Logout(ctx *fiber.Ctx) error {
 func (h *AuthHandler) Refresh(ctx *fiber.Ctx) error {
 	cookie := ctx.Cookies("refresh-token")
 
-	result, claims, err := h.service.Refresh(cookie)
+	result, claims, err := h.service.Refresh(ctx.UserContext(), cookie)
 	if err != nil {
 		return err
 	}
@@ -200,8 +200,8 @@ func (h *AuthHandler) Refresh(ctx *fiber.Ctx) error {
 		Path:     "/",
 		HTTPOnly: true,
 		Expires:  time.Now().Add(10 * time.Minute),
-		SameSite: func(dev string) string {
-			if dev == "true" {
+		SameSite: func(dev bool) string {
+			if dev {
 				return fiber.CookieSameSiteLaxMode
 			}
 			return fiber.CookieSameSiteNoneMode
@@ -213,3 +213,35 @@ func (h *AuthHandler) Refresh(ctx *fiber.Ctx) error {
 		Claims:      claims,
 	})
 }
+
+// @Summary Restore a deleted user
+// @Description Restore a deleted user account that is still within its grace period
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param user body domain.AuthLoginRequest true "User login request object"
+// @Success 200 {object} domain.UserResponse "Successfully restored user"
+// @Router /auth/restore [post]
+func (h *AuthHandler) Restore(ctx *fiber.Ctx) error {
+	var req domain.AuthLoginRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(err)
+	}
+
+	result, err := h.service.Restore(ctx.UserContext(), req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(domain.UserResponse{
+		ID:        result.ID,
+		Name:      result.Name,
+		CreatedAt: result.CreatedAt,
+		UpdatedAt: result.UpdatedAt,
+	})
+}
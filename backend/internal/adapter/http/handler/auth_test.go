@@ -56,7 +56,7 @@ func TestAuthHandler_Register(t *testing.T) {
 			name: "success",
 			fields: fields{
 				service: func() port.AuthService {
-					mockAuthService.EXPECT().Register(mock.AnythingOfType("domain.AuthRegisterRequest")).Return(&authEntity, nil).Once()
+					mockAuthService.EXPECT().Register(mock.Anything, mock.AnythingOfType("domain.AuthRegisterRequest")).Return(&authEntity, nil).Once()
 					return mockAuthService
 				}(),
 				validator: validator,
@@ -74,7 +74,7 @@ func TestAuthHandler_Register(t *testing.T) {
 			name: "validation error",
 			fields: fields{
 				service: func() port.AuthService {
-					mockAuthService.EXPECT().Register(mock.AnythingOfType("domain.AuthRegisterRequest")).Maybe()
+					mockAuthService.EXPECT().Register(mock.Anything, mock.AnythingOfType("domain.AuthRegisterRequest")).Maybe()
 					return mockAuthService
 				}(),
 				validator: validator,
@@ -133,7 +133,7 @@ func TestAuthHandler_Login(t *testing.T) {
 			name: "success",
 			fields: fields{
 				service: func() port.AuthService {
-					mockAuthService.EXPECT().Login(mock.AnythingOfType("domain.AuthLoginRequest")).Return(&authEntity, &userToken, nil).Once()
+					mockAuthService.EXPECT().Login(mock.Anything, mock.AnythingOfType("domain.AuthLoginRequest")).Return(&authEntity, &userToken, nil).Once()
 					return mockAuthService
 				}(),
 				cfg:       &config.Config{},
@@ -152,7 +152,7 @@ func TestAuthHandler_Login(t *testing.T) {
 			name: "wrong password",
 			fields: fields{
 				service: func() port.AuthService {
-					mockAuthService.EXPECT().Login(mock.AnythingOfType("domain.AuthLoginRequest")).Return(nil, nil, fiber.NewError(fiber.StatusUnauthorized, "invalid password")).Once()
+					mockAuthService.EXPECT().Login(mock.Anything, mock.AnythingOfType("domain.AuthLoginRequest")).Return(nil, nil, fiber.NewError(fiber.StatusUnauthorized, "invalid password")).Once()
 					return mockAuthService
 				}(),
 				jwt:       jwt,
@@ -228,7 +228,7 @@ func TestAuthHandler_Logout(t *testing.T) {
 			name: "success",
 			fields: fields{
 				service: func() port.AuthService {
-					mockAuthService.EXPECT().Logout(mock.AnythingOfType("uint")).Return(nil)
+					mockAuthService.EXPECT().Logout(mock.Anything, mock.AnythingOfType("uint")).Return(nil)
 					return mockAuthService
 				}(),
 				jwt: jwt,
@@ -283,3 +283,89 @@ func TestAuthHandler_Logout(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthHandler_Restore(t *testing.T) {
+	type fields struct {
+		service   port.AuthService
+		validator *util.Validator
+	}
+
+	type args struct {
+		req domain.AuthLoginRequest
+	}
+
+	mockAuthService := mocks.NewAuthService(t)
+	validator, _ := util.NewValidator()
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		code   int
+	}{
+		{
+			name: "success",
+			fields: fields{
+				service: func() port.AuthService {
+					mockAuthService.EXPECT().Restore(mock.Anything, mock.AnythingOfType("domain.AuthLoginRequest")).Return(&authEntity, nil).Once()
+					return mockAuthService
+				}(),
+				validator: validator,
+			},
+			args: args{
+				req: domain.AuthLoginRequest{
+					Email:    authEntity.Email,
+					Password: "password123",
+				},
+			},
+			code: fiber.StatusOK,
+		},
+		{
+			name: "grace period expired",
+			fields: fields{
+				service: func() port.AuthService {
+					mockAuthService.EXPECT().Restore(mock.Anything, mock.AnythingOfType("domain.AuthLoginRequest")).Return(nil, fiber.NewError(fiber.StatusGone, "user grace period has expired")).Once()
+					return mockAuthService
+				}(),
+				validator: validator,
+			},
+			args: args{
+				req: domain.AuthLoginRequest{
+					Email:    authEntity.Email,
+					Password: "password123",
+				},
+			},
+			code: fiber.StatusGone,
+		},
+		{
+			name: "validation error",
+			fields: fields{
+				service:   mockAuthService,
+				validator: validator,
+			},
+			code: fiber.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &AuthHandler{
+				service:   tt.fields.service,
+				validator: tt.fields.validator,
+			}
+
+			app := config.NewFiber()
+			app.Post("/api/v1/auth/restore", h.Restore)
+
+			requestBody, err := json.Marshal(tt.args.req)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(fiber.MethodPost, "/api/v1/auth/restore", bytes.NewBuffer(requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			res, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, res.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+)
+
+type CollabHandler struct {
+	noteService   port.NoteService
+	collabService port.CollabService
+	cfg           *config.Config
+}
+
+func NewCollabHandler(noteService port.NoteService, collabService port.CollabService, cfg *config.Config) port.CollabHandler {
+	return &CollabHandler{
+		noteService:   noteService,
+		collabService: collabService,
+		cfg:           cfg,
+	}
+}
+
+// Upgrade authorizes the caller on the note before the connection is upgraded.
+// Editors and owners may send operations, anyone who can read the note joins
+// read-only and only receives updates and presence. Sockets are exempt from
+// CORS and the auth cookie is sent cross-site, so only the web app may open
+// one.
+func (h *CollabHandler) Upgrade(ctx *fiber.Ctx) error {
+	var req domain.NoteRequest
+
+	if ctx.Get(fiber.HeaderOrigin) != h.cfg.Server.Web {
+		return fiber.NewError(fiber.StatusForbidden, "origin not allowed")
+	}
+
+	if !websocket.IsWebSocketUpgrade(ctx) {
+		return fiber.ErrUpgradeRequired
+	}
+
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	claims, ok := ctx.Locals("claims").(*domain.Claims)
+	if !ok {
+		return fiber.NewError(fiber.StatusBadRequest, "failed to retrieve claims from context")
+	}
+
+	editable := true
+	note, err := h.noteService.GetEditable(ctx.UserContext(), req.ID, *claims)
+	if err != nil {
+		var e *fiber.Error
+		if !errors.As(err, &e) || e.Code != fiber.StatusForbidden {
+			return err
+		}
+
+		editable = false
+		note, err = h.noteService.GetByID(ctx.UserContext(), req.ID, claims)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx.Locals("note", note)
+	ctx.Locals("editable", editable)
+	// the socket outlives the request, and with it any deadline of its context
+	ctx.Locals("context", context.WithoutCancel(ctx.UserContext()))
+
+	return ctx.Next()
+}
+
+// @Summary Collaborate on a note
+// @Description Open a WebSocket to edit a note together; messages are JSON objects with a type of init, operation, ack, cursor, presence or error and operations use the ot.js format
+// @Tags note
+// @Param id path int true "Note ID"
+// @Success 101 "Switching protocols"
+// @Router /notes/{id}/ws [get]
+func (h *CollabHandler) Connect() fiber.Handler {
+	return websocket.New(func(conn *websocket.Conn) {
+		note := conn.Locals("note").(*domain.Note)
+		claims := conn.Locals("claims").(*domain.Claims)
+		editable := conn.Locals("editable").(bool)
+		userCtx := conn.Locals("context").(context.Context)
+
+		client, err := h.collabService.Join(userCtx, note, claims.UserID, editable)
+		if err != nil {
+			conn.WriteJSON(domain.CollabMessage{Type: domain.CollabError, Error: err.Error()})
+			return
+		}
+		written := make(chan struct{})
+		// the conn is released once this returns, the writer has to be done
+		defer func() {
+			h.collabService.Leave(client)
+			<-written
+		}()
+
+		go func() {
+			defer close(written)
+			// Send is closed when the client leaves or when the note is gone,
+			// closing the socket then ends the read loop below
+			defer conn.Close()
+			for msg := range client.Send {
+				if err := conn.WriteJSON(msg); err != nil {
+					return
+				}
+			}
+		}()
+
+		for {
+			var msg domain.CollabMessage
+			if err := conn.ReadJSON(&msg); err != nil {
+				return
+			}
+			h.collabService.Receive(client, msg)
+		}
+	}, websocket.Config{Origins: []string{h.cfg.Server.Web}})
+}
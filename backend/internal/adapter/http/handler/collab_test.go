@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/mocks"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCollabHandler_Upgrade(t *testing.T) {
+	type fields struct {
+		noteService port.NoteService
+	}
+
+	type args struct {
+		origin  string
+		upgrade bool
+		claims  domain.Claims
+	}
+
+	mockNoteService := mocks.NewNoteService(t)
+
+	cfg := &config.Config{}
+	cfg.Server.Web = "http://localhost:3000"
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		code   int
+	}{
+		{
+			name: "not a websocket request",
+			fields: fields{
+				noteService: mockNoteService,
+			},
+			args: args{
+				origin: cfg.Server.Web,
+			},
+			code: fiber.StatusUpgradeRequired,
+		},
+		{
+			name: "foreign origin",
+			fields: fields{
+				noteService: mockNoteService,
+			},
+			args: args{
+				origin:  "https://evil.example.com",
+				upgrade: true,
+			},
+			code: fiber.StatusForbidden,
+		},
+		{
+			name: "no origin",
+			fields: fields{
+				noteService: mockNoteService,
+			},
+			args: args{
+				upgrade: true,
+			},
+			code: fiber.StatusForbidden,
+		},
+		{
+			name: "note not found",
+			fields: fields{
+				noteService: func() port.NoteService {
+					mockNoteService.EXPECT().GetEditable(mock.Anything, noteEntity.ID, mock.AnythingOfType("domain.Claims")).Return(nil, fiber.NewError(fiber.StatusNotFound, "note not found")).Once()
+					return mockNoteService
+				}(),
+			},
+			args: args{
+				origin:  cfg.Server.Web,
+				upgrade: true,
+			},
+			code: fiber.StatusNotFound,
+		},
+		{
+			name: "private note",
+			fields: fields{
+				noteService: func() port.NoteService {
+					mockNoteService.EXPECT().GetEditable(mock.Anything, noteEntity.ID, mock.AnythingOfType("domain.Claims")).Return(nil, fiber.NewError(fiber.StatusForbidden, "user does not have permission to perform this action")).Once()
+					mockNoteService.EXPECT().GetByID(mock.Anything, noteEntity.ID, mock.AnythingOfType("*domain.Claims")).Return(nil, fiber.NewError(fiber.StatusUnauthorized, "you are not authorized to access this private note")).Once()
+					return mockNoteService
+				}(),
+			},
+			args: args{
+				origin:  cfg.Server.Web,
+				upgrade: true,
+			},
+			code: fiber.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &CollabHandler{
+				noteService: tt.fields.noteService,
+				cfg:         cfg,
+			}
+
+			app := config.NewFiber()
+			app.Use(func(ctx *fiber.Ctx) error {
+				ctx.Locals("claims", &tt.args.claims)
+				return ctx.Next()
+			})
+			app.Get("/api/v1/notes/:id/ws", h.Upgrade)
+
+			req := httptest.NewRequest(fiber.MethodGet, "/api/v1/notes/1/ws", nil)
+			if tt.args.origin != "" {
+				req.Header.Set(fiber.HeaderOrigin, tt.args.origin)
+			}
+			if tt.args.upgrade {
+				req.Header.Set("Connection", "Upgrade")
+				req.Header.Set("Upgrade", "websocket")
+			}
+
+			res, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, res.StatusCode)
+		})
+	}
+}
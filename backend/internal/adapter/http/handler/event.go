@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type EventHandler struct {
+	service port.EventService
+	images  util.Images
+	cfg     *config.Config
+}
+
+func NewEventHandler(service port.EventService, images util.Images, cfg *config.Config) port.EventHandler {
+	return &EventHandler{
+		service: service,
+		images:  images,
+		cfg:     cfg,
+	}
+}
+
+// @Summary Stream note changes
+// @Description Server-Sent Events stream of note.created, note.updated and note.deleted events for notes the user can see; send Last-Event-ID to resume after a reconnect
+// @Tags note
+// @Produce text/event-stream
+// @Param Last-Event-ID header int false "ID of the last event received"
+// @Success 200 {object} domain.NoteEventResponse "Stream of note events"
+// @Router /notes/events [get]
+func (h *EventHandler) Stream(ctx *fiber.Ctx) error {
+	claims, ok := ctx.Locals("claims").(*domain.Claims)
+	if !ok {
+		return fiber.NewError(fiber.StatusBadRequest, "failed to retrieve claims from context")
+	}
+
+	var lastEventID uint64
+	if header := ctx.Get("Last-Event-ID"); header != "" {
+		id, err := strconv.ParseUint(header, 10, 64)
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "invalid Last-Event-ID header")
+		}
+		lastEventID = id
+	}
+
+	subscription, backlog := h.service.Subscribe(claims.UserID, lastEventID)
+	// the stream outlives the handler, only the values of its context carry over
+	userCtx := context.WithoutCancel(ctx.UserContext())
+
+	ctx.Set(fiber.HeaderContentType, "text/event-stream")
+	ctx.Set(fiber.HeaderCacheControl, "no-cache")
+	ctx.Set(fiber.HeaderConnection, "keep-alive")
+	ctx.Set("X-Accel-Buffering", "no")
+
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer h.service.Unsubscribe(subscription)
+
+		for _, event := range backlog {
+			if err := h.writeNoteEvent(userCtx, w, event); err != nil {
+				return
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return
+		}
+
+		heartbeat := time.NewTicker(h.cfg.Events.Heartbeat)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case event, ok := <-subscription.Events:
+				if !ok {
+					return
+				}
+				if err := h.writeNoteEvent(userCtx, w, event); err != nil {
+					return
+				}
+			case <-heartbeat.C:
+				// a comment line keeps proxies from closing an idle connection and
+				// lets us notice clients that went away
+				if _, err := w.WriteString(": heartbeat\n\n"); err != nil {
+					return
+				}
+			}
+
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	})
+
+	return nil
+}
+
+func (h *EventHandler) writeNoteEvent(ctx context.Context, w *bufio.Writer, event domain.NoteEvent) error {
+	data := domain.NoteEventResponse{
+		NoteID:    event.Note.ID,
+		CreatedAt: event.CreatedAt,
+	}
+
+	if event.Type != domain.NoteDeleted {
+		images, err := h.images.Resolve(ctx, event.Note.CoverURL, event.Note.Author.AvatarURL)
+		if err != nil {
+			return err
+		}
+
+		data.Note = &domain.NoteResponse{
+			ID:          event.Note.ID,
+			Title:       event.Note.Title,
+			Description: event.Note.Description,
+			Cover:       images.Image(event.Note.CoverURL),
+			Content:     event.Note.Content,
+			Visibility:  string(event.Note.Visibility),
+			Notebook:    event.Note.Notebook,
+			Tags:        event.Note.Tags,
+			Author: domain.NoteAuthor{
+				ID:     event.Note.Author.ID,
+				Name:   event.Note.Author.Name,
+				Avatar: images.Avatar(event.Note.Author.AvatarURL),
+			},
+			CreatedAt: event.Note.CreatedAt,
+			UpdatedAt: event.Note.UpdatedAt,
+		}
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, payload)
+	return err
+}
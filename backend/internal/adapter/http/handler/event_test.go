@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/mocks"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEventHandler_Stream(t *testing.T) {
+	type fields struct {
+		service port.EventService
+	}
+
+	type args struct {
+		lastEventID string
+		claims      domain.Claims
+	}
+
+	mockEventService := mocks.NewEventService(t)
+	cfg := &config.Config{}
+	cfg.Events.Heartbeat = time.Minute
+
+	// a closed subscription ends the stream once the backlog has been written
+	closed := &domain.NoteSubscription{Events: make(chan domain.NoteEvent)}
+	close(closed.Events)
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		code   int
+		body   []string
+	}{
+		{
+			name: "resume",
+			fields: fields{
+				service: func() port.EventService {
+					mockEventService.EXPECT().Subscribe(noteEntity.UserID, uint64(4)).Return(closed, []domain.NoteEvent{
+						{ID: 5, Type: domain.NoteUpdated, Note: *noteEntity},
+						{ID: 6, Type: domain.NoteDeleted, Note: *noteEntity},
+					}).Once()
+					mockEventService.EXPECT().Unsubscribe(closed).Once()
+					return mockEventService
+				}(),
+			},
+			args: args{
+				lastEventID: "4",
+				claims: domain.Claims{
+					UserID: noteEntity.UserID,
+				},
+			},
+			code: fiber.StatusOK,
+			body: []string{
+				"id: 5\nevent: note.updated\ndata: {\"note_id\":1,\"note\":{",
+				"id: 6\nevent: note.deleted\ndata: {\"note_id\":1,\"created_at\"",
+			},
+		},
+		{
+			name: "invalid last event id",
+			fields: fields{
+				service: func() port.EventService {
+					mockEventService.EXPECT().Subscribe(mock.Anything, mock.Anything).Maybe()
+					return mockEventService
+				}(),
+			},
+			args: args{
+				lastEventID: "abc",
+			},
+			code: fiber.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &EventHandler{
+				service: tt.fields.service,
+				cfg:     cfg,
+			}
+
+			app := config.NewFiber()
+			app.Use(func(ctx *fiber.Ctx) error {
+				ctx.Locals("claims", &tt.args.claims)
+				return ctx.Next()
+			})
+			app.Get("/api/v1/notes/events", h.Stream)
+
+			req := httptest.NewRequest(fiber.MethodGet, "/api/v1/notes/events", nil)
+			req.Header.Set("Last-Event-ID", tt.args.lastEventID)
+
+			res, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, res.StatusCode)
+
+			body, err := io.ReadAll(res.Body)
+			assert.NoError(t, err)
+			for _, want := range tt.body {
+				assert.Contains(t, string(body), want)
+			}
+		})
+	}
+}
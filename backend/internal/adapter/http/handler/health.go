@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type HealthHandler struct {
+	checks map[string]port.HealthCheck
+	cfg    *config.Config
+}
+
+func NewHealthHandler(checks map[string]port.HealthCheck, cfg *config.Config) port.HealthHandler {
+	return &HealthHandler{
+		checks: checks,
+		cfg:    cfg,
+	}
+}
+
+// @Summary Liveness probe
+// @Description Responds as long as the process can serve requests, it checks no dependencies
+// @Tags health
+// @Produce json
+// @Success 200 {object} domain.HealthResponse
+// @Router /healthz [get]
+func (h *HealthHandler) Live(ctx *fiber.Ctx) error {
+	return ctx.Status(fiber.StatusOK).JSON(domain.HealthResponse{
+		Status: domain.HealthOK,
+	})
+}
+
+// @Summary Readiness probe
+// @Description Runs every readiness check at once, each within the health timeout
+// @Tags health
+// @Produce json
+// @Success 200 {object} domain.HealthResponse
+// @Failure 503 {object} domain.HealthResponse
+// @Router /readyz [get]
+func (h *HealthHandler) Ready(ctx *fiber.Ctx) error {
+	res := domain.HealthResponse{
+		Status: domain.HealthOK,
+		Checks: make(map[string]domain.CheckResult, len(h.checks)),
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, check := range h.checks {
+		wg.Add(1)
+		go func(name string, check port.HealthCheck) {
+			defer wg.Done()
+
+			result := h.run(ctx.UserContext(), check)
+
+			mu.Lock()
+			defer mu.Unlock()
+			res.Checks[name] = result
+			if result.Status != domain.HealthOK {
+				res.Status = domain.HealthFail
+			}
+		}(name, check)
+	}
+	wg.Wait()
+
+	if res.Status != domain.HealthOK {
+		return ctx.Status(fiber.StatusServiceUnavailable).JSON(res)
+	}
+	return ctx.Status(fiber.StatusOK).JSON(res)
+}
+
+// run gives up on a check after the timeout even when the check does not
+// watch its context.
+func (h *HealthHandler) run(parent context.Context, check port.HealthCheck) domain.CheckResult {
+	ctx, cancel := context.WithTimeout(parent, h.cfg.Server.HealthTimeout)
+	defer cancel()
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		done <- check.Check(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	result := domain.CheckResult{
+		Status:   domain.HealthOK,
+		Duration: time.Since(start).Round(time.Microsecond).String(),
+	}
+	if err != nil {
+		result.Status = domain.HealthFail
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+// @Summary Build info
+// @Description Version, commit and build time of the running binary
+// @Tags health
+// @Produce json
+// @Success 200 {object} domain.VersionResponse
+// @Router /version [get]
+func (h *HealthHandler) Version(ctx *fiber.Ctx) error {
+	version, commit, buildTime := config.Build()
+
+	return ctx.Status(fiber.StatusOK).JSON(domain.VersionResponse{
+		Version:   version,
+		Commit:    commit,
+		BuildTime: buildTime,
+		GoVersion: runtime.Version(),
+	})
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/mocks"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHealthHandler_Ready(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Server.HealthTimeout = 50 * time.Millisecond
+
+	healthy := func() port.HealthCheck {
+		check := mocks.NewHealthCheck(t)
+		check.EXPECT().Check(mock.Anything).Return(nil).Once()
+		return check
+	}
+
+	tests := []struct {
+		name   string
+		checks map[string]port.HealthCheck
+		code   int
+		want   map[string]domain.HealthStatus
+		errors map[string]string
+	}{
+		{
+			name: "ready",
+			checks: map[string]port.HealthCheck{
+				"database":   healthy(),
+				"migrations": healthy(),
+			},
+			code: fiber.StatusOK,
+			want: map[string]domain.HealthStatus{"database": domain.HealthOK, "migrations": domain.HealthOK},
+		},
+		{
+			name: "failing check",
+			checks: map[string]port.HealthCheck{
+				"database": healthy(),
+				"migrations": func() port.HealthCheck {
+					check := mocks.NewHealthCheck(t)
+					check.EXPECT().Check(mock.Anything).Return(errors.New("1 migrations pending")).Once()
+					return check
+				}(),
+			},
+			code:   fiber.StatusServiceUnavailable,
+			want:   map[string]domain.HealthStatus{"database": domain.HealthOK, "migrations": domain.HealthFail},
+			errors: map[string]string{"migrations": "1 migrations pending"},
+		},
+		{
+			name: "timeout",
+			checks: map[string]port.HealthCheck{
+				"database": func() port.HealthCheck {
+					check := mocks.NewHealthCheck(t)
+					// a check that ignores its context is cut off all the same
+					check.EXPECT().Check(mock.Anything).Run(func(ctx context.Context) {
+						time.Sleep(200 * time.Millisecond)
+					}).Return(nil).Once()
+					return check
+				}(),
+			},
+			code:   fiber.StatusServiceUnavailable,
+			want:   map[string]domain.HealthStatus{"database": domain.HealthFail},
+			errors: map[string]string{"database": context.DeadlineExceeded.Error()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHealthHandler(tt.checks, cfg)
+
+			app := config.NewFiber()
+			app.Get("/readyz", h.Ready)
+
+			res, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/readyz", nil), -1)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, res.StatusCode)
+
+			var body domain.HealthResponse
+			assert.NoError(t, json.NewDecoder(res.Body).Decode(&body))
+			for name, status := range tt.want {
+				assert.Equal(t, status, body.Checks[name].Status, name)
+				assert.NotEmpty(t, body.Checks[name].Duration, name)
+				assert.Equal(t, tt.errors[name], body.Checks[name].Error, name)
+			}
+		})
+	}
+}
+
+func TestHealthHandler_Version(t *testing.T) {
+	h := NewHealthHandler(nil, &config.Config{})
+
+	app := config.NewFiber()
+	app.Get("/healthz", h.Live)
+	app.Get("/version", h.Version)
+
+	res, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/healthz", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	res, err = app.Test(httptest.NewRequest(fiber.MethodGet, "/version", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	var body domain.VersionResponse
+	assert.NoError(t, json.NewDecoder(res.Body).Decode(&body))
+	assert.Equal(t, config.Version, body.Version)
+	assert.NotEmpty(t, body.GoVersion)
+}
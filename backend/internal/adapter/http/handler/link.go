@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ShareLinkHandler struct {
+	service   port.ShareLinkService
+	validator *util.Validator
+	images    util.Images
+}
+
+func NewShareLinkHandler(service port.ShareLinkService, validator *util.Validator, images util.Images) port.ShareLinkHandler {
+	return &ShareLinkHandler{
+		service:   service,
+		validator: validator,
+		images:    images,
+	}
+}
+
+// @Summary Create a share link
+// @Description Create a read-only share link for a note, optionally expiring and password-protected
+// @Tags link
+// @Accept json
+// @Produce json
+// @Param id path int true "Note ID"
+// @Param link body domain.ShareLinkRequest true "Share link request object"
+// @Success 201 {object} domain.ShareLinkResponse "Successfully created a share link"
+// @Router /notes/{id}/links [post]
+func (h *ShareLinkHandler) Create(ctx *fiber.Ctx) error {
+	var req domain.ShareLinkRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(err)
+	}
+
+	claims, ok := ctx.Locals("claims").(*domain.Claims)
+	if !ok {
+		return fiber.NewError(fiber.StatusBadRequest, "failed to retrieve claims from context")
+	}
+
+	result, err := h.service.Create(ctx.UserContext(), req, *claims)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(domain.ShareLinkResponse{
+		ID:        result.ID,
+		NoteID:    result.NoteID,
+		Token:     result.Token,
+		Protected: result.Password != "",
+		ExpiresAt: result.ExpiresAt,
+		CreatedAt: result.CreatedAt,
+	})
+}
+
+// @Summary Get share links
+// @Description Retrieve the share links of a note
+// @Tags link
+// @Produce json
+// @Param id path int true "Note ID"
+// @Success 200 {object} []domain.ShareLinkResponse "Successfully retrieved share links"
+// @Router /notes/{id}/links [get]
+func (h *ShareLinkHandler) GetAll(ctx *fiber.Ctx) error {
+	var req domain.ShareLinkRequest
+	var data []domain.ShareLinkResponse
+
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	claims, ok := ctx.Locals("claims").(*domain.Claims)
+	if !ok {
+		return fiber.NewError(fiber.StatusBadRequest, "failed to retrieve claims from context")
+	}
+
+	result, err := h.service.GetAll(ctx.UserContext(), req.NoteID, *claims)
+	if err != nil {
+		return err
+	}
+
+	for _, link := range result {
+		data = append(data, domain.ShareLinkResponse{
+			ID:        link.ID,
+			NoteID:    link.NoteID,
+			Token:     link.Token,
+			Protected: link.Password != "",
+			ExpiresAt: link.ExpiresAt,
+			CreatedAt: link.CreatedAt,
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(data)
+}
+
+// @Summary Revoke a share link
+// @Description Revoke a share link of a note based on the provided ID
+// @Tags link
+// @Produce json
+// @Param id path int true "Note ID"
+// @Param link_id path int true "Share link ID"
+// @Success 200 "Successfully revoked a share link"
+// @Router /notes/{id}/links/{link_id} [delete]
+func (h *ShareLinkHandler) Delete(ctx *fiber.Ctx) error {
+	var req domain.ShareLinkRequest
+
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	claims, ok := ctx.Locals("claims").(*domain.Claims)
+	if !ok {
+		return fiber.NewError(fiber.StatusBadRequest, "failed to retrieve claims from context")
+	}
+
+	if err := h.service.Delete(ctx.UserContext(), req.NoteID, req.ID, *claims); err != nil {
+		return err
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON("successfully revoked share link")
+}
+
+// @Summary Open a share link
+// @Description Retrieve the note behind a share link, passing the password in the X-Share-Password header if required
+// @Tags link
+// @Produce json
+// @Param token path string true "Share link token"
+// @Param X-Share-Password header string false "Share link password"
+// @Success 200 {object} domain.NoteResponse "Successfully retrieved a shared note"
+// @Router /shared/{token} [get]
+func (h *ShareLinkHandler) Open(ctx *fiber.Ctx) error {
+	result, err := h.service.Open(ctx.UserContext(), ctx.Params("token"), ctx.Get("X-Share-Password"))
+	if err != nil {
+		return err
+	}
+
+	images, err := h.images.Resolve(ctx.UserContext(), result.CoverURL, result.Author.AvatarURL)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(domain.NoteResponse{
+		ID:          result.ID,
+		Title:       result.Title,
+		Description: result.Description,
+		Cover:       images.Image(result.CoverURL),
+		Content:     result.Content,
+		Visibility:  string(result.Visibility),
+		Notebook:    result.Notebook,
+		Tags:        result.Tags,
+		Author: domain.NoteAuthor{
+			ID:     result.Author.ID,
+			Name:   result.Author.Name,
+			Avatar: images.Avatar(result.Author.AvatarURL),
+		},
+		UpdatedAt: result.UpdatedAt,
+		CreatedAt: result.CreatedAt,
+	})
+}
@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/mocks"
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var shareLinkEntity = &domain.ShareLink{
+	ID:     1,
+	NoteID: 1,
+	Token:  "c2hpcm9u",
+}
+
+func TestShareLinkHandler_Create(t *testing.T) {
+	type fields struct {
+		service   port.ShareLinkService
+		validator *util.Validator
+	}
+
+	type args struct {
+		id     uint
+		req    domain.ShareLinkRequest
+		claims domain.Claims
+	}
+
+	mockShareLinkService := mocks.NewShareLinkService(t)
+	validator, _ := util.NewValidator()
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		code   int
+	}{
+		{
+			name: "success",
+			fields: fields{
+				service: func() port.ShareLinkService {
+					mockShareLinkService.EXPECT().Create(mock.Anything, mock.MatchedBy(func(req domain.ShareLinkRequest) bool {
+						return req.NoteID == noteEntity.ID
+					}), mock.AnythingOfType("domain.Claims")).Return(shareLinkEntity, nil).Once()
+					return mockShareLinkService
+				}(),
+				validator: validator,
+			},
+			args: args{
+				id: noteEntity.ID,
+			},
+			code: fiber.StatusCreated,
+		},
+		{
+			name: "validation error",
+			fields: fields{
+				service:   mockShareLinkService,
+				validator: validator,
+			},
+			args: args{
+				id: noteEntity.ID,
+				req: domain.ShareLinkRequest{
+					Password: "abc",
+				},
+			},
+			code: fiber.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &ShareLinkHandler{
+				service:   tt.fields.service,
+				validator: tt.fields.validator,
+			}
+
+			app := config.NewFiber()
+			app.Use(func(ctx *fiber.Ctx) error {
+				ctx.Locals("claims", &tt.args.claims)
+				return ctx.Next()
+			})
+			app.Post("/api/v1/notes/:id/links", h.Create)
+
+			requestBody, err := json.Marshal(tt.args.req)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/notes/%v/links", tt.args.id), bytes.NewBuffer(requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			res, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, res.StatusCode)
+		})
+	}
+}
+
+func TestShareLinkHandler_Delete(t *testing.T) {
+	type fields struct {
+		service port.ShareLinkService
+	}
+
+	type args struct {
+		noteID uint
+		id     uint
+		claims domain.Claims
+	}
+
+	mockShareLinkService := mocks.NewShareLinkService(t)
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		code   int
+	}{
+		{
+			name: "success",
+			fields: fields{
+				service: func() port.ShareLinkService {
+					mockShareLinkService.EXPECT().Delete(mock.Anything, noteEntity.ID, shareLinkEntity.ID, mock.AnythingOfType("domain.Claims")).Return(nil).Once()
+					return mockShareLinkService
+				}(),
+			},
+			args: args{
+				noteID: noteEntity.ID,
+				id:     shareLinkEntity.ID,
+			},
+			code: fiber.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &ShareLinkHandler{
+				service: tt.fields.service,
+			}
+
+			app := config.NewFiber()
+			app.Use(func(ctx *fiber.Ctx) error {
+				ctx.Locals("claims", &tt.args.claims)
+				return ctx.Next()
+			})
+			app.Delete("/api/v1/notes/:id/links/:link_id", h.Delete)
+
+			req := httptest.NewRequest(fiber.MethodDelete, fmt.Sprintf("/api/v1/notes/%v/links/%v", tt.args.noteID, tt.args.id), nil)
+
+			res, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, res.StatusCode)
+		})
+	}
+}
+
+func TestShareLinkHandler_Open(t *testing.T) {
+	type fields struct {
+		service port.ShareLinkService
+	}
+
+	type args struct {
+		token    string
+		password string
+	}
+
+	mockShareLinkService := mocks.NewShareLinkService(t)
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		code   int
+	}{
+		{
+			name: "success",
+			fields: fields{
+				service: func() port.ShareLinkService {
+					mockShareLinkService.EXPECT().Open(mock.Anything, shareLinkEntity.Token, "password123").Return(noteEntity, nil).Once()
+					return mockShareLinkService
+				}(),
+			},
+			args: args{
+				token:    shareLinkEntity.Token,
+				password: "password123",
+			},
+			code: fiber.StatusOK,
+		},
+		{
+			name: "expired",
+			fields: fields{
+				service: func() port.ShareLinkService {
+					mockShareLinkService.EXPECT().Open(mock.Anything, shareLinkEntity.Token, "").Return(nil, fiber.NewError(fiber.StatusGone, "share link has expired")).Once()
+					return mockShareLinkService
+				}(),
+			},
+			args: args{
+				token: shareLinkEntity.Token,
+			},
+			code: fiber.StatusGone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &ShareLinkHandler{
+				service: tt.fields.service,
+			}
+
+			app := config.NewFiber()
+			app.Get("/api/v1/shared/:token", h.Open)
+
+			req := httptest.NewRequest(fiber.MethodGet, fmt.Sprintf("/api/v1/shared/%v", tt.args.token), nil)
+			if tt.args.password != "" {
+				req.Header.Set("X-Share-Password", tt.args.password)
+			}
+
+			res, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, res.StatusCode)
+		})
+	}
+}
@@ -1,6 +1,14 @@
 package handler
 
 import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
 	"github.com/leebenson/conform"
 	"github.com/shironxn/blanknotes/internal/config"
 	"github.com/shironxn/blanknotes/internal/core/domain"
@@ -13,18 +21,24 @@ import (
 )
 
 type NoteHandler struct {
-	service   port.NoteService
-	validator *util.Validator
-	jwt       util.JWT
-	cfg       *config.Config
+	service           port.NoteService
+	attachmentService port.AttachmentService
+	validator         *util.Validator
+	jwt               util.JWT
+	images            util.Images
+	cfg               *config.Config
+	importers         []port.Importer
 }
 
-func NewNoteHandler(service port.NoteService, validator *util.Validator, jwt util.JWT, cfg *config.Config) port.NoteHandler {
+func NewNoteHandler(service port.NoteService, attachmentService port.AttachmentService, validator *util.Validator, jwt util.JWT, images util.Images, cfg *config.Config, importers []port.Importer) port.NoteHandler {
 	return &NoteHandler{
-		service:   service,
-		validator: validator,
-		jwt:       jwt,
-		cfg:       cfg,
+		service:           service,
+		attachmentService: attachmentService,
+		validator:         validator,
+		jwt:               jwt,
+		images:            images,
+		cfg:               cfg,
+		importers:         importers,
 	}
 }
 
@@ -57,22 +71,29 @@ func (h *NoteHandler) Create(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	result, err := h.service.Create(req)
+	result, err := h.service.Create(ctx.UserContext(), req)
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 
+	images, err := h.images.Resolve(ctx.UserContext(), result.CoverURL, result.Author.AvatarURL)
+	if err != nil {
+		return err
+	}
+
 	return ctx.Status(fiber.StatusCreated).JSON(domain.NoteResponse{
 		ID:          result.ID,
 		Title:       result.Title,
 		Description: result.Description,
-		CoverURL:    result.CoverURL,
+		Cover:       images.Image(result.CoverURL),
 		Content:     result.Content,
 		Visibility:  string(result.Visibility),
+		Notebook:    result.Notebook,
+		Tags:        result.Tags,
 		Author: domain.NoteAuthor{
-			ID:        result.Author.ID,
-			Name:      result.Author.Name,
-			AvatarURL: result.Author.AvatarURL,
+			ID:     result.Author.ID,
+			Name:   result.Author.Name,
+			Avatar: images.Avatar(result.Author.AvatarURL),
 		},
 		UpdatedAt: result.UpdatedAt,
 		CreatedAt: result.CreatedAt,
@@ -87,10 +108,15 @@ func (h *NoteHandler) Create(ctx *fiber.Ctx) error {
 // @Param author query string false "Filter notes by author"
 // @Param user_id query string false "Filter notes by user ID"
 // @Param visibility query string false "Filter notes by visibility"
-// @Param sort query string false "Sorting (e.g., +title, -created_at)"
-// @Param order query string false "Sort order (e.g., asc, desc)"
+// @Param filter query string false "Filter by id, title, description, visibility, user_id, created_at or updated_at (e.g., filter[title][contains]=go, filter[created_at][gte]=2024-01-01, filter[visibility][in]=public,unlisted)"
+// @Param fields query string false "Comma separated fields to return (id, title, description, cover, content, visibility, created_at, updated_at)"
+// @Param include query string false "Comma separated relations to embed (author, attachments), defaults to author"
+// @Param sort query string false "Comma separated sort fields (e.g., -updated_at,title)"
+// @Param order query string false "Sort order of fields without a + or - prefix (e.g., asc, desc)"
 // @Param page query int false "Page number"
 // @Param limit query int false "Number of items per page"
+// @Param cursor query string false "Cursor from next_cursor or prev_cursor of a previous page, replaces page"
+// @Param skip_count query bool false "Skip counting total_records and total_pages"
 // @Success 200 {object} domain.NotePaginationResponse "Successfully retrieved all notes"
 // @Router /notes [get]
 func (h *NoteHandler) GetAll(ctx *fiber.Ctx) error {
@@ -106,6 +132,18 @@ func (h *NoteHandler) GetAll(ctx *fiber.Ctx) error {
 		return err
 	}
 
+	filters, errs := util.ParseFilters(ctx.Queries(), domain.NoteFields)
+	if errs != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errs)
+	}
+	req.Filters = filters
+
+	fieldset, errs := util.ParseFieldset(ctx.Queries(), domain.NoteSelection)
+	if errs != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errs)
+	}
+	req.Fieldset = *fieldset
+
 	cookie := ctx.Cookies("access-token")
 	if cookie != "" {
 		claims, _ := h.jwt.ValidateToken(cookie, h.cfg.JWT.Access)
@@ -120,27 +158,49 @@ func (h *NoteHandler) GetAll(ctx *fiber.Ctx) error {
 		req.Visibility = "public"
 	}
 
-	result, err := h.service.GetAll(req, &metadata)
+	result, err := h.service.GetAll(ctx.UserContext(), req, &metadata)
 	if err != nil {
 		return err
 	}
 
+	var urls []string
 	for _, note := range result {
-		data = append(data, domain.NoteResponse{
+		urls = append(urls, note.CoverURL, note.Author.AvatarURL)
+	}
+	images, err := h.images.Resolve(ctx.UserContext(), urls...)
+	if err != nil {
+		return err
+	}
+
+	for _, note := range result {
+		response := domain.NoteResponse{
 			ID:          note.ID,
 			Title:       note.Title,
 			Description: note.Description,
-			CoverURL:    note.CoverURL,
+			Cover:       images.Image(note.CoverURL),
 			Content:     note.Content,
 			Visibility:  string(note.Visibility),
+			Notebook:    note.Notebook,
+			Tags:        note.Tags,
 			Author: domain.NoteAuthor{
-				ID:        note.Author.ID,
-				Name:      note.Author.Name,
-				AvatarURL: note.Author.AvatarURL,
+				ID:     note.Author.ID,
+				Name:   note.Author.Name,
+				Avatar: images.Avatar(note.Author.AvatarURL),
 			},
 			CreatedAt: note.CreatedAt,
 			UpdatedAt: note.UpdatedAt,
-		})
+			Fieldset:  fieldset,
+		}
+
+		if slices.Contains(fieldset.Include, "attachments") {
+			attachments := make([]domain.AttachmentResponse, 0, len(note.Attachments))
+			for _, attachment := range note.Attachments {
+				attachments = append(attachments, attachmentResponse(&attachment))
+			}
+			response.Attachments = &attachments
+		}
+
+		data = append(data, response)
 	}
 
 	return ctx.Status(fiber.StatusOK).JSON(domain.NotePaginationResponse{
@@ -155,57 +215,84 @@ func (h *NoteHandler) GetAll(ctx *fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "Note ID"
+// @Param format query string false "Content format (markdown, html)"
 // @Param page query int false "Page number"
 // @Param limit query int false "Number of items per page"
 // @Success 200 {object} domain.NoteResponse "Successfully retrieved a note by ID"
 // @Router /notes/{id} [get]
 func (h *NoteHandler) GetByID(ctx *fiber.Ctx) error {
 	var req domain.NoteRequest
+	var query domain.NoteFormatQuery
 	var result *domain.Note
 
 	if err := ctx.ParamsParser(&req); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 
+	if err := ctx.QueryParser(&query); err != nil {
+		return err
+	}
+
+	if err := h.validator.Validate(query); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(err)
+	}
+
 	cookie := ctx.Cookies("access-token")
 	if cookie != "" {
 		claims, err := h.jwt.ValidateToken(cookie, h.cfg.JWT.Access)
 		if err != nil {
-			data, err := h.service.GetByID(req.ID, nil)
+			data, err := h.service.GetByID(ctx.UserContext(), req.ID, nil)
 			if err != nil {
 				return err
 			}
 			result = data
 		} else {
-			data, err := h.service.GetByID(req.ID, claims)
+			data, err := h.service.GetByID(ctx.UserContext(), req.ID, claims)
 			if err != nil {
 				return err
 			}
 			result = data
 		}
 	} else {
-		data, err := h.service.GetByID(req.ID, nil)
+		data, err := h.service.GetByID(ctx.UserContext(), req.ID, nil)
 		if err != nil {
 			return err
 		}
 		result = data
 	}
 
-	return ctx.Status(fiber.StatusOK).JSON(domain.NoteResponse{
+	images, err := h.images.Resolve(ctx.UserContext(), result.CoverURL, result.Author.AvatarURL)
+	if err != nil {
+		return err
+	}
+
+	response := domain.NoteResponse{
 		ID:          result.ID,
 		Title:       result.Title,
 		Description: result.Description,
-		CoverURL:    result.CoverURL,
+		Cover:       images.Image(result.CoverURL),
 		Content:     result.Content,
 		Visibility:  string(result.Visibility),
+		Notebook:    result.Notebook,
+		Tags:        result.Tags,
 		Author: domain.NoteAuthor{
-			ID:        result.Author.ID,
-			Name:      result.Author.Name,
-			AvatarURL: result.Author.AvatarURL,
+			ID:     result.Author.ID,
+			Name:   result.Author.Name,
+			Avatar: images.Avatar(result.Author.AvatarURL),
 		},
 		UpdatedAt: result.UpdatedAt,
 		CreatedAt: result.CreatedAt,
-	})
+	}
+
+	if query.Format == "html" {
+		html, err := h.service.Render(result)
+		if err != nil {
+			return err
+		}
+		response.ContentHTML = html
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(response)
 }
 
 // @Summary Update a note by ID
@@ -238,7 +325,12 @@ func (h *NoteHandler) Update(ctx *fiber.Ctx) error {
 		return ctx.Status(fiber.StatusBadRequest).JSON(err)
 	}
 
-	result, err := h.service.Update(req, *claims)
+	result, err := h.service.Update(ctx.UserContext(), req, *claims)
+	if err != nil {
+		return err
+	}
+
+	images, err := h.images.Resolve(ctx.UserContext(), result.CoverURL, result.Author.AvatarURL)
 	if err != nil {
 		return err
 	}
@@ -247,13 +339,15 @@ func (h *NoteHandler) Update(ctx *fiber.Ctx) error {
 		ID:          result.ID,
 		Title:       result.Title,
 		Description: result.Description,
-		CoverURL:    result.CoverURL,
+		Cover:       images.Image(result.CoverURL),
 		Content:     result.Content,
 		Visibility:  string(result.Visibility),
+		Notebook:    result.Notebook,
+		Tags:        result.Tags,
 		Author: domain.NoteAuthor{
-			ID:        result.Author.ID,
-			Name:      result.Author.Name,
-			AvatarURL: result.Author.AvatarURL,
+			ID:     result.Author.ID,
+			Name:   result.Author.Name,
+			Avatar: images.Avatar(result.Author.AvatarURL),
 		},
 		UpdatedAt: result.UpdatedAt,
 		CreatedAt: result.CreatedAt,
@@ -278,9 +372,380 @@ func (h *NoteHandler) Delete(ctx *fiber.Ctx) error {
 	if !ok {
 		return fiber.NewError(fiber.StatusBadRequest, "failed to retrieve claims from context")
 	}
-	if err := h.service.Delete(req.ID, *claims); err != nil {
+	if err := h.service.Delete(ctx.UserContext(), req.ID, *claims); err != nil {
 		return err
 	}
 
 	return ctx.Status(fiber.StatusOK).JSON("successfully deleted note by id")
 }
+
+// @Summary Get trashed notes
+// @Description Retrieve the notes of the current user that have been moved to the trash
+// @Tags note
+// @Produce json
+// @Param sort query string false "Sorting (e.g., +title, -deleted_at)"
+// @Param order query string false "Sort order (e.g., asc, desc)"
+// @Param page query int false "Page number"
+// @Param limit query int false "Number of items per page"
+// @Success 200 {object} domain.NotePaginationResponse "Successfully retrieved trashed notes"
+// @Router /notes/trash [get]
+func (h *NoteHandler) GetTrash(ctx *fiber.Ctx) error {
+	var metadata domain.Metadata
+	var data []domain.NoteResponse
+
+	if err := ctx.QueryParser(&metadata); err != nil {
+		return err
+	}
+
+	claims, ok := ctx.Locals("claims").(*domain.Claims)
+	if !ok {
+		return fiber.NewError(fiber.StatusBadRequest, "failed to retrieve claims from context")
+	}
+
+	result, err := h.service.GetTrash(ctx.UserContext(), &metadata, *claims)
+	if err != nil {
+		return err
+	}
+
+	var urls []string
+	for _, note := range result {
+		urls = append(urls, note.CoverURL, note.Author.AvatarURL)
+	}
+	images, err := h.images.Resolve(ctx.UserContext(), urls...)
+	if err != nil {
+		return err
+	}
+
+	for _, note := range result {
+		data = append(data, domain.NoteResponse{
+			ID:          note.ID,
+			Title:       note.Title,
+			Description: note.Description,
+			Cover:       images.Image(note.CoverURL),
+			Content:     note.Content,
+			Visibility:  string(note.Visibility),
+			Notebook:    note.Notebook,
+			Tags:        note.Tags,
+			Author: domain.NoteAuthor{
+				ID:     note.Author.ID,
+				Name:   note.Author.Name,
+				Avatar: images.Avatar(note.Author.AvatarURL),
+			},
+			CreatedAt: note.CreatedAt,
+			UpdatedAt: note.UpdatedAt,
+			DeletedAt: &note.DeletedAt.Time,
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(domain.NotePaginationResponse{
+		Notes:    data,
+		Metadata: metadata,
+	})
+}
+
+// @Summary Restore a trashed note by ID
+// @Description Move a note out of the trash based on the provided ID
+// @Tags note
+// @Produce json
+// @Param id path int true "Note ID"
+// @Success 200 {object} domain.NoteResponse "Successfully restored a note by ID"
+// @Router /notes/{id}/restore [post]
+func (h *NoteHandler) Restore(ctx *fiber.Ctx) error {
+	var req domain.NoteRequest
+
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	claims, ok := ctx.Locals("claims").(*domain.Claims)
+	if !ok {
+		return fiber.NewError(fiber.StatusBadRequest, "failed to retrieve claims from context")
+	}
+
+	result, err := h.service.Restore(ctx.UserContext(), req.ID, *claims)
+	if err != nil {
+		return err
+	}
+
+	images, err := h.images.Resolve(ctx.UserContext(), result.CoverURL, result.Author.AvatarURL)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(domain.NoteResponse{
+		ID:          result.ID,
+		Title:       result.Title,
+		Description: result.Description,
+		Cover:       images.Image(result.CoverURL),
+		Content:     result.Content,
+		Visibility:  string(result.Visibility),
+		Notebook:    result.Notebook,
+		Tags:        result.Tags,
+		Author: domain.NoteAuthor{
+			ID:     result.Author.ID,
+			Name:   result.Author.Name,
+			Avatar: images.Avatar(result.Author.AvatarURL),
+		},
+		UpdatedAt: result.UpdatedAt,
+		CreatedAt: result.CreatedAt,
+	})
+}
+
+// @Summary Permanently delete a trashed note by ID
+// @Description Permanently delete a note from the trash based on the provided ID
+// @Tags note
+// @Produce json
+// @Param id path int true "Note ID"
+// @Success 200 "Successfully deleted a note permanently"
+// @Router /notes/trash/{id} [delete]
+func (h *NoteHandler) ForceDelete(ctx *fiber.Ctx) error {
+	var req domain.NoteRequest
+
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	claims, ok := ctx.Locals("claims").(*domain.Claims)
+	if !ok {
+		return fiber.NewError(fiber.StatusBadRequest, "failed to retrieve claims from context")
+	}
+
+	if err := h.service.ForceDelete(ctx.UserContext(), req.ID, *claims); err != nil {
+		return err
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON("successfully deleted note permanently")
+}
+
+// @Summary Export notes
+// @Description Download all notes of the user as a zip archive with one Markdown file per note and its metadata as YAML front matter
+// @Tags note
+// @Produce application/zip
+// @Success 200 {file} file "Zip archive of notes"
+// @Router /notes/export [get]
+func (h *NoteHandler) Export(ctx *fiber.Ctx) error {
+	claims, ok := ctx.Locals("claims").(*domain.Claims)
+	if !ok {
+		return fiber.NewError(fiber.StatusBadRequest, "failed to retrieve claims from context")
+	}
+
+	ctx.Set(fiber.HeaderContentType, "application/zip")
+	ctx.Set(fiber.HeaderContentDisposition, `attachment; filename="notes.zip"`)
+
+	// the writer runs after the handler returns, ctx is reused by then and the
+	// deadline of the request has to be left behind
+	userCtx := context.WithoutCancel(ctx.UserContext())
+
+	// the archive is written while notes are read in batches, so the status is
+	// already sent when something fails and all we can do is log and stop
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		archive := util.NewNoteArchive(w)
+
+		err := h.service.Export(userCtx, *claims, func(notes []domain.Note) error {
+			for _, note := range notes {
+				if err := archive.Add(note); err != nil {
+					return err
+				}
+			}
+
+			return w.Flush()
+		})
+		if err != nil {
+			util.Logger(userCtx).Error("failed to export notes", "err", err)
+			return
+		}
+
+		if err := archive.Close(); err != nil {
+			util.Logger(userCtx).Error("failed to export notes", "err", err)
+			return
+		}
+		w.Flush()
+	})
+
+	return nil
+}
+
+// @Summary Import notes
+// @Description Import notes from a Markdown file or zip archive, an Evernote ENEX export or a Google Keep Takeout archive; the format is detected from the file unless given, and titles that already exist are skipped, renamed or overwritten depending on the conflict strategy
+// @Tags note
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "File to import"
+// @Param format query string false "Import format (markdown, enex, keep)"
+// @Param conflict query string false "Conflict strategy (skip, rename, overwrite)"
+// @Success 200 {object} domain.NoteImportResponse "Import report"
+// @Router /notes/import [post]
+func (h *NoteHandler) Import(ctx *fiber.Ctx) error {
+	var query domain.NoteImportQuery
+	var response domain.NoteImportResponse
+
+	if err := ctx.QueryParser(&query); err != nil {
+		return err
+	}
+
+	if err := h.validator.Validate(query); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(err)
+	}
+
+	conflict := domain.ImportSkip
+	if query.Conflict != "" {
+		conflict = domain.ImportConflict(query.Conflict)
+	}
+
+	claims, ok := ctx.Locals("claims").(*domain.Claims)
+	if !ok {
+		return fiber.NewError(fiber.StatusBadRequest, "failed to retrieve claims from context")
+	}
+
+	header, err := ctx.FormFile("file")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "file is required")
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var importer port.Importer
+	for _, candidate := range h.importers {
+		if query.Format == candidate.Format() || (query.Format == "" && candidate.Detect(header.Filename, file, header.Size)) {
+			importer = candidate
+			break
+		}
+	}
+	if importer == nil {
+		return fiber.NewError(fiber.StatusBadRequest, "unsupported import file")
+	}
+
+	// the request timeout would cut a large import short and leave the notes
+	// written so far without a report, it gets a bound of its own
+	importCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx.UserContext()), h.cfg.Import.Timeout)
+	defer cancel()
+
+	err = importer.Import(header.Filename, file, header.Size, func(note domain.ImportedNote) error {
+		if len(response.Results) == maxImportNotes {
+			return errImportLimit
+		}
+		if err := importCtx.Err(); err != nil {
+			return err
+		}
+
+		result := h.importNote(importCtx, note, conflict, claims.UserID)
+		switch result.Status {
+		case "failed":
+			response.Failed++
+		case "skipped":
+			response.Skipped++
+		default:
+			response.Imported++
+		}
+		response.Results = append(response.Results, result)
+
+		return nil
+	})
+	if errors.Is(err, errImportLimit) || errors.Is(err, context.DeadlineExceeded) {
+		response.Truncated = true
+	} else if err != nil {
+		return err
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(response)
+}
+
+// maxImportNotes bounds the notes imported by a single request, the report
+// is marked as truncated when an upload contains more.
+const maxImportNotes = 500
+
+var errImportLimit = errors.New("import limit reached")
+
+func (h *NoteHandler) importNote(ctx context.Context, note domain.ImportedNote, conflict domain.ImportConflict, userID uint) domain.NoteImportResult {
+	result := domain.NoteImportResult{
+		File:     note.File,
+		Status:   "failed",
+		Notebook: note.Notebook,
+		Tags:     note.Tags,
+	}
+
+	if note.Err != nil {
+		result.Error = note.Err.Error()
+		return result
+	}
+
+	req := domain.NoteRequest{
+		Title:       note.Title,
+		Description: note.Description,
+		CoverURL:    note.CoverURL,
+		Content:     note.Content,
+		Visibility:  note.Visibility,
+		Notebook:    note.Notebook,
+		Tags:        note.Tags,
+		UserID:      userID,
+		CreatedAt:   note.CreatedAt,
+		UpdatedAt:   note.UpdatedAt,
+	}
+	if req.Visibility == "" {
+		req.Visibility = string(domain.Private)
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		result.Error = err.Error
+		return result
+	}
+
+	if err := conform.Strings(&req); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	data, status, err := h.service.Import(ctx, req, conflict)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Status = status
+	result.NoteID = data.ID
+	result.Title = data.Title
+
+	if status != "skipped" && len(note.Attachments) > 0 {
+		h.importAttachments(ctx, data, note.Attachments, userID, &result)
+	}
+
+	return result
+}
+
+// importAttachments attaches the files of an imported note to it, within the
+// quota of the user, and points the links to them at the stored files. Files
+// the note does not link to are linked at its end. A file that cannot be
+// stored is reported as skipped and its link is left as it was.
+func (h *NoteHandler) importAttachments(ctx context.Context, note *domain.Note, attachments []domain.ImportedAttachment, userID uint, result *domain.NoteImportResult) {
+	claims := domain.Claims{UserID: userID}
+	content := note.Content
+
+	for _, attachment := range attachments {
+		stored, err := h.attachmentService.Create(ctx, note.ID, attachment.Name, attachment.MimeType, bytes.NewReader(attachment.Data), int64(len(attachment.Data)), claims)
+		if err != nil {
+			result.SkippedAttachments = append(result.SkippedAttachments, attachment.Name+": "+err.Error())
+			continue
+		}
+		result.Attachments++
+
+		url := attachmentResponse(stored).URL
+		if link := "](" + attachment.Name + ")"; strings.Contains(content, link) {
+			content = strings.ReplaceAll(content, link, "]("+url+")")
+		} else if strings.HasPrefix(stored.ContentType, "image/") {
+			content += fmt.Sprintf("\n\n![%s](%s)", attachment.Name, url)
+		} else {
+			content += fmt.Sprintf("\n\n[%s](%s)", attachment.Name, url)
+		}
+	}
+
+	if content == note.Content {
+		return
+	}
+	if _, err := h.service.Update(ctx, domain.NoteUpdateRequest{ID: note.ID, Content: content}, claims); err != nil {
+		result.Error = "failed to link attachments: " + err.Error()
+	}
+}
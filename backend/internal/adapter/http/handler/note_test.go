@@ -1,12 +1,20 @@
 package handler
 
 import (
+	"archive/zip"
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http/httptest"
+	"reflect"
 	"testing"
+	"time"
 
+	"github.com/shironxn/blanknotes/internal/adapter/http/middleware"
+	"github.com/shironxn/blanknotes/internal/adapter/importer"
 	"github.com/shironxn/blanknotes/internal/config"
 	"github.com/shironxn/blanknotes/internal/core/domain"
 	"github.com/shironxn/blanknotes/internal/core/port"
@@ -65,7 +73,7 @@ func TestNoteHandler_Create(t *testing.T) {
 			name: "success",
 			fields: fields{
 				service: func() port.NoteService {
-					mockNoteService.EXPECT().Create(mock.AnythingOfType("domain.NoteRequest")).Return(noteEntity, nil).Once()
+					mockNoteService.EXPECT().Create(mock.Anything, mock.AnythingOfType("domain.NoteRequest")).Return(noteEntity, nil).Once()
 					return mockNoteService
 				}(),
 				validator: validator,
@@ -130,13 +138,14 @@ func TestNoteHandler_GetAll(t *testing.T) {
 	tests := []struct {
 		name   string
 		fields fields
+		query  string
 		code   int
 	}{
 		{
 			name: "success",
 			fields: fields{
 				service: func() port.NoteService {
-					mockNoteService.EXPECT().GetAll(mock.AnythingOfType("domain.NoteQuery"), mock.AnythingOfType("*domain.Metadata")).Return([]domain.Note{
+					mockNoteService.EXPECT().GetAll(mock.Anything, mock.AnythingOfType("domain.NoteQuery"), mock.AnythingOfType("*domain.Metadata")).Return([]domain.Note{
 						*noteEntity,
 					}, nil).Once()
 					return mockNoteService
@@ -145,6 +154,31 @@ func TestNoteHandler_GetAll(t *testing.T) {
 			},
 			code: fiber.StatusOK,
 		},
+		{
+			name: "success with filters",
+			fields: fields{
+				service: func() port.NoteService {
+					mockNoteService.EXPECT().GetAll(mock.Anything, mock.MatchedBy(func(req domain.NoteQuery) bool {
+						return len(req.Filters) == 2 && req.Filters[0].Field == "title" && req.Filters[1].Operator == "in"
+					}), mock.AnythingOfType("*domain.Metadata")).Return([]domain.Note{
+						*noteEntity,
+					}, nil).Once()
+					return mockNoteService
+				}(),
+				jwt: jwt,
+			},
+			query: "?filter[title][contains]=go&filter[visibility][in]=public,unlisted&sort=-updated_at,title",
+			code:  fiber.StatusOK,
+		},
+		{
+			name: "invalid filter",
+			fields: fields{
+				service: mockNoteService,
+				jwt:     jwt,
+			},
+			query: "?filter[password]=secret",
+			code:  fiber.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
@@ -157,7 +191,7 @@ func TestNoteHandler_GetAll(t *testing.T) {
 			app := config.NewFiber()
 			app.Get("/api/v1/notes", h.GetAll)
 
-			req := httptest.NewRequest(fiber.MethodGet, "/api/v1/notes", nil)
+			req := httptest.NewRequest(fiber.MethodGet, "/api/v1/notes"+tt.query, nil)
 			req.Header.Set("Content-Type", "application/json")
 
 			res, err := app.Test(req)
@@ -169,17 +203,20 @@ func TestNoteHandler_GetAll(t *testing.T) {
 
 func TestNoteHandler_GetByID(t *testing.T) {
 	type fields struct {
-		service port.NoteService
-		jwt     util.JWT
+		service   port.NoteService
+		jwt       util.JWT
+		validator *util.Validator
 	}
 
 	type args struct {
 		req    uint
+		format string
 		claims *domain.Claims
 	}
 
 	mockNoteService := mocks.NewNoteService(t)
 	jwt := util.NewJWT(&config.Config{})
+	validator, _ := util.NewValidator()
 
 	tests := []struct {
 		name   string
@@ -191,20 +228,50 @@ func TestNoteHandler_GetByID(t *testing.T) {
 			name: "success",
 			fields: fields{
 				service: func() port.NoteService {
-					mockNoteService.EXPECT().GetByID(mock.AnythingOfType("uint"), mock.AnythingOfType("*domain.Claims")).Return(noteEntity, nil).Once()
+					mockNoteService.EXPECT().GetByID(mock.Anything, mock.AnythingOfType("uint"), mock.AnythingOfType("*domain.Claims")).Return(noteEntity, nil).Once()
 					return mockNoteService
 				}(),
-				jwt: jwt,
+				jwt:       jwt,
+				validator: validator,
 			},
 			code: fiber.StatusOK,
 		},
+		{
+			name: "html format",
+			fields: fields{
+				service: func() port.NoteService {
+					mockNoteService.EXPECT().GetByID(mock.Anything, mock.AnythingOfType("uint"), mock.AnythingOfType("*domain.Claims")).Return(noteEntity, nil).Once()
+					mockNoteService.EXPECT().Render(noteEntity).Return("<p>is the best</p>", nil).Once()
+					return mockNoteService
+				}(),
+				jwt:       jwt,
+				validator: validator,
+			},
+			args: args{
+				format: "html",
+			},
+			code: fiber.StatusOK,
+		},
+		{
+			name: "invalid format",
+			fields: fields{
+				service:   mockNoteService,
+				jwt:       jwt,
+				validator: validator,
+			},
+			args: args{
+				format: "pdf",
+			},
+			code: fiber.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			h := &NoteHandler{
-				service: tt.fields.service,
-				jwt:     tt.fields.jwt,
+				service:   tt.fields.service,
+				jwt:       tt.fields.jwt,
+				validator: tt.fields.validator,
 			}
 
 			app := config.NewFiber()
@@ -217,7 +284,7 @@ func TestNoteHandler_GetByID(t *testing.T) {
 			requestBody, err := json.Marshal(tt.args.req)
 			assert.NoError(t, err)
 
-			req := httptest.NewRequest(fiber.MethodGet, fmt.Sprintf("/api/v1/note/%v", tt.args.req), bytes.NewBuffer(requestBody))
+			req := httptest.NewRequest(fiber.MethodGet, fmt.Sprintf("/api/v1/note/%v?format=%v", tt.args.req, tt.args.format), bytes.NewBuffer(requestBody))
 			req.Header.Set("Content-Type", "application/json")
 
 			res, err := app.Test(req)
@@ -254,7 +321,7 @@ func TestNoteHandler_Update(t *testing.T) {
 			name: "success",
 			fields: fields{
 				service: func() port.NoteService {
-					mockNoteService.EXPECT().Update(mock.AnythingOfType("domain.NoteUpdateRequest"), mock.AnythingOfType("domain.Claims")).Return(noteEntity, nil).Once()
+					mockNoteService.EXPECT().Update(mock.Anything, mock.AnythingOfType("domain.NoteUpdateRequest"), mock.AnythingOfType("domain.Claims")).Return(noteEntity, nil).Once()
 					return mockNoteService
 				}(),
 				jwt:       jwt,
@@ -266,7 +333,7 @@ func TestNoteHandler_Update(t *testing.T) {
 			name: "permission denied",
 			fields: fields{
 				service: func() port.NoteService {
-					mockNoteService.EXPECT().Update(mock.AnythingOfType("domain.NoteUpdateRequest"), mock.AnythingOfType("domain.Claims")).Return(nil, fiber.NewError(fiber.StatusForbidden, "user does not have permission to perform this action")).Once()
+					mockNoteService.EXPECT().Update(mock.Anything, mock.AnythingOfType("domain.NoteUpdateRequest"), mock.AnythingOfType("domain.Claims")).Return(nil, fiber.NewError(fiber.StatusForbidden, "user does not have permission to perform this action")).Once()
 					return mockNoteService
 				}(),
 				validator: validator,
@@ -337,7 +404,7 @@ func TestNoteHandler_Delete(t *testing.T) {
 			name: "success",
 			fields: fields{
 				service: func() port.NoteService {
-					mockNoteService.EXPECT().Delete(mock.AnythingOfType("uint"), mock.AnythingOfType("domain.Claims")).Return(nil).Once()
+					mockNoteService.EXPECT().Delete(mock.Anything, mock.AnythingOfType("uint"), mock.AnythingOfType("domain.Claims")).Return(nil).Once()
 					return mockNoteService
 				}(),
 			},
@@ -347,7 +414,7 @@ func TestNoteHandler_Delete(t *testing.T) {
 			name: "permission denied",
 			fields: fields{
 				service: func() port.NoteService {
-					mockNoteService.EXPECT().Delete(mock.AnythingOfType("uint"), mock.AnythingOfType("domain.Claims")).Return(fiber.NewError(fiber.StatusForbidden, "user does not have permission to perform this action")).Once()
+					mockNoteService.EXPECT().Delete(mock.Anything, mock.AnythingOfType("uint"), mock.AnythingOfType("domain.Claims")).Return(fiber.NewError(fiber.StatusForbidden, "user does not have permission to perform this action")).Once()
 					return mockNoteService
 				}(),
 			},
@@ -388,3 +455,573 @@ func TestNoteHandler_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestNoteHandler_GetTrash(t *testing.T) {
+	type fields struct {
+		service port.NoteService
+	}
+
+	type args struct {
+		claims domain.Claims
+	}
+
+	mockNoteService := mocks.NewNoteService(t)
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		code   int
+	}{
+		{
+			name: "success",
+			fields: fields{
+				service: func() port.NoteService {
+					mockNoteService.EXPECT().GetTrash(mock.Anything, mock.AnythingOfType("*domain.Metadata"), mock.AnythingOfType("domain.Claims")).Return([]domain.Note{
+						*noteEntity,
+					}, nil).Once()
+					return mockNoteService
+				}(),
+			},
+			args: args{
+				claims: domain.Claims{
+					UserID: noteEntity.UserID,
+				},
+			},
+			code: fiber.StatusOK,
+		},
+		{
+			name: "empty trash",
+			fields: fields{
+				service: func() port.NoteService {
+					mockNoteService.EXPECT().GetTrash(mock.Anything, mock.AnythingOfType("*domain.Metadata"), mock.AnythingOfType("domain.Claims")).Return(nil, fiber.NewError(fiber.StatusNotFound, "notes not found")).Once()
+					return mockNoteService
+				}(),
+			},
+			code: fiber.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &NoteHandler{
+				service: tt.fields.service,
+			}
+
+			app := config.NewFiber()
+			app.Use(func(ctx *fiber.Ctx) error {
+				ctx.Locals("claims", &tt.args.claims)
+				return ctx.Next()
+			})
+			app.Get("/api/v1/notes/trash", h.GetTrash)
+
+			req := httptest.NewRequest(fiber.MethodGet, "/api/v1/notes/trash", nil)
+
+			res, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, res.StatusCode)
+		})
+	}
+}
+
+func TestNoteHandler_Restore(t *testing.T) {
+	type fields struct {
+		service port.NoteService
+	}
+
+	type args struct {
+		req    uint
+		claims domain.Claims
+	}
+
+	mockNoteService := mocks.NewNoteService(t)
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		code   int
+	}{
+		{
+			name: "success",
+			fields: fields{
+				service: func() port.NoteService {
+					mockNoteService.EXPECT().Restore(mock.Anything, noteEntity.ID, mock.AnythingOfType("domain.Claims")).Return(noteEntity, nil).Once()
+					return mockNoteService
+				}(),
+			},
+			args: args{
+				req: noteEntity.ID,
+			},
+			code: fiber.StatusOK,
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				service: func() port.NoteService {
+					mockNoteService.EXPECT().Restore(mock.Anything, noteEntity.ID, mock.AnythingOfType("domain.Claims")).Return(nil, fiber.NewError(fiber.StatusForbidden, "user does not have permission to perform this action")).Once()
+					return mockNoteService
+				}(),
+			},
+			args: args{
+				req: noteEntity.ID,
+			},
+			code: fiber.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &NoteHandler{
+				service: tt.fields.service,
+			}
+
+			app := config.NewFiber()
+			app.Use(func(ctx *fiber.Ctx) error {
+				ctx.Locals("claims", &tt.args.claims)
+				return ctx.Next()
+			})
+			app.Post("/api/v1/notes/:id/restore", h.Restore)
+
+			req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/notes/%v/restore", tt.args.req), nil)
+
+			res, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, res.StatusCode)
+		})
+	}
+}
+
+func TestNoteHandler_ForceDelete(t *testing.T) {
+	type fields struct {
+		service port.NoteService
+	}
+
+	type args struct {
+		req    uint
+		claims domain.Claims
+	}
+
+	mockNoteService := mocks.NewNoteService(t)
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		code   int
+	}{
+		{
+			name: "success",
+			fields: fields{
+				service: func() port.NoteService {
+					mockNoteService.EXPECT().ForceDelete(mock.Anything, noteEntity.ID, mock.AnythingOfType("domain.Claims")).Return(nil).Once()
+					return mockNoteService
+				}(),
+			},
+			args: args{
+				req: noteEntity.ID,
+			},
+			code: fiber.StatusOK,
+		},
+		{
+			name: "not in trash",
+			fields: fields{
+				service: func() port.NoteService {
+					mockNoteService.EXPECT().ForceDelete(mock.Anything, noteEntity.ID, mock.AnythingOfType("domain.Claims")).Return(fiber.NewError(fiber.StatusNotFound, "note not found in trash")).Once()
+					return mockNoteService
+				}(),
+			},
+			args: args{
+				req: noteEntity.ID,
+			},
+			code: fiber.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &NoteHandler{
+				service: tt.fields.service,
+			}
+
+			app := config.NewFiber()
+			app.Use(func(ctx *fiber.Ctx) error {
+				ctx.Locals("claims", &tt.args.claims)
+				return ctx.Next()
+			})
+			app.Delete("/api/v1/notes/trash/:id", h.ForceDelete)
+
+			req := httptest.NewRequest(fiber.MethodDelete, fmt.Sprintf("/api/v1/notes/trash/%v", tt.args.req), nil)
+
+			res, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, res.StatusCode)
+		})
+	}
+}
+
+func TestNoteHandler_Export(t *testing.T) {
+	type fields struct {
+		service port.NoteService
+	}
+
+	type args struct {
+		claims domain.Claims
+	}
+
+	mockNoteService := mocks.NewNoteService(t)
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		code   int
+		files  []string
+	}{
+		{
+			name: "success",
+			fields: fields{
+				service: func() port.NoteService {
+					mockNoteService.EXPECT().Export(mock.Anything, mock.AnythingOfType("domain.Claims"), mock.Anything).RunAndReturn(func(_ context.Context, claims domain.Claims, batch func([]domain.Note) error) error {
+						duplicate := *noteEntity
+						duplicate.Title = "Golang!"
+						return batch([]domain.Note{*noteEntity, duplicate})
+					}).Once()
+					return mockNoteService
+				}(),
+			},
+			args: args{
+				claims: domain.Claims{
+					UserID: noteEntity.UserID,
+				},
+			},
+			code:  fiber.StatusOK,
+			files: []string{"golang.md", "golang-2.md"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &NoteHandler{
+				service: tt.fields.service,
+			}
+
+			app := config.NewFiber()
+			app.Use(func(ctx *fiber.Ctx) error {
+				ctx.Locals("claims", &tt.args.claims)
+				return ctx.Next()
+			})
+			app.Get("/api/v1/notes/export", h.Export)
+
+			req := httptest.NewRequest(fiber.MethodGet, "/api/v1/notes/export", nil)
+
+			res, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, res.StatusCode)
+
+			body, err := io.ReadAll(res.Body)
+			assert.NoError(t, err)
+
+			archive, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+			assert.NoError(t, err)
+
+			var files []string
+			for _, file := range archive.File {
+				files = append(files, file.Name)
+			}
+			assert.Equal(t, tt.files, files)
+
+			file, err := archive.File[0].Open()
+			assert.NoError(t, err)
+			content, err := io.ReadAll(file)
+			assert.NoError(t, err)
+			assert.Contains(t, string(content), "---\ntitle: golang\n")
+			assert.Contains(t, string(content), "---\n\nis the best\n")
+		})
+	}
+}
+
+func TestNoteHandler_Import(t *testing.T) {
+	type fields struct {
+		service           port.NoteService
+		attachmentService port.AttachmentService
+		validator         *util.Validator
+	}
+
+	type args struct {
+		filename string
+		data     []byte
+		conflict string
+		claims   domain.Claims
+	}
+
+	mockNoteService := mocks.NewNoteService(t)
+	mockAttachmentService := mocks.NewAttachmentService(t)
+	validator, _ := util.NewValidator()
+	cfg := &config.Config{}
+	cfg.Import.DefaultCoverURL = "http://localhost:3000/cover.jpg"
+	cfg.Import.Timeout = time.Minute
+	importers := []port.Importer{importer.NewMarkdownImporter(cfg), importer.NewENEXImporter(cfg)}
+
+	markdown := []byte("---\ntitle: golang\ndescription: lets go\ncover_url: https://example.com/cover.png\nvisibility: public\ntags: [go]\n---\n\nis the best\n")
+
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	for name, data := range map[string][]byte{
+		"docs/golang.md": markdown,
+		"docs/broken.md": []byte("---\ntitle: [unclosed\n---\n\nbroken front matter"),
+		"docs/image.png": []byte("not markdown"),
+	} {
+		file, _ := zw.Create(name)
+		file.Write(data)
+	}
+	zw.Close()
+
+	enex := []byte(`<en-export><note><title>files</title>
+<content><![CDATA[<en-note><div>two files</div><en-media hash="5d41402abc4b2a76b9719d911017c592" type="text/plain"/></en-note>]]></content>
+<resource><data encoding="base64">aGVsbG8=</data><mime>text/plain</mime><resource-attributes><file-name>hello.txt</file-name></resource-attributes></resource>
+<resource><data encoding="base64">YmlnIGZpbGU=</data><mime>application/zip</mime><resource-attributes><file-name>big.zip</file-name></resource-attributes></resource>
+</note></en-export>`)
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		code   int
+		want   *domain.NoteImportResponse
+	}{
+		{
+			name: "markdown file",
+			fields: fields{
+				service: func() port.NoteService {
+					mockNoteService.EXPECT().Import(mock.Anything, mock.MatchedBy(func(req domain.NoteRequest) bool {
+						return req.Title == "Golang" && req.Content == "is the best" && req.UserID == noteEntity.UserID && reflect.DeepEqual(req.Tags, []string{"go"})
+					}), domain.ImportRename).Return(noteEntity, "renamed", nil).Once()
+					return mockNoteService
+				}(),
+				validator: validator,
+			},
+			args: args{
+				filename: "golang.md",
+				data:     markdown,
+				conflict: "rename",
+				claims:   domain.Claims{UserID: noteEntity.UserID},
+			},
+			code: fiber.StatusOK,
+			want: &domain.NoteImportResponse{
+				Imported: 1,
+				Results: []domain.NoteImportResult{
+					{File: "golang.md", Status: "renamed", NoteID: noteEntity.ID, Title: noteEntity.Title, Tags: []string{"go"}},
+				},
+			},
+		},
+		{
+			name: "markdown without front matter",
+			fields: fields{
+				service: func() port.NoteService {
+					mockNoteService.EXPECT().Import(mock.Anything, mock.MatchedBy(func(req domain.NoteRequest) bool {
+						return req.Title == "Plain" && req.Description == "Just some text" && req.CoverURL == cfg.Import.DefaultCoverURL
+					}), domain.ImportSkip).Return(noteEntity, "created", nil).Once()
+					return mockNoteService
+				}(),
+				validator: validator,
+			},
+			args: args{
+				filename: "plain.md",
+				data:     []byte("# Just some text\n\nwithout any front matter\n"),
+				claims:   domain.Claims{UserID: noteEntity.UserID},
+			},
+			code: fiber.StatusOK,
+			want: &domain.NoteImportResponse{
+				Imported: 1,
+				Results: []domain.NoteImportResult{
+					{File: "plain.md", Status: "created", NoteID: noteEntity.ID, Title: noteEntity.Title},
+				},
+			},
+		},
+		{
+			name: "zip archive",
+			fields: fields{
+				service: func() port.NoteService {
+					mockNoteService.EXPECT().Import(mock.Anything, mock.MatchedBy(func(req domain.NoteRequest) bool {
+						return req.Notebook == "docs"
+					}), domain.ImportSkip).Return(noteEntity, "skipped", nil).Once()
+					return mockNoteService
+				}(),
+				validator: validator,
+			},
+			args: args{
+				filename: "notes.zip",
+				data:     archive.Bytes(),
+				claims:   domain.Claims{UserID: noteEntity.UserID},
+			},
+			code: fiber.StatusOK,
+		},
+		{
+			name: "enex with attachments",
+			fields: fields{
+				service: func() port.NoteService {
+					mockNoteService.EXPECT().Import(mock.Anything, mock.AnythingOfType("domain.NoteRequest"), domain.ImportRename).
+						RunAndReturn(func(_ context.Context, req domain.NoteRequest, _ domain.ImportConflict) (*domain.Note, string, error) {
+							return &domain.Note{Model: gorm.Model{ID: noteEntity.ID}, Title: req.Title, Content: req.Content}, "created", nil
+						}).Once()
+					mockNoteService.EXPECT().Update(mock.Anything, domain.NoteUpdateRequest{ID: noteEntity.ID, Content: "two files\n\n[hello.txt](/api/v1/notes/1/attachments/3)"}, domain.Claims{UserID: noteEntity.UserID}).Return(noteEntity, nil).Once()
+					return mockNoteService
+				}(),
+				attachmentService: func() port.AttachmentService {
+					mockAttachmentService.EXPECT().Create(mock.Anything, noteEntity.ID, "hello.txt", "text/plain", mock.Anything, int64(5), domain.Claims{UserID: noteEntity.UserID}).Return(&domain.Attachment{ID: 3, NoteID: noteEntity.ID, Name: "hello.txt", ContentType: "text/plain"}, nil).Once()
+					mockAttachmentService.EXPECT().Create(mock.Anything, noteEntity.ID, "big.zip", "application/zip", mock.Anything, int64(8), domain.Claims{UserID: noteEntity.UserID}).Return(nil, fiber.NewError(fiber.StatusRequestEntityTooLarge, "storage quota exceeded, 10 of 10 bytes used")).Once()
+					return mockAttachmentService
+				}(),
+				validator: validator,
+			},
+			args: args{
+				filename: "files.enex",
+				data:     enex,
+				conflict: "rename",
+				claims:   domain.Claims{UserID: noteEntity.UserID},
+			},
+			code: fiber.StatusOK,
+			want: &domain.NoteImportResponse{
+				Imported: 1,
+				Results: []domain.NoteImportResult{
+					{
+						File:               "files.enex#1",
+						Status:             "created",
+						NoteID:             noteEntity.ID,
+						Title:              "Files",
+						Notebook:           "files",
+						Attachments:        1,
+						SkippedAttachments: []string{"big.zip: storage quota exceeded, 10 of 10 bytes used"},
+					},
+				},
+			},
+		},
+		{
+			name: "unsupported file",
+			fields: fields{
+				service:   mockNoteService,
+				validator: validator,
+			},
+			args: args{
+				filename: "notes.txt",
+				data:     []byte("hello"),
+			},
+			code: fiber.StatusBadRequest,
+		},
+		{
+			name: "invalid conflict strategy",
+			fields: fields{
+				service:   mockNoteService,
+				validator: validator,
+			},
+			args: args{
+				filename: "golang.md",
+				data:     markdown,
+				conflict: "merge",
+			},
+			code: fiber.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &NoteHandler{
+				service:           tt.fields.service,
+				attachmentService: tt.fields.attachmentService,
+				validator:         tt.fields.validator,
+				cfg:               cfg,
+				importers:         importers,
+			}
+
+			app := config.NewFiber()
+			app.Use(func(ctx *fiber.Ctx) error {
+				ctx.Locals("claims", &tt.args.claims)
+				return ctx.Next()
+			})
+			app.Post("/api/v1/notes/import", h.Import)
+
+			var body bytes.Buffer
+			writer := multipart.NewWriter(&body)
+			part, err := writer.CreateFormFile("file", tt.args.filename)
+			assert.NoError(t, err)
+			part.Write(tt.args.data)
+			writer.Close()
+
+			req := httptest.NewRequest(fiber.MethodPost, "/api/v1/notes/import?conflict="+tt.args.conflict, &body)
+			req.Header.Set("Content-Type", writer.FormDataContentType())
+
+			res, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, res.StatusCode)
+
+			if tt.code != fiber.StatusOK {
+				return
+			}
+
+			var got domain.NoteImportResponse
+			assert.NoError(t, json.NewDecoder(res.Body).Decode(&got))
+
+			if tt.want != nil {
+				assert.Equal(t, *tt.want, got)
+				return
+			}
+
+			assert.Equal(t, 0, got.Imported)
+			assert.Equal(t, 1, got.Skipped)
+			assert.Equal(t, 1, got.Failed)
+			assert.Len(t, got.Results, 2)
+		})
+	}
+}
+
+func TestNoteHandler_ImportTimeout(t *testing.T) {
+	mockNoteService := mocks.NewNoteService(t)
+	validator, _ := util.NewValidator()
+	cfg := &config.Config{}
+	cfg.Import.DefaultCoverURL = "http://localhost:3000/cover.jpg"
+	cfg.Import.Timeout = time.Minute
+
+	// the import outlives the timeout of the request
+	mockNoteService.EXPECT().Import(mock.Anything, mock.AnythingOfType("domain.NoteRequest"), domain.ImportSkip).
+		RunAndReturn(func(ctx context.Context, req domain.NoteRequest, _ domain.ImportConflict) (*domain.Note, string, error) {
+			time.Sleep(50 * time.Millisecond)
+			if err := ctx.Err(); err != nil {
+				return nil, "", err
+			}
+			return noteEntity, "created", nil
+		}).Once()
+
+	h := &NoteHandler{
+		service:   mockNoteService,
+		validator: validator,
+		cfg:       cfg,
+		importers: []port.Importer{importer.NewMarkdownImporter(cfg)},
+	}
+
+	app := config.NewFiber()
+	app.Use(middleware.Timeout(10 * time.Millisecond))
+	app.Use(func(ctx *fiber.Ctx) error {
+		ctx.Locals("claims", &domain.Claims{UserID: noteEntity.UserID})
+		return ctx.Next()
+	})
+	app.Post("/api/v1/notes/import", h.Import)
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", "golang.md")
+	assert.NoError(t, err)
+	part.Write([]byte("is the best\n"))
+	writer.Close()
+
+	req := httptest.NewRequest(fiber.MethodPost, "/api/v1/notes/import", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	res, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	var got domain.NoteImportResponse
+	assert.NoError(t, json.NewDecoder(res.Body).Decode(&got))
+	assert.Equal(t, 1, got.Imported)
+}
@@ -0,0 +1,218 @@
+package handler
+
+import (
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type NoteShareHandler struct {
+	service   port.NoteShareService
+	validator *util.Validator
+	images    util.Images
+}
+
+func NewNoteShareHandler(service port.NoteShareService, validator *util.Validator, images util.Images) port.NoteShareHandler {
+	return &NoteShareHandler{
+		service:   service,
+		validator: validator,
+		images:    images,
+	}
+}
+
+// @Summary Get note shares
+// @Description Retrieve the users a note has been shared with
+// @Tags share
+// @Produce json
+// @Param id path int true "Note ID"
+// @Success 200 {object} []domain.NoteShareResponse "Successfully retrieved note shares"
+// @Router /notes/{id}/shares [get]
+func (h *NoteShareHandler) GetAll(ctx *fiber.Ctx) error {
+	var req domain.NoteShareRequest
+	var data []domain.NoteShareResponse
+
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	claims, ok := ctx.Locals("claims").(*domain.Claims)
+	if !ok {
+		return fiber.NewError(fiber.StatusBadRequest, "failed to retrieve claims from context")
+	}
+
+	result, err := h.service.GetAll(ctx.UserContext(), req.NoteID, *claims)
+	if err != nil {
+		return err
+	}
+
+	var urls []string
+	for _, share := range result {
+		urls = append(urls, share.User.AvatarURL)
+	}
+	images, err := h.images.Resolve(ctx.UserContext(), urls...)
+	if err != nil {
+		return err
+	}
+
+	for _, share := range result {
+		data = append(data, domain.NoteShareResponse{
+			ID:     share.ID,
+			NoteID: share.NoteID,
+			User: domain.NoteAuthor{
+				ID:     share.User.ID,
+				Name:   share.User.Name,
+				Avatar: images.Avatar(share.User.AvatarURL),
+			},
+			Permission: string(share.Permission),
+			CreatedAt:  share.CreatedAt,
+			UpdatedAt:  share.UpdatedAt,
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(data)
+}
+
+// @Summary Share a note
+// @Description Grant another user viewer or editor permission on a note
+// @Tags share
+// @Accept json
+// @Produce json
+// @Param id path int true "Note ID"
+// @Param share body domain.NoteShareRequest true "Note share request object"
+// @Success 200 {object} domain.NoteShareResponse "Successfully shared a note"
+// @Router /notes/{id}/shares [put]
+func (h *NoteShareHandler) Save(ctx *fiber.Ctx) error {
+	var req domain.NoteShareRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(err)
+	}
+
+	claims, ok := ctx.Locals("claims").(*domain.Claims)
+	if !ok {
+		return fiber.NewError(fiber.StatusBadRequest, "failed to retrieve claims from context")
+	}
+
+	result, err := h.service.Save(ctx.UserContext(), req, *claims)
+	if err != nil {
+		return err
+	}
+
+	images, err := h.images.Resolve(ctx.UserContext(), result.User.AvatarURL)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(domain.NoteShareResponse{
+		ID:     result.ID,
+		NoteID: result.NoteID,
+		User: domain.NoteAuthor{
+			ID:     result.User.ID,
+			Name:   result.User.Name,
+			Avatar: images.Avatar(result.User.AvatarURL),
+		},
+		Permission: string(result.Permission),
+		CreatedAt:  result.CreatedAt,
+		UpdatedAt:  result.UpdatedAt,
+	})
+}
+
+// @Summary Revoke a note share
+// @Description Revoke the permission of a user on a note
+// @Tags share
+// @Produce json
+// @Param id path int true "Note ID"
+// @Param user_id path int true "User ID"
+// @Success 200 "Successfully revoked a note share"
+// @Router /notes/{id}/shares/{user_id} [delete]
+func (h *NoteShareHandler) Delete(ctx *fiber.Ctx) error {
+	var req domain.NoteShareRequest
+
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	claims, ok := ctx.Locals("claims").(*domain.Claims)
+	if !ok {
+		return fiber.NewError(fiber.StatusBadRequest, "failed to retrieve claims from context")
+	}
+
+	if err := h.service.Delete(ctx.UserContext(), req.NoteID, req.UserID, *claims); err != nil {
+		return err
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON("successfully revoked note share")
+}
+
+// @Summary Get notes shared with me
+// @Description Retrieve the notes other users have shared with the current user
+// @Tags share
+// @Produce json
+// @Param sort query string false "Sorting (e.g., +title, -created_at)"
+// @Param order query string false "Sort order (e.g., asc, desc)"
+// @Param page query int false "Page number"
+// @Param limit query int false "Number of items per page"
+// @Success 200 {object} domain.NotePaginationResponse "Successfully retrieved shared notes"
+// @Router /notes/shared [get]
+func (h *NoteShareHandler) GetShared(ctx *fiber.Ctx) error {
+	var metadata domain.Metadata
+	var data []domain.NoteResponse
+
+	if err := ctx.QueryParser(&metadata); err != nil {
+		return err
+	}
+
+	claims, ok := ctx.Locals("claims").(*domain.Claims)
+	if !ok {
+		return fiber.NewError(fiber.StatusBadRequest, "failed to retrieve claims from context")
+	}
+
+	result, err := h.service.GetShared(ctx.UserContext(), &metadata, *claims)
+	if err != nil {
+		return err
+	}
+
+	var urls []string
+	for _, note := range result {
+		urls = append(urls, note.CoverURL, note.Author.AvatarURL)
+	}
+	images, err := h.images.Resolve(ctx.UserContext(), urls...)
+	if err != nil {
+		return err
+	}
+
+	for _, note := range result {
+		data = append(data, domain.NoteResponse{
+			ID:          note.ID,
+			Title:       note.Title,
+			Description: note.Description,
+			Cover:       images.Image(note.CoverURL),
+			Content:     note.Content,
+			Visibility:  string(note.Visibility),
+			Notebook:    note.Notebook,
+			Tags:        note.Tags,
+			Author: domain.NoteAuthor{
+				ID:     note.Author.ID,
+				Name:   note.Author.Name,
+				Avatar: images.Avatar(note.Author.AvatarURL),
+			},
+			CreatedAt: note.CreatedAt,
+			UpdatedAt: note.UpdatedAt,
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(domain.NotePaginationResponse{
+		Notes:    data,
+		Metadata: metadata,
+	})
+}
@@ -0,0 +1,293 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/mocks"
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var noteShareEntity = &domain.NoteShare{
+	ID:         1,
+	NoteID:     1,
+	UserID:     2,
+	Permission: domain.Viewer,
+}
+
+func TestNoteShareHandler_GetAll(t *testing.T) {
+	type fields struct {
+		service port.NoteShareService
+	}
+
+	type args struct {
+		req    uint
+		claims domain.Claims
+	}
+
+	mockNoteShareService := mocks.NewNoteShareService(t)
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		code   int
+	}{
+		{
+			name: "success",
+			fields: fields{
+				service: func() port.NoteShareService {
+					mockNoteShareService.EXPECT().GetAll(mock.Anything, noteEntity.ID, mock.AnythingOfType("domain.Claims")).Return([]domain.NoteShare{*noteShareEntity}, nil).Once()
+					return mockNoteShareService
+				}(),
+			},
+			args: args{
+				req: noteEntity.ID,
+			},
+			code: fiber.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &NoteShareHandler{
+				service: tt.fields.service,
+			}
+
+			app := config.NewFiber()
+			app.Use(func(ctx *fiber.Ctx) error {
+				ctx.Locals("claims", &tt.args.claims)
+				return ctx.Next()
+			})
+			app.Get("/api/v1/notes/:id/shares", h.GetAll)
+
+			req := httptest.NewRequest(fiber.MethodGet, fmt.Sprintf("/api/v1/notes/%v/shares", tt.args.req), nil)
+
+			res, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, res.StatusCode)
+		})
+	}
+}
+
+func TestNoteShareHandler_Save(t *testing.T) {
+	type fields struct {
+		service   port.NoteShareService
+		validator *util.Validator
+	}
+
+	type args struct {
+		id     uint
+		req    domain.NoteShareRequest
+		claims domain.Claims
+	}
+
+	mockNoteShareService := mocks.NewNoteShareService(t)
+	validator, _ := util.NewValidator()
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		code   int
+	}{
+		{
+			name: "success",
+			fields: fields{
+				service: func() port.NoteShareService {
+					mockNoteShareService.EXPECT().Save(mock.Anything, mock.MatchedBy(func(req domain.NoteShareRequest) bool {
+						return req.NoteID == noteEntity.ID && req.UserID == noteShareEntity.UserID
+					}), mock.AnythingOfType("domain.Claims")).Return(noteShareEntity, nil).Once()
+					return mockNoteShareService
+				}(),
+				validator: validator,
+			},
+			args: args{
+				id: noteEntity.ID,
+				req: domain.NoteShareRequest{
+					UserID:     noteShareEntity.UserID,
+					Permission: string(domain.Viewer),
+				},
+			},
+			code: fiber.StatusOK,
+		},
+		{
+			name: "validation error",
+			fields: fields{
+				service:   mockNoteShareService,
+				validator: validator,
+			},
+			args: args{
+				id: noteEntity.ID,
+				req: domain.NoteShareRequest{
+					UserID:     noteShareEntity.UserID,
+					Permission: "owner",
+				},
+			},
+			code: fiber.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &NoteShareHandler{
+				service:   tt.fields.service,
+				validator: tt.fields.validator,
+			}
+
+			app := config.NewFiber()
+			app.Use(func(ctx *fiber.Ctx) error {
+				ctx.Locals("claims", &tt.args.claims)
+				return ctx.Next()
+			})
+			app.Put("/api/v1/notes/:id/shares", h.Save)
+
+			requestBody, err := json.Marshal(tt.args.req)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(fiber.MethodPut, fmt.Sprintf("/api/v1/notes/%v/shares", tt.args.id), bytes.NewBuffer(requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			res, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, res.StatusCode)
+		})
+	}
+}
+
+func TestNoteShareHandler_Delete(t *testing.T) {
+	type fields struct {
+		service port.NoteShareService
+	}
+
+	type args struct {
+		noteID uint
+		userID uint
+		claims domain.Claims
+	}
+
+	mockNoteShareService := mocks.NewNoteShareService(t)
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		code   int
+	}{
+		{
+			name: "success",
+			fields: fields{
+				service: func() port.NoteShareService {
+					mockNoteShareService.EXPECT().Delete(mock.Anything, noteEntity.ID, noteShareEntity.UserID, mock.AnythingOfType("domain.Claims")).Return(nil).Once()
+					return mockNoteShareService
+				}(),
+			},
+			args: args{
+				noteID: noteEntity.ID,
+				userID: noteShareEntity.UserID,
+			},
+			code: fiber.StatusOK,
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				service: func() port.NoteShareService {
+					mockNoteShareService.EXPECT().Delete(mock.Anything, noteEntity.ID, noteShareEntity.UserID, mock.AnythingOfType("domain.Claims")).Return(fiber.NewError(fiber.StatusForbidden, "user does not have permission to perform this action")).Once()
+					return mockNoteShareService
+				}(),
+			},
+			args: args{
+				noteID: noteEntity.ID,
+				userID: noteShareEntity.UserID,
+			},
+			code: fiber.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &NoteShareHandler{
+				service: tt.fields.service,
+			}
+
+			app := config.NewFiber()
+			app.Use(func(ctx *fiber.Ctx) error {
+				ctx.Locals("claims", &tt.args.claims)
+				return ctx.Next()
+			})
+			app.Delete("/api/v1/notes/:id/shares/:user_id", h.Delete)
+
+			req := httptest.NewRequest(fiber.MethodDelete, fmt.Sprintf("/api/v1/notes/%v/shares/%v", tt.args.noteID, tt.args.userID), nil)
+
+			res, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, res.StatusCode)
+		})
+	}
+}
+
+func TestNoteShareHandler_GetShared(t *testing.T) {
+	type fields struct {
+		service port.NoteShareService
+	}
+
+	type args struct {
+		claims domain.Claims
+	}
+
+	mockNoteShareService := mocks.NewNoteShareService(t)
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		code   int
+	}{
+		{
+			name: "success",
+			fields: fields{
+				service: func() port.NoteShareService {
+					mockNoteShareService.EXPECT().GetShared(mock.Anything, mock.AnythingOfType("*domain.Metadata"), mock.AnythingOfType("domain.Claims")).Return([]domain.Note{*noteEntity}, nil).Once()
+					return mockNoteShareService
+				}(),
+			},
+			args: args{
+				claims: domain.Claims{
+					UserID: noteShareEntity.UserID,
+				},
+			},
+			code: fiber.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &NoteShareHandler{
+				service: tt.fields.service,
+			}
+
+			app := config.NewFiber()
+			app.Use(func(ctx *fiber.Ctx) error {
+				ctx.Locals("claims", &tt.args.claims)
+				return ctx.Next()
+			})
+			app.Get("/api/v1/notes/shared", h.GetShared)
+
+			req := httptest.NewRequest(fiber.MethodGet, "/api/v1/notes/shared", nil)
+
+			res, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, res.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type UploadHandler struct {
+	service port.UploadService
+	images  util.Images
+}
+
+func NewUploadHandler(service port.UploadService, images util.Images) port.UploadHandler {
+	return &UploadHandler{
+		service: service,
+		images:  images,
+	}
+}
+
+// @Summary Upload an image
+// @Description Upload an image for a note cover or an avatar; the returned url can be used as cover_url or avatar_url once the image is processed
+// @Tags upload
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "Image file (jpeg, png, webp or gif)"
+// @Success 201 {object} domain.UploadResponse "Successfully uploaded an image"
+// @Router /uploads [post]
+func (h *UploadHandler) Create(ctx *fiber.Ctx) error {
+	claims, ok := ctx.Locals("claims").(*domain.Claims)
+	if !ok {
+		return fiber.NewError(fiber.StatusBadRequest, "failed to retrieve claims from context")
+	}
+
+	header, err := ctx.FormFile("file")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "file is required")
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	result, err := h.service.Create(ctx.UserContext(), file, header.Size, *claims)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(domain.UploadResponse{
+		ID:          result.ID,
+		URL:         h.service.URL(result),
+		Image:       h.images.Upload(result),
+		ContentType: result.ContentType,
+		Size:        result.Size,
+		Status:      string(result.Status),
+		CreatedAt:   result.CreatedAt,
+	})
+}
+
+// @Summary Get an uploaded file
+// @Description Serve an uploaded file by its key
+// @Tags upload
+// @Param key path string true "Upload key"
+// @Success 200 {file} file "Uploaded file"
+// @Router /uploads/{key} [get]
+func (h *UploadHandler) Serve(ctx *fiber.Ctx) error {
+	upload, file, err := h.service.Open(ctx.UserContext(), ctx.Params("*"))
+	if err != nil {
+		return err
+	}
+
+	// keys are random and never reused, so the file can be cached for good
+	ctx.Set(fiber.HeaderContentType, upload.ContentType)
+	ctx.Set(fiber.HeaderCacheControl, "public, max-age=31536000, immutable")
+	ctx.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+
+	return ctx.Status(fiber.StatusOK).SendStream(file, int(upload.Size))
+}
+
+// @Summary Delete an upload
+// @Description Delete an uploaded file by ID
+// @Tags upload
+// @Produce json
+// @Param id path int true "Upload ID"
+// @Success 200 "Successfully deleted an upload"
+// @Router /uploads/{id} [delete]
+func (h *UploadHandler) Delete(ctx *fiber.Ctx) error {
+	var req domain.UploadRequest
+
+	if err := ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	claims, ok := ctx.Locals("claims").(*domain.Claims)
+	if !ok {
+		return fiber.NewError(fiber.StatusBadRequest, "failed to retrieve claims from context")
+	}
+
+	if err := h.service.Delete(ctx.UserContext(), req.ID, *claims); err != nil {
+		return err
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON("successfully deleted upload")
+}
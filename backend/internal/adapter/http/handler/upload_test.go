@@ -0,0 +1,190 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/mocks"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var uploadEntity = &domain.Upload{
+	ID:          1,
+	UserID:      1,
+	Key:         "1/c2hpcm9u.png",
+	ContentType: "image/png",
+	Size:        5,
+}
+
+func TestUploadHandler_Create(t *testing.T) {
+	type fields struct {
+		service port.UploadService
+	}
+
+	type args struct {
+		file   []byte
+		claims domain.Claims
+	}
+
+	mockUploadService := mocks.NewUploadService(t)
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		code   int
+	}{
+		{
+			name: "success",
+			fields: fields{
+				service: func() port.UploadService {
+					mockUploadService.EXPECT().Create(mock.Anything, mock.Anything, int64(5), mock.AnythingOfType("domain.Claims")).Return(uploadEntity, nil).Once()
+					mockUploadService.EXPECT().URL(uploadEntity).Return("http://localhost:3000/uploads/" + uploadEntity.Key).Once()
+					return mockUploadService
+				}(),
+			},
+			args: args{
+				file: []byte("image"),
+			},
+			code: fiber.StatusCreated,
+		},
+		{
+			name: "missing file",
+			fields: fields{
+				service: mockUploadService,
+			},
+			code: fiber.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &UploadHandler{
+				service: tt.fields.service,
+			}
+
+			app := config.NewFiber()
+			app.Use(func(ctx *fiber.Ctx) error {
+				ctx.Locals("claims", &tt.args.claims)
+				return ctx.Next()
+			})
+			app.Post("/api/v1/uploads", h.Create)
+
+			body := new(bytes.Buffer)
+			writer := multipart.NewWriter(body)
+			if tt.args.file != nil {
+				part, err := writer.CreateFormFile("file", "image.png")
+				assert.NoError(t, err)
+				part.Write(tt.args.file)
+			}
+			assert.NoError(t, writer.Close())
+
+			req := httptest.NewRequest(fiber.MethodPost, "/api/v1/uploads", body)
+			req.Header.Set("Content-Type", writer.FormDataContentType())
+
+			res, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, res.StatusCode)
+		})
+	}
+}
+
+func TestUploadHandler_Serve(t *testing.T) {
+	mockUploadService := mocks.NewUploadService(t)
+	mockUploadService.EXPECT().Open(mock.Anything, uploadEntity.Key).Return(uploadEntity, io.NopCloser(bytes.NewReader([]byte("image"))), nil).Once()
+
+	h := &UploadHandler{
+		service: mockUploadService,
+	}
+
+	app := config.NewFiber()
+	app.Get("/uploads/*", h.Serve)
+
+	res, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/uploads/"+uploadEntity.Key, nil))
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, res.StatusCode)
+	assert.Equal(t, "image/png", res.Header.Get(fiber.HeaderContentType))
+	assert.Equal(t, "nosniff", res.Header.Get(fiber.HeaderXContentTypeOptions))
+
+	data, err := io.ReadAll(res.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "image", string(data))
+}
+
+func TestUploadHandler_Delete(t *testing.T) {
+	type fields struct {
+		service port.UploadService
+	}
+
+	type args struct {
+		id     uint
+		claims domain.Claims
+	}
+
+	mockUploadService := mocks.NewUploadService(t)
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		code   int
+	}{
+		{
+			name: "success",
+			fields: fields{
+				service: func() port.UploadService {
+					mockUploadService.EXPECT().Delete(mock.Anything, uploadEntity.ID, mock.AnythingOfType("domain.Claims")).Return(nil).Once()
+					return mockUploadService
+				}(),
+			},
+			args: args{
+				id: uploadEntity.ID,
+			},
+			code: fiber.StatusOK,
+		},
+		{
+			name: "forbidden",
+			fields: fields{
+				service: func() port.UploadService {
+					mockUploadService.EXPECT().Delete(mock.Anything, uploadEntity.ID, mock.AnythingOfType("domain.Claims")).Return(fiber.NewError(fiber.StatusForbidden, "user does not have permission to perform this action")).Once()
+					return mockUploadService
+				}(),
+			},
+			args: args{
+				id: uploadEntity.ID,
+			},
+			code: fiber.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &UploadHandler{
+				service: tt.fields.service,
+			}
+
+			app := config.NewFiber()
+			app.Use(func(ctx *fiber.Ctx) error {
+				ctx.Locals("claims", &tt.args.claims)
+				return ctx.Next()
+			})
+			app.Delete("/api/v1/uploads/:id", h.Delete)
+
+			req := httptest.NewRequest(fiber.MethodDelete, fmt.Sprintf("/api/v1/uploads/%v", tt.args.id), nil)
+
+			res, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, res.StatusCode)
+		})
+	}
+}
@@ -14,13 +14,15 @@ type UserHandler struct {
 	service   port.UserService
 	validator *util.Validator
 	jwt       util.JWT
+	images    util.Images
 }
 
-func NewUserHandler(service port.UserService, validator *util.Validator, jwt util.JWT) port.UserHandler {
+func NewUserHandler(service port.UserService, validator *util.Validator, jwt util.JWT, images util.Images) port.UserHandler {
 	return &UserHandler{
 		service:   service,
 		validator: validator,
 		jwt:       jwt,
+		images:    images,
 	}
 }
 
@@ -30,11 +32,14 @@ func NewUserHandler(service port.UserService, validator *util.Validator, jwt uti
 // @Produce json
 // @Param id query int false "Filter users by ID"
 // @Param name query string false "Filter users by name"
-// @Param details query bool false "Get users details"
-// @Param sort query string false "Sorting (e.g., +name, -created_at)"
-// @Param order query string false "Sort order (e.g., asc, desc)"
+// @Param fields query string false "Comma separated fields to return (id, name, bio, avatar, created_at, updated_at)"
+// @Param filter query string false "Filter by id, name, created_at or updated_at (e.g., filter[name][contains]=john, filter[created_at][lt]=2024-01-01)"
+// @Param sort query string false "Comma separated sort fields (e.g., -created_at,name)"
+// @Param order query string false "Sort order of fields without a + or - prefix (e.g., asc, desc)"
 // @Param page query int false "Page number"
 // @Param limit query int false "Number of items per page"
+// @Param cursor query string false "Cursor from next_cursor or prev_cursor of a previous page, replaces page"
+// @Param skip_count query bool false "Skip counting total_records and total_pages"
 // @Success 200 {object} []domain.UserPaginationResponse "Successfully retrieved all user data"
 // @Router /users [get]
 func (h *UserHandler) GetAll(ctx *fiber.Ctx) error {
@@ -50,7 +55,28 @@ func (h *UserHandler) GetAll(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	result, err := h.service.GetAll(req, &metadata)
+	filters, errs := util.ParseFilters(ctx.Queries(), domain.UserFields)
+	if errs != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errs)
+	}
+	req.Filters = filters
+
+	fieldset, errs := util.ParseFieldset(ctx.Queries(), domain.UserSelection)
+	if errs != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errs)
+	}
+	req.Fieldset = *fieldset
+
+	result, err := h.service.GetAll(ctx.UserContext(), req, &metadata)
+	if err != nil {
+		return err
+	}
+
+	var urls []string
+	for _, user := range result {
+		urls = append(urls, user.AvatarURL)
+	}
+	images, err := h.images.Resolve(ctx.UserContext(), urls...)
 	if err != nil {
 		return err
 	}
@@ -61,9 +87,10 @@ func (h *UserHandler) GetAll(ctx *fiber.Ctx) error {
 				ID:        user.ID,
 				Name:      user.Name,
 				Bio:       user.Bio,
-				AvatarURL: user.AvatarURL,
+				Avatar:    images.Avatar(user.AvatarURL),
 				CreatedAt: user.CreatedAt,
 				UpdatedAt: user.UpdatedAt,
+				Fieldset:  fieldset,
 			})
 	}
 
@@ -82,7 +109,12 @@ func (h *UserHandler) GetAll(ctx *fiber.Ctx) error {
 func (h *UserHandler) GetMe(ctx *fiber.Ctx) error {
 	claims := ctx.Locals("claims").(*domain.Claims)
 
-	result, err := h.service.GetByID(claims.UserID)
+	result, err := h.service.GetByID(ctx.UserContext(), claims.UserID)
+	if err != nil {
+		return err
+	}
+
+	images, err := h.images.Resolve(ctx.UserContext(), result.AvatarURL)
 	if err != nil {
 		return err
 	}
@@ -91,7 +123,7 @@ func (h *UserHandler) GetMe(ctx *fiber.Ctx) error {
 		ID:        result.ID,
 		Name:      result.Name,
 		Bio:       result.Bio,
-		AvatarURL: result.AvatarURL,
+		Avatar:    images.Avatar(result.AvatarURL),
 		CreatedAt: result.CreatedAt,
 		UpdatedAt: result.UpdatedAt,
 	})
@@ -113,7 +145,12 @@ func (h *UserHandler) GetByID(ctx *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 
-	result, err := h.service.GetByID(req.ID)
+	result, err := h.service.GetByID(ctx.UserContext(), req.ID)
+	if err != nil {
+		return err
+	}
+
+	images, err := h.images.Resolve(ctx.UserContext(), result.AvatarURL)
 	if err != nil {
 		return err
 	}
@@ -122,7 +159,7 @@ func (h *UserHandler) GetByID(ctx *fiber.Ctx) error {
 		ID:        result.ID,
 		Name:      result.Name,
 		Bio:       result.Bio,
-		AvatarURL: result.AvatarURL,
+		Avatar:    images.Avatar(result.AvatarURL),
 		CreatedAt: result.CreatedAt,
 		UpdatedAt: result.UpdatedAt,
 	})
@@ -157,7 +194,12 @@ func (h *UserHandler) Update(ctx *fiber.Ctx) error {
 		return ctx.Status(fiber.StatusBadRequest).JSON(err)
 	}
 
-	result, err := h.service.Update(req, *claims)
+	result, err := h.service.Update(ctx.UserContext(), req, *claims)
+	if err != nil {
+		return err
+	}
+
+	images, err := h.images.Resolve(ctx.UserContext(), result.AvatarURL)
 	if err != nil {
 		return err
 	}
@@ -166,7 +208,7 @@ func (h *UserHandler) Update(ctx *fiber.Ctx) error {
 		ID:        result.ID,
 		Name:      result.Name,
 		Bio:       result.Bio,
-		AvatarURL: result.AvatarURL,
+		Avatar:    images.Avatar(result.AvatarURL),
 		CreatedAt: result.CreatedAt,
 		UpdatedAt: result.UpdatedAt,
 	})
@@ -191,24 +233,27 @@ func (h *UserHandler) Delete(ctx *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "failed to retrieve claims from context")
 	}
 
-	err := h.service.Delete(req, *claims)
+	err := h.service.Delete(ctx.UserContext(), req, *claims)
 	if err != nil {
 		return err
 	}
 
-	ctx.Cookie(&fiber.Cookie{
-		Name:     "access-token",
-		Expires:  time.Now().Add(-(time.Hour * 2)),
-		HTTPOnly: true,
-		SameSite: "lax",
-	})
-
-	ctx.Cookie(&fiber.Cookie{
-		Name:     "refresh-token",
-		Expires:  time.Now().Add(-(time.Hour * 2)),
-		HTTPOnly: true,
-		SameSite: "lax",
-	})
+	// an admin deleting someone else stays logged in
+	if req.ID == claims.UserID {
+		ctx.Cookie(&fiber.Cookie{
+			Name:     "access-token",
+			Expires:  time.Now().Add(-(time.Hour * 2)),
+			HTTPOnly: true,
+			SameSite: "lax",
+		})
+
+		ctx.Cookie(&fiber.Cookie{
+			Name:     "refresh-token",
+			Expires:  time.Now().Add(-(time.Hour * 2)),
+			HTTPOnly: true,
+			SameSite: "lax",
+		})
+	}
 
 	return ctx.Status(fiber.StatusOK).JSON("successfully deleted user by id")
 }
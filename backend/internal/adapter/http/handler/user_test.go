@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"testing"
 
 	"github.com/shironxn/blanknotes/internal/config"
@@ -44,6 +45,7 @@ func TestUserHandler_GetAll(t *testing.T) {
 	tests := []struct {
 		name    string
 		fields  fields
+		query   string
 		code    int
 		want    interface{}
 		wantErr bool
@@ -52,13 +54,43 @@ func TestUserHandler_GetAll(t *testing.T) {
 			name: "success",
 			fields: fields{
 				service: func() port.UserService {
-					mockUserService.EXPECT().GetAll(mock.AnythingOfType("domain.UserQuery"), mock.AnythingOfType("*domain.Metadata")).Return(userEntity, nil).Once()
+					mockUserService.EXPECT().GetAll(mock.Anything, mock.AnythingOfType("domain.UserQuery"), mock.AnythingOfType("*domain.Metadata")).Return(userEntity, nil).Once()
 					return mockUserService
 				}(),
 			},
 			code:    fiber.StatusOK,
 			wantErr: false,
 		},
+		{
+			name: "success with fields",
+			fields: fields{
+				service: func() port.UserService {
+					mockUserService.EXPECT().GetAll(mock.Anything, mock.MatchedBy(func(req domain.UserQuery) bool {
+						return slices.Equal(req.Fieldset.Fields, []string{"id", "name"})
+					}), mock.AnythingOfType("*domain.Metadata")).Return(userEntity, nil).Once()
+					return mockUserService
+				}(),
+			},
+			query:   "?fields=id,name",
+			code:    fiber.StatusOK,
+			want:    []string{"id", "name"},
+			wantErr: false,
+		},
+		{
+			name: "invalid fields",
+			fields: fields{
+				service: mockUserService,
+			},
+			query: "?fields=email",
+			code:  fiber.StatusBadRequest,
+			want: domain.ErrorResponse{
+				Code: fiber.StatusBadRequest,
+				Error: []interface{}{
+					map[string]interface{}{"field": "fields", "error": "selecting email is not supported"},
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -70,7 +102,7 @@ func TestUserHandler_GetAll(t *testing.T) {
 			app := config.NewFiber()
 			app.Get("/api/v1/user", h.GetAll)
 
-			req := httptest.NewRequest(fiber.MethodGet, "/api/v1/user", nil)
+			req := httptest.NewRequest(fiber.MethodGet, "/api/v1/user"+tt.query, nil)
 			req.Header.Set("Content-Type", "application/json")
 
 			res, err := app.Test(req)
@@ -82,6 +114,19 @@ func TestUserHandler_GetAll(t *testing.T) {
 				err = json.NewDecoder(res.Body).Decode(&got)
 				assert.NoError(t, err)
 				assert.Equal(t, tt.want, got)
+			} else if tt.want != nil {
+				var got struct {
+					Users []map[string]interface{} `json:"users"`
+				}
+				err = json.NewDecoder(res.Body).Decode(&got)
+				assert.NoError(t, err)
+				assert.Len(t, got.Users, 1)
+
+				var keys []string
+				for key := range got.Users[0] {
+					keys = append(keys, key)
+				}
+				assert.ElementsMatch(t, tt.want, keys)
 			} else {
 				assert.NoError(t, err)
 			}
@@ -112,7 +157,7 @@ func TestUserHandler_GetByID(t *testing.T) {
 			name: "success",
 			fields: fields{
 				service: func() port.UserService {
-					mockUserService.EXPECT().GetByID(mock.AnythingOfType("uint")).Return(userEntity, nil).Once()
+					mockUserService.EXPECT().GetByID(mock.Anything, mock.AnythingOfType("uint")).Return(userEntity, nil).Once()
 					return mockUserService
 				}(),
 			},
@@ -175,7 +220,7 @@ func TestUserHandler_Update(t *testing.T) {
 			name: "success",
 			fields: fields{
 				service: func() port.UserService {
-					mockUserService.EXPECT().Update(mock.AnythingOfType("domain.UserRequest"), mock.AnythingOfType("domain.Claims")).Return(userEntity, nil).Once()
+					mockUserService.EXPECT().Update(mock.Anything, mock.AnythingOfType("domain.UserRequest"), mock.AnythingOfType("domain.Claims")).Return(userEntity, nil).Once()
 					return mockUserService
 				}(),
 				validator: validator,
@@ -187,7 +232,7 @@ func TestUserHandler_Update(t *testing.T) {
 			name: "permission denied",
 			fields: fields{
 				service: func() port.UserService {
-					mockUserService.EXPECT().Update(mock.AnythingOfType("domain.UserRequest"), mock.AnythingOfType("domain.Claims")).Return(nil, fiber.NewError(fiber.StatusForbidden, "user does not have permission to perform this action")).Once()
+					mockUserService.EXPECT().Update(mock.Anything, mock.AnythingOfType("domain.UserRequest"), mock.AnythingOfType("domain.Claims")).Return(nil, fiber.NewError(fiber.StatusForbidden, "user does not have permission to perform this action")).Once()
 					return mockUserService
 				}(),
 				validator: validator,
@@ -262,7 +307,7 @@ func TestUserHandler_Delete(t *testing.T) {
 			name: "success",
 			fields: fields{
 				service: func() port.UserService {
-					mockUserService.EXPECT().Delete(mock.AnythingOfType("domain.UserRequest"), mock.AnythingOfType("domain.Claims")).Return(nil).Once()
+					mockUserService.EXPECT().Delete(mock.Anything, mock.AnythingOfType("domain.UserRequest"), mock.AnythingOfType("domain.Claims")).Return(nil).Once()
 					return mockUserService
 				}(),
 			},
@@ -277,7 +322,7 @@ func TestUserHandler_Delete(t *testing.T) {
 			name: "permission denied",
 			fields: fields{
 				service: func() port.UserService {
-					mockUserService.EXPECT().Delete(mock.AnythingOfType("domain.UserRequest"), mock.AnythingOfType("domain.Claims")).Return(fiber.NewError(fiber.StatusForbidden, "user does not have permission to perform this action")).Once()
+					mockUserService.EXPECT().Delete(mock.Anything, mock.AnythingOfType("domain.UserRequest"), mock.AnythingOfType("domain.Claims")).Return(fiber.NewError(fiber.StatusForbidden, "user does not have permission to perform this action")).Once()
 					return mockUserService
 				}(),
 			},
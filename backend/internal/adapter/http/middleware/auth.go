@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
 	"github.com/shironxn/blanknotes/internal/core/port"
 	"github.com/shironxn/blanknotes/internal/util"
 
@@ -35,7 +36,7 @@ func (m *AuthMiddleware) Auth() fiber.Handler {
 				return fiber.NewError(fiber.StatusUnauthorized, "unauthorized access")
 			}
 
-			newAccessToken, claims, err := m.service.Refresh(refreshToken)
+			newAccessToken, claims, err := m.service.Refresh(c.UserContext(), refreshToken)
 			if err != nil {
 				return fiber.NewError(fiber.StatusUnauthorized, "unauthorized access")
 			}
@@ -46,20 +47,27 @@ func (m *AuthMiddleware) Auth() fiber.Handler {
 				Path:     "/",
 				HTTPOnly: true,
 				Expires:  time.Now().Add(10 * time.Minute),
-				SameSite: func(dev string) string {
-					if dev == "true" {
+				SameSite: func(dev bool) string {
+					if dev {
 						return fiber.CookieSameSiteLaxMode
 					}
 					return fiber.CookieSameSiteNoneMode
 				}(m.cfg.Server.Dev),
 			})
-			c.Locals("claims", claims)
+			setClaims(c, claims)
 
 			return c.Next()
 		}
 
-		c.Locals("claims", claims)
+		setClaims(c, claims)
 
 		return c.Next()
 	}
 }
+
+// setClaims stores the claims for the handlers and adds the user to the
+// logger of the request.
+func setClaims(c *fiber.Ctx, claims *domain.Claims) {
+	c.Locals("claims", claims)
+	c.SetUserContext(util.WithLogger(c.UserContext(), util.Logger(c.UserContext()).With("user_id", claims.UserID)))
+}
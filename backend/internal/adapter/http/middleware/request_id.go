@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RequestIDHeader carries the ID of a request, in and back out.
+const RequestIDHeader = "X-Request-ID"
+
+// RequestID keeps the ID a proxy in front gave the request, or makes one up,
+// so its logs can be found from either side. The ID is sent back in the
+// response and stored in the request_id local.
+func RequestID() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		// fiber reuses the memory of the request, the ID outlives it in logs
+		id := string(ctx.Request().Header.Peek(RequestIDHeader))
+		if !validRequestID(id) {
+			var err error
+			if id, err = util.GenerateToken(16); err != nil {
+				return err
+			}
+		}
+
+		ctx.Set(RequestIDHeader, id)
+		ctx.Locals("request_id", id)
+
+		return ctx.Next()
+	}
+}
+
+// validRequestID rejects IDs that are empty, too long or not plain text, they
+// end up in logs and headers as they are.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > 128 {
+		return false
+	}
+	for _, c := range id {
+		if c < '!' || c > '~' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestID(t *testing.T) {
+	app := fiber.New()
+	app.Use(RequestID())
+	app.Get("/", func(ctx *fiber.Ctx) error {
+		return ctx.SendString(ctx.Locals("request_id").(string))
+	})
+
+	tests := []struct {
+		name     string
+		incoming string
+		kept     bool
+	}{
+		{name: "kept", incoming: "4bf92f3577b34da6", kept: true},
+		{name: "missing"},
+		{name: "too long", incoming: strings.Repeat("a", 129)},
+		{name: "not plain text", incoming: "id with spaces"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+			if tt.incoming != "" {
+				req.Header.Set(RequestIDHeader, tt.incoming)
+			}
+
+			res, err := app.Test(req)
+			require.NoError(t, err)
+
+			id := res.Header.Get(RequestIDHeader)
+			if tt.kept {
+				assert.Equal(t, tt.incoming, id)
+			} else {
+				assert.NotEmpty(t, id)
+				assert.NotEqual(t, tt.incoming, id)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Timeout gives the context of every request a deadline, the queries and
+// calls made with ctx.UserContext() are cancelled once it passes. Fiber does
+// not tell when a client goes away, so the deadline is what stops the work of
+// an abandoned request. Responses streamed after the handler returns and
+// websockets have to detach from it with context.WithoutCancel.
+func Timeout(timeout time.Duration) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		userCtx, cancel := context.WithTimeout(ctx.UserContext(), timeout)
+		defer cancel()
+		ctx.SetUserContext(userCtx)
+
+		return ctx.Next()
+	}
+}
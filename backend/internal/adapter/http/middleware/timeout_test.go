@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/config"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeout(t *testing.T) {
+	app := fiber.New(fiber.Config{ErrorHandler: config.ErrorHandler()})
+	app.Use(Timeout(10 * time.Millisecond))
+	app.Get("/fast", func(ctx *fiber.Ctx) error {
+		_, ok := ctx.UserContext().Deadline()
+		assert.True(t, ok)
+		return ctx.SendStatus(fiber.StatusOK)
+	})
+	app.Get("/slow", func(ctx *fiber.Ctx) error {
+		<-ctx.UserContext().Done()
+		return ctx.UserContext().Err()
+	})
+
+	res, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/fast", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	res, err = app.Test(httptest.NewRequest(fiber.MethodGet, "/slow", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusServiceUnavailable, res.StatusCode)
+}
@@ -0,0 +1,29 @@
+package route
+
+import (
+	"github.com/shironxn/blanknotes/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type AttachmentRoute struct {
+	handler    port.AttachmentHandler
+	middleware port.Middleware
+}
+
+func NewAttachmentRoute(handler port.AttachmentHandler, middleware port.Middleware) AttachmentRoute {
+	return AttachmentRoute{
+		handler:    handler,
+		middleware: middleware,
+	}
+}
+
+func (r *AttachmentRoute) Route(app *fiber.App) {
+	api := app.Group("/api")
+
+	v1 := api.Group("/v1/notes")
+	v1.Post("/:id/attachments", r.middleware.Auth(), r.handler.Create)
+	v1.Get("/:id/attachments", r.handler.GetAll)
+	v1.Get("/:id/attachments/:attachment_id", r.handler.Download)
+	v1.Delete("/:id/attachments/:attachment_id", r.middleware.Auth(), r.handler.Delete)
+}
@@ -26,4 +26,5 @@ func (r *AuthRoute) Route(app *fiber.App) {
 	v1.Post("/login", r.handler.Login)
 	v1.Post("/logout", r.middleware.Auth(), r.handler.Logout)
 	v1.Post("/refresh", r.handler.Refresh)
+	v1.Post("/restore", r.handler.Restore)
 }
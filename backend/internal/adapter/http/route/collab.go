@@ -0,0 +1,26 @@
+package route
+
+import (
+	"github.com/shironxn/blanknotes/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CollabRoute struct {
+	handler    port.CollabHandler
+	middleware port.Middleware
+}
+
+func NewCollabRoute(handler port.CollabHandler, middleware port.Middleware) CollabRoute {
+	return CollabRoute{
+		handler:    handler,
+		middleware: middleware,
+	}
+}
+
+func (r *CollabRoute) Route(app *fiber.App) {
+	api := app.Group("/api")
+
+	v1 := api.Group("/v1/notes")
+	v1.Get("/:id/ws", r.middleware.Auth(), r.handler.Upgrade, r.handler.Connect())
+}
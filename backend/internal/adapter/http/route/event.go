@@ -0,0 +1,26 @@
+package route
+
+import (
+	"github.com/shironxn/blanknotes/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type EventRoute struct {
+	handler    port.EventHandler
+	middleware port.Middleware
+}
+
+func NewEventRoute(handler port.EventHandler, middleware port.Middleware) EventRoute {
+	return EventRoute{
+		handler:    handler,
+		middleware: middleware,
+	}
+}
+
+func (r *EventRoute) Route(app *fiber.App) {
+	api := app.Group("/api")
+
+	v1 := api.Group("/v1/notes")
+	v1.Get("/events", r.middleware.Auth(), r.handler.Stream)
+}
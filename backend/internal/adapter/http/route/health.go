@@ -0,0 +1,24 @@
+package route
+
+import (
+	"github.com/shironxn/blanknotes/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type HealthRoute struct {
+	handler port.HealthHandler
+}
+
+func NewHealthRoute(handler port.HealthHandler) HealthRoute {
+	return HealthRoute{
+		handler: handler,
+	}
+}
+
+// Route has to come before the init route, probes then skip the request log.
+func (r *HealthRoute) Route(app *fiber.App) {
+	app.Get("/healthz", r.handler.Live)
+	app.Get("/readyz", r.handler.Ready)
+	app.Get("/version", r.handler.Version)
+}
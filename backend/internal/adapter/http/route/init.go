@@ -3,7 +3,6 @@ package route
 import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
-	"github.com/gofiber/fiber/v2/middleware/logger"
 	"github.com/shironxn/blanknotes/internal/config"
 
 	_ "github.com/shironxn/blanknotes/docs"
@@ -28,7 +27,6 @@ func (r *InitRoute) Route(app *fiber.App) {
 			AllowCredentials: true,
 		},
 	))
-	app.Use(logger.New())
 
 	app.Get("/api/v1/docs/*", swagger.HandlerDefault)
 	app.Get("/", func(ctx *fiber.Ctx) error {
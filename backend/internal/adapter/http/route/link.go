@@ -0,0 +1,31 @@
+package route
+
+import (
+	"github.com/shironxn/blanknotes/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ShareLinkRoute struct {
+	handler    port.ShareLinkHandler
+	middleware port.Middleware
+}
+
+func NewShareLinkRoute(handler port.ShareLinkHandler, middleware port.Middleware) ShareLinkRoute {
+	return ShareLinkRoute{
+		handler:    handler,
+		middleware: middleware,
+	}
+}
+
+func (r *ShareLinkRoute) Route(app *fiber.App) {
+	api := app.Group("/api")
+
+	notes := api.Group("/v1/notes")
+	notes.Get("/:id/links", r.middleware.Auth(), r.handler.GetAll)
+	notes.Post("/:id/links", r.middleware.Auth(), r.handler.Create)
+	notes.Delete("/:id/links/:link_id", r.middleware.Auth(), r.handler.Delete)
+
+	shared := api.Group("/v1/shared")
+	shared.Get("/:token", r.handler.Open)
+}
@@ -24,7 +24,12 @@ func (r *NoteRoute) Route(app *fiber.App) {
 	v1 := api.Group("/v1/notes")
 	v1.Post("/", r.middleware.Auth(), r.handler.Create)
 	v1.Get("/", r.handler.GetAll)
+	v1.Get("/trash", r.middleware.Auth(), r.handler.GetTrash)
+	v1.Delete("/trash/:id", r.middleware.Auth(), r.handler.ForceDelete)
+	v1.Get("/export", r.middleware.Auth(), r.handler.Export)
+	v1.Post("/import", r.middleware.Auth(), r.handler.Import)
 	v1.Get("/:id", r.handler.GetByID)
 	v1.Put("/:id", r.middleware.Auth(), r.handler.Update)
 	v1.Delete("/:id", r.middleware.Auth(), r.handler.Delete)
+	v1.Post("/:id/restore", r.middleware.Auth(), r.handler.Restore)
 }
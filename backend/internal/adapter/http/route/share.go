@@ -0,0 +1,30 @@
+package route
+
+import (
+	"github.com/shironxn/blanknotes/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type NoteShareRoute struct {
+	handler    port.NoteShareHandler
+	middleware port.Middleware
+}
+
+func NewNoteShareRoute(handler port.NoteShareHandler, middleware port.Middleware) NoteShareRoute {
+	return NoteShareRoute{
+		handler:    handler,
+		middleware: middleware,
+	}
+}
+
+// Route must be registered before NoteRoute so /shared is not captured by /:id.
+func (r *NoteShareRoute) Route(app *fiber.App) {
+	api := app.Group("/api")
+
+	v1 := api.Group("/v1/notes")
+	v1.Get("/shared", r.middleware.Auth(), r.handler.GetShared)
+	v1.Get("/:id/shares", r.middleware.Auth(), r.handler.GetAll)
+	v1.Put("/:id/shares", r.middleware.Auth(), r.handler.Save)
+	v1.Delete("/:id/shares/:user_id", r.middleware.Auth(), r.handler.Delete)
+}
@@ -0,0 +1,29 @@
+package route
+
+import (
+	"github.com/shironxn/blanknotes/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type UploadRoute struct {
+	handler    port.UploadHandler
+	middleware port.Middleware
+}
+
+func NewUploadRoute(handler port.UploadHandler, middleware port.Middleware) UploadRoute {
+	return UploadRoute{
+		handler:    handler,
+		middleware: middleware,
+	}
+}
+
+func (r *UploadRoute) Route(app *fiber.App) {
+	app.Get("/uploads/*", r.handler.Serve)
+
+	api := app.Group("/api")
+
+	v1 := api.Group("/v1/uploads")
+	v1.Post("/", r.middleware.Auth(), r.handler.Create)
+	v1.Delete("/:id", r.middleware.Auth(), r.handler.Delete)
+}
@@ -0,0 +1,149 @@
+package importer
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const enexTimeLayout = "20060102T150405Z"
+
+// ENEXImporter reads Evernote exports. An ENEX file holds the notes of a single
+// notebook, so the notebook is named after the file.
+type ENEXImporter struct {
+	cfg *config.Config
+}
+
+func NewENEXImporter(cfg *config.Config) port.Importer {
+	return &ENEXImporter{
+		cfg: cfg,
+	}
+}
+
+type enexNote struct {
+	Title     string         `xml:"title"`
+	Content   string         `xml:"content"`
+	Created   string         `xml:"created"`
+	Updated   string         `xml:"updated"`
+	Tags      []string       `xml:"tag"`
+	Resources []enexResource `xml:"resource"`
+}
+
+type enexResource struct {
+	Data struct {
+		Encoding string `xml:"encoding,attr"`
+		Value    string `xml:",chardata"`
+	} `xml:"data"`
+	Mime       string `xml:"mime"`
+	Attributes struct {
+		FileName string `xml:"file-name"`
+	} `xml:"resource-attributes"`
+}
+
+func (i *ENEXImporter) Format() string {
+	return "enex"
+}
+
+func (i *ENEXImporter) Detect(filename string, file io.ReaderAt, size int64) bool {
+	return extension(filename) == ".enex"
+}
+
+// Import decodes the export one <note> element at a time instead of
+// unmarshalling the whole document.
+func (i *ENEXImporter) Import(filename string, file io.ReaderAt, size int64, yield func(note domain.ImportedNote) error) error {
+	decoder := xml.NewDecoder(io.NewSectionReader(file, 0, size))
+	decoder.Strict = false
+	decoder.Entity = xml.HTMLEntity
+
+	notebook := strings.TrimSuffix(path.Base(filename), path.Ext(filename))
+	index := 0
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "invalid enex file: "+err.Error())
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "note" {
+			continue
+		}
+
+		index++
+		var raw enexNote
+		if err := decoder.DecodeElement(&raw, &start); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "invalid enex file: "+err.Error())
+		}
+
+		note := i.convert(raw)
+		note.File = fmt.Sprintf("%s#%d", path.Base(filename), index)
+		note.Notebook = notebook
+
+		if err := yield(note); err != nil {
+			return err
+		}
+	}
+}
+
+func (i *ENEXImporter) convert(raw enexNote) domain.ImportedNote {
+	note := domain.ImportedNote{
+		Title:      truncate(raw.Title, 25),
+		CoverURL:   i.cfg.Import.DefaultCoverURL,
+		Visibility: string(domain.Private),
+		Tags:       raw.Tags,
+	}
+	note.CreatedAt, _ = time.Parse(enexTimeLayout, strings.TrimSpace(raw.Created))
+	note.UpdatedAt, _ = time.Parse(enexTimeLayout, strings.TrimSpace(raw.Updated))
+
+	media := make(map[string]string)
+	for n, resource := range raw.Resources {
+		if resource.Data.Encoding != "" && resource.Data.Encoding != "base64" {
+			continue
+		}
+
+		data, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(resource.Data.Value), ""))
+		if err != nil {
+			note.Err = fiber.NewError(fiber.StatusBadRequest, "invalid attachment data")
+			return note
+		}
+
+		name := path.Base(resource.Attributes.FileName)
+		if name == "." || name == "/" {
+			name = fmt.Sprintf("attachment-%d", n+1)
+		}
+
+		sum := md5.Sum(data)
+		media[hex.EncodeToString(sum[:])] = name
+
+		note.Attachments = append(note.Attachments, domain.ImportedAttachment{
+			Name:     name,
+			MimeType: resource.Mime,
+			Data:     data,
+		})
+	}
+
+	content, err := enmlToMarkdown(raw.Content, media)
+	if err != nil {
+		note.Err = fiber.NewError(fiber.StatusBadRequest, "invalid note content: "+err.Error())
+		return note
+	}
+	note.Content = content
+	note.Description = describe(content, note.Title)
+
+	return note
+}
@@ -0,0 +1,81 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const enexExport = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE en-export SYSTEM "http://xml.evernote.com/pub/evernote-export3.dtd">
+<en-export export-date="20240101T000000Z" application="Evernote" version="10">
+  <note>
+    <title>A very long title from evernote</title>
+    <content><![CDATA[<?xml version="1.0" encoding="UTF-8"?><!DOCTYPE en-note SYSTEM "http://xml.evernote.com/pub/enml2.dtd"><en-note><div>Shopping list</div><div><en-media hash="5d41402abc4b2a76b9719d911017c592" type="text/plain"/></div></en-note>]]></content>
+    <created>20230730T205204Z</created>
+    <updated>20230801T101010Z</updated>
+    <tag>home</tag>
+    <tag>todo</tag>
+    <resource>
+      <data encoding="base64">aGVs
+bG8=</data>
+      <mime>text/plain</mime>
+      <resource-attributes><file-name>../hello.txt</file-name></resource-attributes>
+    </resource>
+  </note>
+  <note>
+    <title>broken</title>
+    <content><![CDATA[<en-note>ok</en-note>]]></content>
+    <resource><data encoding="base64">!!!</data></resource>
+  </note>
+</en-export>`
+
+func TestENEXImporter_Import(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Import.DefaultCoverURL = "http://localhost:3000/cover.jpg"
+
+	i := NewENEXImporter(cfg)
+	r := strings.NewReader(enexExport)
+
+	assert.True(t, i.Detect("Recipes.enex", r, r.Size()))
+	assert.False(t, i.Detect("Recipes.zip", r, r.Size()))
+
+	var notes []domain.ImportedNote
+	err := i.Import("Recipes.enex", r, r.Size(), func(note domain.ImportedNote) error {
+		notes = append(notes, note)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, notes, 2)
+
+	note := notes[0]
+	assert.NoError(t, note.Err)
+	assert.Equal(t, "Recipes.enex#1", note.File)
+	assert.Equal(t, "A very long title from ev", note.Title)
+	assert.Equal(t, "Shopping list", note.Description)
+	assert.Equal(t, "Shopping list\n\n[hello.txt](hello.txt)\n", note.Content)
+	assert.Equal(t, cfg.Import.DefaultCoverURL, note.CoverURL)
+	assert.Equal(t, "private", note.Visibility)
+	assert.Equal(t, "Recipes", note.Notebook)
+	assert.Equal(t, []string{"home", "todo"}, note.Tags)
+	assert.Equal(t, time.Date(2023, 7, 30, 20, 52, 4, 0, time.UTC), note.CreatedAt)
+	assert.Equal(t, time.Date(2023, 8, 1, 10, 10, 10, 0, time.UTC), note.UpdatedAt)
+	assert.Equal(t, []domain.ImportedAttachment{{Name: "hello.txt", MimeType: "text/plain", Data: []byte("hello")}}, note.Attachments)
+
+	assert.Error(t, notes[1].Err)
+}
+
+func TestENEXImporter_InvalidFile(t *testing.T) {
+	i := NewENEXImporter(&config.Config{})
+	r := strings.NewReader("<en-export><note><title>unterminated")
+
+	err := i.Import("broken.enex", r, r.Size(), func(note domain.ImportedNote) error {
+		return nil
+	})
+	assert.Error(t, err)
+}
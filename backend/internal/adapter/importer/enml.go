@@ -0,0 +1,238 @@
+package importer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+var (
+	blankLines = regexp.MustCompile(`\n{3,}`)
+	emptyLines = regexp.MustCompile(`\n\s*\n`)
+	whitespace = regexp.MustCompile(`\s+`)
+)
+
+// enmlToMarkdown converts the XHTML dialect Evernote stores notes in to
+// Markdown. media maps the hash of an <en-media> element to the file name of
+// the attachment it embeds.
+func enmlToMarkdown(enml string, media map[string]string) (string, error) {
+	doc, err := html.Parse(strings.NewReader(enml))
+	if err != nil {
+		return "", err
+	}
+
+	c := &enmlConverter{media: media}
+	markdown := c.children(doc)
+
+	lines := strings.Split(markdown, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	markdown = blankLines.ReplaceAllString(strings.Join(lines, "\n"), "\n\n")
+
+	return strings.TrimSpace(markdown) + "\n", nil
+}
+
+type enmlConverter struct {
+	media map[string]string
+	lists []listState
+}
+
+type listState struct {
+	ordered bool
+	index   int
+}
+
+func (c *enmlConverter) children(n *html.Node) string {
+	var b strings.Builder
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		b.WriteString(c.node(child))
+	}
+	return b.String()
+}
+
+func (c *enmlConverter) node(n *html.Node) string {
+	switch n.Type {
+	case html.TextNode:
+		return whitespace.ReplaceAllString(n.Data, " ")
+	case html.ElementNode:
+	case html.DocumentNode:
+		return c.children(n)
+	default:
+		return ""
+	}
+
+	switch n.Data {
+	// the HTML parser does not know these are empty elements and nests the
+	// siblings that follow a self-closing tag inside them
+	case "en-todo":
+		if attr(n, "checked") == "true" {
+			return "[x] " + c.children(n)
+		}
+		return "[ ] " + c.children(n)
+	case "en-media":
+		name := c.media[attr(n, "hash")]
+		if name == "" {
+			return c.children(n)
+		}
+		if strings.HasPrefix(attr(n, "type"), "image/") {
+			return fmt.Sprintf("![%s](%s)", name, name) + c.children(n)
+		}
+		return fmt.Sprintf("[%s](%s)", name, name) + c.children(n)
+	case "en-crypt":
+		return "*[encrypted content]*"
+	}
+
+	switch n.DataAtom {
+	case atom.Head, atom.Script, atom.Style, atom.Title:
+		return ""
+	case atom.Br:
+		return "\n"
+	case atom.Hr:
+		return "\n\n---\n\n"
+	case atom.P, atom.Div:
+		return "\n\n" + strings.TrimSpace(c.children(n)) + "\n\n"
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		level := int(n.Data[1] - '0')
+		return "\n\n" + strings.Repeat("#", level) + " " + strings.TrimSpace(c.children(n)) + "\n\n"
+	case atom.B, atom.Strong:
+		return wrap(c.children(n), "**")
+	case atom.I, atom.Em:
+		return wrap(c.children(n), "*")
+	case atom.S, atom.Strike, atom.Del:
+		return wrap(c.children(n), "~~")
+	case atom.Code:
+		return wrap(c.children(n), "`")
+	case atom.Pre:
+		return "\n\n```\n" + strings.Trim(text(n), "\n") + "\n```\n\n"
+	case atom.A:
+		content := strings.TrimSpace(c.children(n))
+		href := attr(n, "href")
+		if href == "" {
+			return content
+		}
+		if content == "" {
+			content = href
+		}
+		return fmt.Sprintf("[%s](%s)", content, href)
+	case atom.Img:
+		return fmt.Sprintf("![%s](%s)", attr(n, "alt"), attr(n, "src"))
+	case atom.Blockquote:
+		content := strings.TrimSpace(blankLines.ReplaceAllString(c.children(n), "\n\n"))
+		return "\n\n> " + strings.ReplaceAll(content, "\n", "\n> ") + "\n\n"
+	case atom.Ul, atom.Ol:
+		c.lists = append(c.lists, listState{ordered: n.DataAtom == atom.Ol})
+		content := c.children(n)
+		c.lists = c.lists[:len(c.lists)-1]
+		if len(c.lists) > 0 {
+			return "\n" + content
+		}
+		return "\n\n" + content + "\n\n"
+	case atom.Li:
+		return c.listItem(n)
+	case atom.Table:
+		return "\n\n" + c.table(n) + "\n\n"
+	}
+
+	return c.children(n)
+}
+
+func (c *enmlConverter) listItem(n *html.Node) string {
+	marker := "- "
+	depth := len(c.lists)
+	if depth > 0 {
+		list := &c.lists[depth-1]
+		list.index++
+		if list.ordered {
+			marker = fmt.Sprintf("%d. ", list.index)
+		}
+	}
+
+	// nested lists end up in content and get indented along with it
+	content := strings.TrimSpace(c.children(n))
+	content = emptyLines.ReplaceAllString(content, "\n")
+
+	return marker + strings.ReplaceAll(content, "\n", "\n  ") + "\n"
+}
+
+func (c *enmlConverter) table(n *html.Node) string {
+	var rows [][]string
+
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.DataAtom == atom.Tr {
+			var row []string
+			for cell := n.FirstChild; cell != nil; cell = cell.NextSibling {
+				if cell.Type == html.ElementNode && (cell.DataAtom == atom.Td || cell.DataAtom == atom.Th) {
+					content := strings.TrimSpace(whitespace.ReplaceAllString(c.children(cell), " "))
+					row = append(row, strings.ReplaceAll(content, "|", `\|`))
+				}
+			}
+			rows = append(rows, row)
+			return
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(n)
+
+	if len(rows) == 0 {
+		return ""
+	}
+
+	columns := 0
+	for _, row := range rows {
+		columns = max(columns, len(row))
+	}
+
+	var b strings.Builder
+	for i, row := range rows {
+		for len(row) < columns {
+			row = append(row, "")
+		}
+		b.WriteString("| " + strings.Join(row, " | ") + " |\n")
+		if i == 0 {
+			b.WriteString("|" + strings.Repeat(" --- |", columns) + "\n")
+		}
+	}
+	return b.String()
+}
+
+func wrap(s string, marker string) string {
+	trimmed := strings.TrimSpace(s)
+	if trimmed == "" {
+		return s
+	}
+	return marker + trimmed + marker
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func text(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+	if n.Type == html.ElementNode && n.DataAtom == atom.Br {
+		return "\n"
+	}
+
+	var b strings.Builder
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		b.WriteString(text(child))
+	}
+	if n.Type == html.ElementNode && n.DataAtom == atom.Div {
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,52 @@
+package importer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestENMLToMarkdown(t *testing.T) {
+	tests := []struct {
+		name  string
+		enml  string
+		media map[string]string
+		want  string
+	}{
+		{
+			name: "paragraphs and inline",
+			enml: `<?xml version="1.0" encoding="UTF-8"?><!DOCTYPE en-note SYSTEM "http://xml.evernote.com/pub/enml2.dtd"><en-note><div>lets <b>go</b> and <i>rust</i></div><div><br/></div><div>see <a href="https://go.dev">go.dev</a></div></en-note>`,
+			want: "lets **go** and *rust*\n\nsee [go.dev](https://go.dev)\n",
+		},
+		{
+			name: "headings and lists",
+			enml: `<en-note><h2>todo</h2><ul><li>one</li><li>two<ol><li>nested</li></ol></li></ul><div><en-todo checked="true"/>done</div><div><en-todo/>open</div></en-note>`,
+			want: "## todo\n\n- one\n- two\n  1. nested\n\n[x] done\n\n[ ] open\n",
+		},
+		{
+			name:  "media",
+			enml:  `<en-note><div><en-media hash="abc" type="image/png"/></div><div><en-media hash="def" type="application/pdf"/></div><en-media hash="unknown" type="image/png"/></en-note>`,
+			media: map[string]string{"abc": "cat.png", "def": "doc.pdf"},
+			want:  "![cat.png](cat.png)\n\n[doc.pdf](doc.pdf)\n",
+		},
+		{
+			name: "table and code",
+			enml: `<en-note><table><tr><td>a</td><td>b|c</td></tr><tr><td>1</td></tr></table><pre>func main() {
+}</pre></en-note>`,
+			want: "| a | b\\|c |\n| --- | --- |\n| 1 |  |\n\n```\nfunc main() {\n}\n```\n",
+		},
+		{
+			name: "blockquote",
+			enml: `<en-note><blockquote><div>first</div><div>second</div></blockquote></en-note>`,
+			want: "> first\n>\n> second\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enmlToMarkdown(tt.enml, tt.media)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
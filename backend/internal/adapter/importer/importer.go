@@ -0,0 +1,52 @@
+package importer
+
+import (
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+var markdownPrefix = regexp.MustCompile(`^\s*(#+|>|[-*+]|\d+\.)?\s*(\[[ xX]\])?`)
+
+// MaxFileSize caps every single file read from an upload, so a small archive
+// cannot expand into something huge.
+const MaxFileSize = 10 << 20
+
+func readLimited(r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, MaxFileSize+1))
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	if len(data) > MaxFileSize {
+		return nil, fiber.NewError(fiber.StatusRequestEntityTooLarge, "imported files must not be larger than 10MB")
+	}
+	return data, nil
+}
+
+func extension(filename string) string {
+	return strings.ToLower(path.Ext(filename))
+}
+
+// truncate shortens s to at most n runes.
+func truncate(s string, n int) string {
+	runes := []rune(strings.TrimSpace(s))
+	if len(runes) <= n {
+		return string(runes)
+	}
+	return strings.TrimSpace(string(runes[:n]))
+}
+
+// describe derives a note description from the first line of its content,
+// since other apps do not have one.
+func describe(content string, title string) string {
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(markdownPrefix.ReplaceAllString(line, ""))
+		if line != "" {
+			return truncate(line, 50)
+		}
+	}
+	return truncate(title, 50)
+}
@@ -0,0 +1,218 @@
+package importer
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// KeepImporter reads Google Keep notes from a Takeout archive, or a single
+// note JSON file taken out of one. Labels become tags and trashed notes are
+// left out.
+type KeepImporter struct {
+	cfg *config.Config
+}
+
+func NewKeepImporter(cfg *config.Config) port.Importer {
+	return &KeepImporter{
+		cfg: cfg,
+	}
+}
+
+type keepNote struct {
+	Title       string `json:"title"`
+	TextContent string `json:"textContent"`
+	ListContent []struct {
+		Text      string `json:"text"`
+		IsChecked bool   `json:"isChecked"`
+	} `json:"listContent"`
+	Labels []struct {
+		Name string `json:"name"`
+	} `json:"labels"`
+	Attachments []struct {
+		FilePath string `json:"filePath"`
+		Mimetype string `json:"mimetype"`
+	} `json:"attachments"`
+	IsTrashed               bool  `json:"isTrashed"`
+	CreatedTimestampUsec    int64 `json:"createdTimestampUsec"`
+	UserEditedTimestampUsec int64 `json:"userEditedTimestampUsec"`
+}
+
+func (i *KeepImporter) Format() string {
+	return "keep"
+}
+
+func (i *KeepImporter) Detect(filename string, file io.ReaderAt, size int64) bool {
+	switch extension(filename) {
+	case ".json":
+		return true
+	case ".zip":
+		archive, err := zip.NewReader(file, size)
+		if err != nil {
+			return false
+		}
+		for _, entry := range archive.File {
+			if isKeepNote(entry.Name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (i *KeepImporter) Import(filename string, file io.ReaderAt, size int64, yield func(note domain.ImportedNote) error) error {
+	if extension(filename) == ".json" {
+		data, err := readLimited(io.NewSectionReader(file, 0, size))
+		if err != nil {
+			return err
+		}
+		note, ok := i.parse(filename, data, nil)
+		if !ok {
+			return nil
+		}
+		return yield(note)
+	}
+
+	archive, err := zip.NewReader(file, size)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid zip archive")
+	}
+
+	files := make(map[string]*zip.File, len(archive.File))
+	for _, entry := range archive.File {
+		files[entry.Name] = entry
+	}
+
+	for _, entry := range archive.File {
+		if !isKeepNote(entry.Name) {
+			continue
+		}
+
+		data, err := readEntry(entry)
+		if err != nil {
+			return err
+		}
+
+		// attachments are stored next to the note they belong to
+		note, ok := i.parse(entry.Name, data, func(name string) ([]byte, error) {
+			attachment, ok := files[path.Join(path.Dir(entry.Name), name)]
+			if !ok {
+				return nil, fiber.NewError(fiber.StatusBadRequest, "attachment "+name+" is missing from the archive")
+			}
+			return readEntry(attachment)
+		})
+		if !ok {
+			continue
+		}
+
+		if err := yield(note); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parse converts a Keep note, it reports false for notes that should not be
+// imported at all.
+func (i *KeepImporter) parse(name string, data []byte, attachment func(name string) ([]byte, error)) (domain.ImportedNote, bool) {
+	var raw keepNote
+
+	note := domain.ImportedNote{
+		File:       name,
+		CoverURL:   i.cfg.Import.DefaultCoverURL,
+		Visibility: string(domain.Private),
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		note.Err = fiber.NewError(fiber.StatusBadRequest, "invalid keep note: "+err.Error())
+		return note, true
+	}
+
+	if raw.IsTrashed {
+		return note, false
+	}
+
+	var content strings.Builder
+	content.WriteString(strings.TrimSpace(raw.TextContent))
+	for _, item := range raw.ListContent {
+		if content.Len() > 0 {
+			content.WriteString("\n")
+		}
+		if item.IsChecked {
+			content.WriteString("- [x] ")
+		} else {
+			content.WriteString("- [ ] ")
+		}
+		content.WriteString(strings.TrimSpace(item.Text))
+	}
+
+	for _, file := range raw.Attachments {
+		name := path.Base(file.FilePath)
+		if strings.HasPrefix(file.Mimetype, "image/") {
+			content.WriteString("\n\n![" + name + "](" + name + ")")
+		} else {
+			content.WriteString("\n\n[" + name + "](" + name + ")")
+		}
+
+		if attachment == nil {
+			continue
+		}
+		data, err := attachment(name)
+		if err != nil {
+			note.Err = err
+			return note, true
+		}
+		note.Attachments = append(note.Attachments, domain.ImportedAttachment{
+			Name:     name,
+			MimeType: file.Mimetype,
+			Data:     data,
+		})
+	}
+
+	for _, label := range raw.Labels {
+		note.Tags = append(note.Tags, label.Name)
+	}
+
+	note.Content = content.String() + "\n"
+	note.Title = truncate(raw.Title, 25)
+	if note.Title == "" {
+		note.Title = truncate(strings.SplitN(strings.TrimSpace(note.Content), "\n", 2)[0], 25)
+	}
+	if note.Title == "" {
+		note.Title = strings.TrimSuffix(path.Base(name), path.Ext(name))
+	}
+	note.Description = describe(note.Content, note.Title)
+
+	if raw.CreatedTimestampUsec > 0 {
+		note.CreatedAt = time.UnixMicro(raw.CreatedTimestampUsec).UTC()
+	}
+	if raw.UserEditedTimestampUsec > 0 {
+		note.UpdatedAt = time.UnixMicro(raw.UserEditedTimestampUsec).UTC()
+	}
+
+	return note, true
+}
+
+func isKeepNote(name string) bool {
+	return extension(name) == ".json" && path.Base(path.Dir(name)) == "Keep"
+}
+
+func readEntry(entry *zip.File) ([]byte, error) {
+	reader, err := entry.Open()
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "invalid zip archive")
+	}
+	defer reader.Close()
+
+	return readLimited(reader)
+}
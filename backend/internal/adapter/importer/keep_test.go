@@ -0,0 +1,87 @@
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func keepArchive(t *testing.T, files map[string]string) *bytes.Reader {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		assert.NoError(t, err)
+		f.Write([]byte(content))
+	}
+	assert.NoError(t, w.Close())
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestKeepImporter_Import(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Import.DefaultCoverURL = "http://localhost:3000/cover.jpg"
+
+	archive := keepArchive(t, map[string]string{
+		"Takeout/Keep/Groceries.json": `{
+			"title": "Groceries",
+			"textContent": "",
+			"listContent": [{"text": "milk", "isChecked": true}, {"text": "eggs", "isChecked": false}],
+			"labels": [{"name": "home"}],
+			"attachments": [{"filePath": "photo.png", "mimetype": "image/png"}],
+			"isTrashed": false,
+			"createdTimestampUsec": 1690750324000000,
+			"userEditedTimestampUsec": 1690884610000000
+		}`,
+		"Takeout/Keep/photo.png":  "png",
+		"Takeout/Keep/Old.json":   `{"title": "old", "textContent": "gone", "isTrashed": true}`,
+		"Takeout/Keep/Idea.json":  `{"textContent": "Untitled idea about go\nmore"}`,
+		"Takeout/Keep/Labels.txt": "ignored",
+	})
+
+	i := NewKeepImporter(cfg)
+	assert.True(t, i.Detect("takeout.zip", archive, archive.Size()))
+	assert.False(t, NewKeepImporter(cfg).Detect("notes.zip", keepArchive(t, map[string]string{"notes/a.md": "a"}), archive.Size()))
+
+	notes := make(map[string]domain.ImportedNote)
+	err := i.Import("takeout.zip", archive, archive.Size(), func(note domain.ImportedNote) error {
+		notes[note.File] = note
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, notes, 2)
+
+	note := notes["Takeout/Keep/Groceries.json"]
+	assert.NoError(t, note.Err)
+	assert.Equal(t, "Groceries", note.Title)
+	assert.Equal(t, "- [x] milk\n- [ ] eggs\n\n![photo.png](photo.png)\n", note.Content)
+	assert.Equal(t, "milk", note.Description)
+	assert.Equal(t, []string{"home"}, note.Tags)
+	assert.Equal(t, time.Date(2023, 7, 30, 20, 52, 4, 0, time.UTC), note.CreatedAt)
+	assert.Equal(t, []domain.ImportedAttachment{{Name: "photo.png", MimeType: "image/png", Data: []byte("png")}}, note.Attachments)
+
+	idea := notes["Takeout/Keep/Idea.json"]
+	assert.Equal(t, "Untitled idea about go", idea.Title)
+	assert.Equal(t, cfg.Import.DefaultCoverURL, idea.CoverURL)
+}
+
+func TestKeepImporter_MissingAttachment(t *testing.T) {
+	archive := keepArchive(t, map[string]string{
+		"Takeout/Keep/Photo.json": `{"title": "photo", "attachments": [{"filePath": "missing.jpg", "mimetype": "image/jpeg"}]}`,
+	})
+
+	var notes []domain.ImportedNote
+	err := NewKeepImporter(&config.Config{}).Import("takeout.zip", archive, archive.Size(), func(note domain.ImportedNote) error {
+		notes = append(notes, note)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, notes, 1)
+	assert.EqualError(t, notes[0].Err, "attachment missing.jpg is missing from the archive")
+}
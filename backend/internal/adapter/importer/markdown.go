@@ -0,0 +1,110 @@
+package importer
+
+import (
+	"archive/zip"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// MarkdownImporter reads single Markdown files or zip archives of them, with
+// the note fields taken from an optional YAML front matter. The description
+// and cover default like those of the other importers when it leaves them out.
+type MarkdownImporter struct {
+	cfg *config.Config
+}
+
+func NewMarkdownImporter(cfg *config.Config) port.Importer {
+	return &MarkdownImporter{
+		cfg: cfg,
+	}
+}
+
+func (i *MarkdownImporter) Format() string {
+	return "markdown"
+}
+
+func (i *MarkdownImporter) Detect(filename string, file io.ReaderAt, size int64) bool {
+	switch extension(filename) {
+	case ".md", ".markdown", ".zip":
+		return true
+	}
+	return false
+}
+
+func (i *MarkdownImporter) Import(filename string, file io.ReaderAt, size int64, yield func(note domain.ImportedNote) error) error {
+	if extension(filename) != ".zip" {
+		data, err := readLimited(io.NewSectionReader(file, 0, size))
+		if err != nil {
+			return err
+		}
+		return yield(i.parse(filename, data))
+	}
+
+	archive, err := zip.NewReader(file, size)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid zip archive")
+	}
+
+	for _, entry := range archive.File {
+		ext := extension(entry.Name)
+		if entry.FileInfo().IsDir() || (ext != ".md" && ext != ".markdown") || strings.HasPrefix(entry.Name, "__MACOSX/") {
+			continue
+		}
+
+		data, err := readEntry(entry)
+		if err != nil {
+			return err
+		}
+
+		if err := yield(i.parse(entry.Name, data)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *MarkdownImporter) parse(name string, data []byte) domain.ImportedNote {
+	var meta domain.NoteFrontMatter
+
+	note := domain.ImportedNote{File: name}
+
+	content, err := util.ParseFrontMatter(data, &meta)
+	if err != nil {
+		note.Err = fiber.NewError(fiber.StatusBadRequest, "invalid front matter: "+err.Error())
+		return note
+	}
+
+	note.Title = meta.Title
+	note.Description = meta.Description
+	note.CoverURL = meta.CoverURL
+	note.Visibility = meta.Visibility
+	note.Notebook = meta.Notebook
+	note.Tags = meta.Tags
+	note.Content = content
+	note.CreatedAt = meta.CreatedAt
+	note.UpdatedAt = meta.UpdatedAt
+
+	if note.Title == "" {
+		note.Title = truncate(strings.TrimSuffix(path.Base(name), path.Ext(name)), 25)
+	}
+	if note.Description == "" {
+		note.Description = describe(content, note.Title)
+	}
+	if note.CoverURL == "" {
+		note.CoverURL = i.cfg.Import.DefaultCoverURL
+	}
+	if dir := path.Dir(name); note.Notebook == "" && dir != "." {
+		note.Notebook = path.Base(dir)
+	}
+
+	return note
+}
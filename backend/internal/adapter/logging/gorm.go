@@ -0,0 +1,82 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// slowQuery is how long a query may take before it is logged as slow, the
+// threshold of the default logger of gorm.
+const slowQuery = 200 * time.Millisecond
+
+// GormLogger logs the queries of gorm with the logger of their context, so a
+// failed or slow query shows up with the request it was made for. Failed
+// queries are errors, slow ones warnings and the rest debug. Missing records
+// are left out, they are answered with a 404.
+type GormLogger struct{}
+
+func NewGormLogger() *GormLogger {
+	return &GormLogger{}
+}
+
+// LogMode is ignored, the level of the logger decides.
+func (l *GormLogger) LogMode(logger.LogLevel) logger.Interface {
+	return l
+}
+
+func (l *GormLogger) Info(ctx context.Context, msg string, data ...interface{}) {
+	util.Logger(ctx).InfoContext(ctx, fmt.Sprintf(msg, data...))
+}
+
+func (l *GormLogger) Warn(ctx context.Context, msg string, data ...interface{}) {
+	util.Logger(ctx).WarnContext(ctx, fmt.Sprintf(msg, data...))
+}
+
+func (l *GormLogger) Error(ctx context.Context, msg string, data ...interface{}) {
+	util.Logger(ctx).ErrorContext(ctx, fmt.Sprintf(msg, data...))
+}
+
+func (l *GormLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+	log := util.Logger(ctx)
+	elapsed := time.Since(begin)
+
+	var level slog.Level
+	var msg string
+	switch {
+	case err != nil && !errors.Is(err, gorm.ErrRecordNotFound):
+		level, msg = slog.LevelError, "query failed"
+	case elapsed > slowQuery:
+		level, msg = slog.LevelWarn, "slow query"
+	default:
+		level, msg = slog.LevelDebug, "query"
+	}
+	if !log.Enabled(ctx, level) {
+		return
+	}
+
+	sql, rows := fc()
+	attrs := []slog.Attr{
+		slog.String("sql", sql),
+		slog.Int64("rows", rows),
+		slog.Float64("elapsed_ms", float64(elapsed.Microseconds())/1000),
+	}
+	if level == slog.LevelError {
+		attrs = append(attrs, slog.String("err", err.Error()))
+	}
+	log.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// ParamsFilter leaves the values out of logged queries, they hold password
+// hashes and tokens. Scan records its query on its own and keeps the values,
+// so secrets must not be bound in one.
+func (l *GormLogger) ParamsFilter(ctx context.Context, sql string, params ...interface{}) (string, []interface{}) {
+	return sql, nil
+}
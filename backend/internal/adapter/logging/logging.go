@@ -0,0 +1,95 @@
+package logging
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// redacted are the keys whose values never make it into a log. They are
+// matched in lower case anywhere in the key, so access_token and Set-Cookie
+// are caught too.
+var redacted = []string{"password", "secret", "token", "cookie", "authorization"}
+
+// NewLogger writes JSON lines to w from the level of cfg up.
+func NewLogger(w io.Writer, cfg *config.Config) *slog.Logger {
+	var level slog.Level
+	// the level is validated by config.Load, info is the zero value
+	_ = level.UnmarshalText([]byte(cfg.Log.Level))
+
+	return slog.New(slog.NewJSONHandler(w, &slog.HandlerOptions{
+		Level:       level,
+		ReplaceAttr: redact,
+	}))
+}
+
+func redact(groups []string, attr slog.Attr) slog.Attr {
+	key := strings.ToLower(attr.Key)
+	for _, s := range redacted {
+		if strings.Contains(key, s) {
+			return slog.String(attr.Key, "[REDACTED]")
+		}
+	}
+	return attr
+}
+
+// Middleware hands a logger holding the request ID and trace ID down with
+// ctx.UserContext(), the auth middleware adds the user to it. Every request is
+// logged once it is done. Errors are handled here rather than by the app so
+// the status they end up with is the one logged.
+func Middleware(logger *slog.Logger) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		start := time.Now()
+
+		requestLogger := logger
+		if id, ok := ctx.Locals("request_id").(string); ok {
+			requestLogger = requestLogger.With("request_id", id)
+		}
+		if span := trace.SpanContextFromContext(ctx.UserContext()); span.HasTraceID() {
+			requestLogger = requestLogger.With("trace_id", span.TraceID().String())
+		}
+		ctx.SetUserContext(util.WithLogger(ctx.UserContext(), requestLogger))
+
+		err := ctx.Next()
+		if err != nil {
+			if err := ctx.App().ErrorHandler(ctx, err); err != nil {
+				_ = ctx.SendStatus(fiber.StatusInternalServerError)
+			}
+		}
+
+		status := ctx.Response().StatusCode()
+		attrs := []slog.Attr{
+			// fiber reuses the memory of the request, the record may outlive it
+			slog.String("method", strings.Clone(ctx.Method())),
+			slog.Int("status", status),
+			slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+			slog.String("ip", strings.Clone(ctx.IP())),
+		}
+		// the template rather than the path, paths carry share link tokens
+		if route, ok := util.Route(ctx); ok {
+			attrs = append(attrs, slog.String("route", route))
+		} else {
+			attrs = append(attrs, slog.String("path", strings.Clone(ctx.Path())))
+		}
+		if err != nil {
+			attrs = append(attrs, slog.String("err", err.Error()))
+		}
+
+		level := slog.LevelInfo
+		if status >= fiber.StatusInternalServerError {
+			level = slog.LevelError
+		}
+
+		// the logger of the user context has the user once auth has run
+		util.Logger(ctx.UserContext()).LogAttrs(ctx.UserContext(), level, "request", attrs...)
+
+		return nil
+	}
+}
@@ -0,0 +1,125 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/shironxn/blanknotes/internal/adapter/http/middleware"
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// lines decodes the JSON lines written to buf.
+func lines(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+
+	var records []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var record map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &record), line)
+		records = append(records, record)
+	}
+	buf.Reset()
+
+	return records
+}
+
+func TestNewLogger(t *testing.T) {
+	var buf bytes.Buffer
+	cfg := &config.Config{}
+	cfg.Log.Level = "warn"
+	logger := NewLogger(&buf, cfg)
+
+	logger.Info("left out")
+	logger.Warn("login", "email", "shiron@example.com", "password", "password", "access_token", "eyJ", "Set-Cookie", "refresh-token=eyJ")
+
+	records := lines(t, &buf)
+	require.Len(t, records, 1)
+	assert.Equal(t, "WARN", records[0]["level"])
+	assert.Equal(t, "shiron@example.com", records[0]["email"])
+	assert.Equal(t, "[REDACTED]", records[0]["password"])
+	assert.Equal(t, "[REDACTED]", records[0]["access_token"])
+	assert.Equal(t, "[REDACTED]", records[0]["Set-Cookie"])
+}
+
+func TestMiddleware(t *testing.T) {
+	var buf bytes.Buffer
+	cfg := &config.Config{}
+	cfg.Log.Level = "debug"
+	cfg.Database.Driver = "sqlite"
+	cfg.Database.Name = filepath.Join(t.TempDir(), "test.db")
+	logger := NewLogger(&buf, cfg)
+
+	db, err := config.NewGorm(cfg).Connection()
+	require.NoError(t, err)
+	db.Logger = NewGormLogger()
+
+	app := fiber.New(fiber.Config{ErrorHandler: config.ErrorHandler()})
+	app.Use(middleware.RequestID())
+	app.Use(Middleware(logger))
+	app.Get("/api/v1/notes/:id", func(ctx *fiber.Ctx) error {
+		// what the auth middleware does
+		ctx.Locals("claims", &domain.Claims{UserID: 7})
+		ctx.SetUserContext(util.WithLogger(ctx.UserContext(), util.Logger(ctx.UserContext()).With("user_id", 7)))
+
+		var id string
+		if err := db.WithContext(ctx.UserContext()).Raw("SELECT ?", "secret value").Row().Scan(&id); err != nil {
+			return err
+		}
+		if ctx.Params("id") == "2" {
+			return fiber.ErrInternalServerError
+		}
+		return ctx.SendStatus(fiber.StatusOK)
+	})
+
+	req := httptest.NewRequest(fiber.MethodGet, "/api/v1/notes/1", nil)
+	req.Header.Set(middleware.RequestIDHeader, "proxy-1")
+	res, err := app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, "proxy-1", res.Header.Get(middleware.RequestIDHeader))
+
+	records := lines(t, &buf)
+	require.Len(t, records, 2)
+	query, request := records[0], records[1]
+
+	assert.Equal(t, "query", query["msg"])
+	assert.Equal(t, "proxy-1", query["request_id"])
+	assert.Equal(t, 7.0, query["user_id"])
+	assert.Equal(t, "SELECT ?", query["sql"], "values are left out")
+
+	assert.Equal(t, "request", request["msg"])
+	assert.Equal(t, "INFO", request["level"])
+	assert.Equal(t, "proxy-1", request["request_id"])
+	assert.Equal(t, 7.0, request["user_id"])
+	assert.Equal(t, "GET", request["method"])
+	assert.Equal(t, "/api/v1/notes/:id", request["route"])
+	assert.Equal(t, 200.0, request["status"])
+	assert.Contains(t, request, "latency_ms")
+
+	res, err = app.Test(httptest.NewRequest(fiber.MethodGet, "/api/v1/notes/2", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusInternalServerError, res.StatusCode)
+	id := res.Header.Get(middleware.RequestIDHeader)
+	assert.NotEmpty(t, id)
+
+	request = lines(t, &buf)[1]
+	assert.Equal(t, "ERROR", request["level"])
+	assert.Equal(t, id, request["request_id"])
+	assert.Equal(t, fiber.ErrInternalServerError.Message, request["err"])
+
+	_, err = app.Test(httptest.NewRequest(fiber.MethodGet, "/wp-login.php", nil))
+	require.NoError(t, err)
+	request = lines(t, &buf)[0]
+	assert.Equal(t, "/wp-login.php", request["path"])
+	assert.Equal(t, 404.0, request["status"])
+	assert.NotContains(t, request, "user_id")
+}
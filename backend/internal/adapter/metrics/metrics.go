@@ -0,0 +1,174 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"gorm.io/gorm"
+)
+
+const namespace = "blanknotes"
+
+// unmatched labels requests no route was found for, so scanners probing
+// random paths cannot blow up the number of series.
+const unmatched = "unmatched"
+
+type Metrics struct {
+	registry *prometheus.Registry
+
+	requests     *prometheus.CounterVec
+	duration     *prometheus.HistogramVec
+	logins       *prometheus.CounterVec
+	notesCreated prometheus.Counter
+}
+
+// NewMetrics registers the HTTP, database and business metrics along with
+// those of the Go runtime and the process. The pool of db is read on every
+// scrape, as are the number of users and notes.
+func NewMetrics(db *gorm.DB) (*Metrics, error) {
+	pool, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "HTTP requests by method, route template and status.",
+		}, []string{"method", "route", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "Time spent handling HTTP requests by method, route template and status.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		logins: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "logins_total",
+			Help:      "Login attempts by result, success or failure.",
+		}, []string{"result"}),
+		notesCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "notes_created_total",
+			Help:      "Notes created, imported ones included.",
+		}),
+	}
+
+	// start both results at zero so rate() works before the first failure
+	m.logins.WithLabelValues("success")
+	m.logins.WithLabelValues("failure")
+
+	if err := errors.Join(
+		m.registry.Register(collectors.NewGoCollector()),
+		m.registry.Register(collectors.NewProcessCollector(collectors.ProcessCollectorOpts{})),
+		m.registry.Register(collectors.NewDBStatsCollector(pool, db.Dialector.Name())),
+		m.registry.Register(m.requests),
+		m.registry.Register(m.duration),
+		m.registry.Register(m.logins),
+		m.registry.Register(m.notesCreated),
+		m.registry.Register(newTotals(db)),
+	); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (m *Metrics) Login(succeeded bool) {
+	if succeeded {
+		m.logins.WithLabelValues("success").Inc()
+	} else {
+		m.logins.WithLabelValues("failure").Inc()
+	}
+}
+
+func (m *Metrics) NoteCreated() {
+	m.notesCreated.Inc()
+}
+
+// Middleware measures every request after it. Errors are handled here rather
+// than by the app so the status they end up with is the one recorded.
+func (m *Metrics) Middleware() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		start := time.Now()
+
+		if err := ctx.Next(); err != nil {
+			if err := ctx.App().ErrorHandler(ctx, err); err != nil {
+				_ = ctx.SendStatus(fiber.StatusInternalServerError)
+			}
+		}
+
+		route, ok := util.Route(ctx)
+		if !ok {
+			route = unmatched
+		}
+
+		labels := prometheus.Labels{
+			// fiber reuses the memory of the request, labels outlive it
+			"method": strings.Clone(ctx.Method()),
+			"route":  route,
+			"status": strconv.Itoa(ctx.Response().StatusCode()),
+		}
+		m.requests.With(labels).Inc()
+		m.duration.With(labels).Observe(time.Since(start).Seconds())
+
+		return nil
+	}
+}
+
+// Handler serves the metrics in the Prometheus text format.
+func (m *Metrics) Handler() fiber.Handler {
+	return adaptor.HTTPHandler(promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{
+		Registry: m.registry,
+	}))
+}
+
+// totals counts the users and notes in the database when scraped. Trashed
+// notes and deleted users are left out.
+type totals struct {
+	db    *gorm.DB
+	users *prometheus.Desc
+	notes *prometheus.Desc
+}
+
+func newTotals(db *gorm.DB) *totals {
+	return &totals{
+		db:    db,
+		users: prometheus.NewDesc(namespace+"_users", "Users that are not deleted.", nil, nil),
+		notes: prometheus.NewDesc(namespace+"_notes", "Notes that are not in the trash.", nil, nil),
+	}
+}
+
+func (t *totals) Describe(ch chan<- *prometheus.Desc) {
+	ch <- t.users
+	ch <- t.notes
+}
+
+func (t *totals) Collect(ch chan<- prometheus.Metric) {
+	// a slow database must not hold up the scrape
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	t.collect(ctx, ch, t.users, &domain.User{})
+	t.collect(ctx, ch, t.notes, &domain.Note{})
+}
+
+func (t *totals) collect(ctx context.Context, ch chan<- prometheus.Metric, desc *prometheus.Desc, model interface{}) {
+	var count int64
+	if err := t.db.WithContext(ctx).Model(model).Count(&count).Error; err != nil {
+		ch <- prometheus.NewInvalidMetric(desc, err)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(count))
+}
@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"io"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/shironxn/blanknotes/internal/adapter/migration"
+	"github.com/shironxn/blanknotes/internal/config"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm/logger"
+)
+
+func TestMetrics(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Database.Driver = "sqlite"
+	cfg.Database.Name = filepath.Join(t.TempDir(), "test.db")
+
+	db, err := config.NewGorm(cfg).Connection()
+	require.NoError(t, err)
+	db.Logger = logger.Discard
+
+	migrator, err := migration.NewMigrator(db)
+	require.NoError(t, err)
+	_, err = migrator.Up()
+	require.NoError(t, err)
+
+	m, err := NewMetrics(db)
+	require.NoError(t, err)
+
+	m.Login(true)
+	m.Login(false)
+	m.Login(false)
+	m.NoteCreated()
+
+	app := fiber.New(fiber.Config{ErrorHandler: config.ErrorHandler()})
+	app.Use(m.Middleware())
+	app.Use(func(ctx *fiber.Ctx) error {
+		return ctx.Next()
+	})
+	app.Get("/api/v1/notes/:id", func(ctx *fiber.Ctx) error {
+		if ctx.Params("id") == "2" {
+			return fiber.NewError(fiber.StatusNotFound, "note not found")
+		}
+		return ctx.SendStatus(fiber.StatusOK)
+	})
+	app.Get("/metrics", m.Handler())
+
+	for _, path := range []string{"/api/v1/notes/1", "/api/v1/notes/2", "/api/v1/notes/3", "/wp-login.php"} {
+		_, err := app.Test(httptest.NewRequest(fiber.MethodGet, path, nil))
+		require.NoError(t, err)
+	}
+
+	res, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/metrics", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+
+	for _, line := range []string{
+		`http_requests_total{method="GET",route="/api/v1/notes/:id",status="200"} 2`,
+		`http_requests_total{method="GET",route="/api/v1/notes/:id",status="404"} 1`,
+		`http_requests_total{method="GET",route="unmatched",status="404"} 1`,
+		`http_request_duration_seconds_count{method="GET",route="/api/v1/notes/:id",status="200"} 2`,
+		`blanknotes_logins_total{result="success"} 1`,
+		`blanknotes_logins_total{result="failure"} 2`,
+		`blanknotes_notes_created_total 1`,
+		`blanknotes_users 0`,
+		`blanknotes_notes 0`,
+		`go_sql_max_open_connections{db_name="sqlite"}`,
+	} {
+		assert.Contains(t, string(body), line)
+	}
+}
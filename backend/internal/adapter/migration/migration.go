@@ -0,0 +1,372 @@
+package migration
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+//go:embed postgres/*.sql mysql/*.sql sqlite/*.sql
+var scripts embed.FS
+
+// lockKey identifies the advisory lock held while migrating, any constant
+// works as long as every replica uses the same one.
+const lockKey = 4207301
+
+// schemaMigrations creates the table recording applied migrations in every
+// dialect.
+var schemaMigrations = map[string]string{
+	"postgres": `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version BIGINT PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at TIMESTAMPTZ NOT NULL
+	)`,
+	"mysql": `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version BIGINT UNSIGNED PRIMARY KEY,
+		name VARCHAR(255) NOT NULL,
+		applied_at DATETIME(3) NOT NULL
+	)`,
+	"sqlite": `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at DATETIME NOT NULL
+	)`,
+}
+
+var scriptName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Version uint
+	Name    string
+	Up      string
+	Down    string
+}
+
+type Status struct {
+	Version   uint
+	Name      string
+	AppliedAt *time.Time
+}
+
+// SchemaMigration is a row of schema_migrations, one for every applied
+// migration.
+type SchemaMigration struct {
+	Version   uint `gorm:"primarykey;autoIncrement:false"`
+	Name      string
+	AppliedAt time.Time
+}
+
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// NewMigrator loads the migrations embedded for the dialect of db.
+func NewMigrator(db *gorm.DB) (*Migrator, error) {
+	dialect := db.Dialector.Name()
+
+	dir, err := fs.Sub(scripts, dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	migrations, err := Load(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(migrations) == 0 {
+		return nil, fmt.Errorf("no migrations for %s", dialect)
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}, nil
+}
+
+// Load reads migrations from files named <version>_<name>.up.sql and
+// <version>_<name>.down.sql. Every version needs both. Statements in a script
+// end with a semicolon at the end of a line, they are run one by one because
+// not every driver accepts several in one call.
+func Load(dir fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(dir, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[uint]*Migration{}
+	for _, entry := range entries {
+		match := scriptName.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseUint(match[1], 10, 32)
+		if err != nil || version == 0 {
+			return nil, fmt.Errorf("invalid migration version in %s", entry.Name())
+		}
+
+		data, err := fs.ReadFile(dir, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[uint(version)]
+		if !ok {
+			migration = &Migration{Version: uint(version), Name: match[2]}
+			byVersion[uint(version)] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has two names, %s and %s", version, migration.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			migration.Up = string(data)
+		} else {
+			migration.Down = string(data)
+		}
+	}
+
+	var migrations []Migration
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s needs an up and a down script", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Up applies every pending migration in order and returns those it applied.
+func (m *Migrator) Up() ([]Migration, error) {
+	if err := m.init(); err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	for _, migration := range m.migrations {
+		var done bool
+
+		if err := m.locked(func(tx *gorm.DB) error {
+			// another replica may have applied it while we waited for the lock
+			var count int64
+			if err := tx.Model(&SchemaMigration{}).Where("version = ?", migration.Version).Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				return nil
+			}
+
+			if err := exec(tx, migration.Up); err != nil {
+				return fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			done = true
+			return tx.Create(&SchemaMigration{
+				Version:   migration.Version,
+				Name:      migration.Name,
+				AppliedAt: time.Now(),
+			}).Error
+		}); err != nil {
+			return applied, err
+		}
+
+		if done {
+			applied = append(applied, migration)
+		}
+	}
+
+	return applied, nil
+}
+
+// Down rolls back the last steps applied migrations and returns those it
+// rolled back.
+func (m *Migrator) Down(steps int) ([]Migration, error) {
+	if err := m.init(); err != nil {
+		return nil, err
+	}
+
+	var reverted []Migration
+	for i := 0; i < steps; i++ {
+		var migration *Migration
+
+		if err := m.locked(func(tx *gorm.DB) error {
+			var last SchemaMigration
+			if err := tx.Order("version DESC").First(&last).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return nil
+				}
+				return err
+			}
+
+			migration = m.find(last.Version)
+			if migration == nil {
+				return fmt.Errorf("migration %d_%s is applied but unknown to this version", last.Version, last.Name)
+			}
+
+			if err := exec(tx, migration.Down); err != nil {
+				return fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			return tx.Delete(&SchemaMigration{}, last.Version).Error
+		}); err != nil {
+			return reverted, err
+		}
+
+		if migration == nil {
+			break
+		}
+		reverted = append(reverted, *migration)
+	}
+
+	return reverted, nil
+}
+
+// Status lists every known migration and when it was applied, followed by
+// applied ones this version does not know about.
+func (m *Migrator) Status() ([]Status, error) {
+	if err := m.init(); err != nil {
+		return nil, err
+	}
+
+	var rows []SchemaMigration
+	if err := m.db.Order("version").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	applied := map[uint]SchemaMigration{}
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+
+	var status []Status
+	for _, migration := range m.migrations {
+		s := Status{Version: migration.Version, Name: migration.Name}
+		if row, ok := applied[migration.Version]; ok {
+			s.AppliedAt = &row.AppliedAt
+			delete(applied, migration.Version)
+		}
+		status = append(status, s)
+	}
+
+	for _, row := range rows {
+		if _, ok := applied[row.Version]; ok {
+			status = append(status, Status{Version: row.Version, Name: row.Name, AppliedAt: &row.AppliedAt})
+		}
+	}
+
+	return status, nil
+}
+
+// Pending lists the migrations not applied yet. Unlike Up and Status it does
+// not create the schema_migrations table, it only reads it.
+func (m *Migrator) Pending() ([]Migration, error) {
+	var versions []uint
+	if err := m.db.Model(&SchemaMigration{}).Pluck("version", &versions).Error; err != nil {
+		return nil, err
+	}
+
+	applied := map[uint]bool{}
+	for _, version := range versions {
+		applied[version] = true
+	}
+
+	var pending []Migration
+	for _, migration := range m.migrations {
+		if !applied[migration.Version] {
+			pending = append(pending, migration)
+		}
+	}
+
+	return pending, nil
+}
+
+func (m *Migrator) init() error {
+	return m.db.Exec(schemaMigrations[m.db.Dialector.Name()]).Error
+}
+
+// locked runs fn in a transaction holding the migration lock. On postgres the
+// lock is released with the transaction, so it also works through connection
+// poolers that do not keep sessions. MySQL commits DDL on its own, so there a
+// failed migration can leave part of its statements applied. SQLite allows a
+// single writer anyway.
+func (m *Migrator) locked(fn func(tx *gorm.DB) error) error {
+	switch m.db.Dialector.Name() {
+	case "postgres":
+		return m.db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", lockKey).Error; err != nil {
+				return err
+			}
+			return fn(tx)
+		})
+	case "mysql":
+		// named locks belong to a connection and outlive transactions
+		return m.db.Connection(func(conn *gorm.DB) error {
+			name := fmt.Sprintf("migration_%d", lockKey)
+			if err := conn.Exec("SELECT GET_LOCK(?, -1)", name).Error; err != nil {
+				return err
+			}
+			defer conn.Exec("SELECT RELEASE_LOCK(?)", name)
+
+			return conn.Transaction(fn)
+		})
+	default:
+		return m.db.Transaction(fn)
+	}
+}
+
+func exec(tx *gorm.DB, script string) error {
+	for _, statement := range statements(script) {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// statements splits a script at semicolons ending a line.
+func statements(script string) []string {
+	var statements []string
+	var statement strings.Builder
+
+	for _, line := range strings.Split(script, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+
+		statement.WriteString(line)
+		statement.WriteString("\n")
+
+		if strings.HasSuffix(trimmed, ";") {
+			statements = append(statements, strings.TrimSpace(statement.String()))
+			statement.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(statement.String()); rest != "" {
+		statements = append(statements, rest)
+	}
+
+	return statements
+}
+
+func (m *Migrator) find(version uint) *Migration {
+	for i := range m.migrations {
+		if m.migrations[i].Version == version {
+			return &m.migrations[i]
+		}
+	}
+	return nil
+}
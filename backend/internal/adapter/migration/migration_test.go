@@ -0,0 +1,101 @@
+package migration
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad(t *testing.T) {
+	migrations, err := Load(fstest.MapFS{
+		"0002_add_tags.up.sql":   {Data: []byte("CREATE TABLE tags ();")},
+		"0002_add_tags.down.sql": {Data: []byte("DROP TABLE tags;")},
+		"0001_init.up.sql":       {Data: []byte("CREATE TABLE notes ();")},
+		"0001_init.down.sql":     {Data: []byte("DROP TABLE notes;")},
+		"README.md":              {Data: []byte("not a migration")},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []Migration{
+		{Version: 1, Name: "init", Up: "CREATE TABLE notes ();", Down: "DROP TABLE notes;"},
+		{Version: 2, Name: "add_tags", Up: "CREATE TABLE tags ();", Down: "DROP TABLE tags;"},
+	}, migrations)
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		dir   fstest.MapFS
+		error string
+	}{
+		{
+			name: "missing down",
+			dir: fstest.MapFS{
+				"0001_init.up.sql": {Data: []byte("CREATE TABLE notes ();")},
+			},
+			error: "migration 1_init needs an up and a down script",
+		},
+		{
+			name: "two names",
+			dir: fstest.MapFS{
+				"0001_init.up.sql":    {Data: []byte("CREATE TABLE notes ();")},
+				"0001_notes.down.sql": {Data: []byte("DROP TABLE notes;")},
+			},
+			error: "migration 1 has two names, init and notes",
+		},
+		{
+			name: "version zero",
+			dir: fstest.MapFS{
+				"0000_init.up.sql": {Data: []byte("CREATE TABLE notes ();")},
+			},
+			error: "invalid migration version in 0000_init.up.sql",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(tt.dir)
+			assert.EqualError(t, err, tt.error)
+		})
+	}
+}
+
+func TestLoad_Embedded(t *testing.T) {
+	var want []Migration
+	for _, dialect := range []string{"postgres", "mysql", "sqlite"} {
+		dir, err := fs.Sub(scripts, dialect)
+		assert.NoError(t, err)
+
+		migrations, err := Load(dir)
+		assert.NoError(t, err)
+		for i, migration := range migrations {
+			assert.Equal(t, uint(i+1), migration.Version, "versions have no gaps")
+		}
+
+		// every dialect has the same migrations
+		for i := range migrations {
+			migrations[i].Up, migrations[i].Down = "", ""
+		}
+		if want == nil {
+			want = migrations
+		}
+		assert.Equal(t, want, migrations, dialect)
+	}
+}
+
+func TestStatements(t *testing.T) {
+	script := `-- a comment; with a semicolon
+CREATE TABLE notes (
+    id INTEGER PRIMARY KEY
+);
+CREATE TRIGGER notes_insert BEFORE INSERT ON notes
+BEGIN SELECT RAISE(ABORT, 'no'); END;
+
+DROP TABLE tags`
+
+	assert.Equal(t, []string{
+		"CREATE TABLE notes (\n    id INTEGER PRIMARY KEY\n);",
+		"CREATE TRIGGER notes_insert BEFORE INSERT ON notes\nBEGIN SELECT RAISE(ABORT, 'no'); END;",
+		"DROP TABLE tags",
+	}, statements(script))
+}
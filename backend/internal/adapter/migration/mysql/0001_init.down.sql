@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS attachments;
+DROP TABLE IF EXISTS upload_variants;
+DROP TABLE IF EXISTS uploads;
+DROP TABLE IF EXISTS share_links;
+DROP TABLE IF EXISTS note_shares;
+DROP TABLE IF EXISTS notes;
+DROP TABLE IF EXISTS refresh_tokens;
+DROP TABLE IF EXISTS users;
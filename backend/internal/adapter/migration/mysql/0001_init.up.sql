@@ -0,0 +1,104 @@
+CREATE TABLE IF NOT EXISTS users (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    name VARCHAR(255) NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    bio TEXT,
+    avatar_url TEXT,
+    password TEXT NOT NULL,
+    INDEX idx_users_deleted_at (deleted_at),
+    UNIQUE INDEX idx_users_name (name),
+    UNIQUE INDEX idx_users_email (email)
+);
+
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    user_id BIGINT UNSIGNED PRIMARY KEY,
+    token TEXT NOT NULL,
+    CONSTRAINT fk_users_refresh_token FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+CREATE TABLE IF NOT EXISTS notes (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    title VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    cover_url TEXT NOT NULL,
+    content LONGTEXT NOT NULL,
+    visibility VARCHAR(16) NOT NULL DEFAULT 'private',
+    user_id BIGINT UNSIGNED NOT NULL,
+    INDEX idx_notes_deleted_at (deleted_at),
+    CONSTRAINT fk_users_notes FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+CREATE TABLE IF NOT EXISTS note_shares (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    note_id BIGINT UNSIGNED NOT NULL,
+    user_id BIGINT UNSIGNED NOT NULL,
+    permission VARCHAR(16) NOT NULL DEFAULT 'viewer',
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    UNIQUE INDEX idx_note_shares_note_user (note_id, user_id),
+    CONSTRAINT fk_note_shares_note FOREIGN KEY (note_id) REFERENCES notes (id) ON DELETE CASCADE,
+    CONSTRAINT fk_note_shares_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS share_links (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    note_id BIGINT UNSIGNED NOT NULL,
+    token VARCHAR(255) NOT NULL,
+    password TEXT,
+    expires_at DATETIME(3),
+    created_at DATETIME(3),
+    INDEX idx_share_links_note_id (note_id),
+    UNIQUE INDEX idx_share_links_token (token),
+    CONSTRAINT fk_share_links_note FOREIGN KEY (note_id) REFERENCES notes (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS uploads (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT UNSIGNED NOT NULL,
+    `key` VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    width BIGINT,
+    height BIGINT,
+    created_at DATETIME(3),
+    INDEX idx_uploads_user_id (user_id),
+    UNIQUE INDEX idx_uploads_key (`key`),
+    INDEX idx_uploads_status (status),
+    CONSTRAINT fk_uploads_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS upload_variants (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    upload_id BIGINT UNSIGNED NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    `key` VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL,
+    width BIGINT NOT NULL,
+    height BIGINT NOT NULL,
+    INDEX idx_upload_variants_upload_id (upload_id),
+    UNIQUE INDEX idx_upload_variants_key (`key`),
+    CONSTRAINT fk_uploads_variants FOREIGN KEY (upload_id) REFERENCES uploads (id) ON DELETE CASCADE
+);
+
+-- no foreign key on the note, see domain.Attachment
+CREATE TABLE IF NOT EXISTS attachments (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    note_id BIGINT UNSIGNED NOT NULL,
+    user_id BIGINT UNSIGNED NOT NULL,
+    `key` VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL,
+    created_at DATETIME(3),
+    INDEX idx_attachments_note_id (note_id),
+    INDEX idx_attachments_user_id (user_id),
+    UNIQUE INDEX idx_attachments_key (`key`)
+);
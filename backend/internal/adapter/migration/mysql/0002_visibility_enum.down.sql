@@ -0,0 +1 @@
+ALTER TABLE notes MODIFY visibility VARCHAR(16) NOT NULL DEFAULT 'private';
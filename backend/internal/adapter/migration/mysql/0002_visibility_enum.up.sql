@@ -0,0 +1 @@
+ALTER TABLE notes MODIFY visibility ENUM('private', 'public', 'unlisted') NOT NULL DEFAULT 'private';
@@ -0,0 +1,3 @@
+ALTER TABLE notes
+    DROP COLUMN tags,
+    DROP COLUMN notebook;
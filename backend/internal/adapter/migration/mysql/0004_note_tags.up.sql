@@ -0,0 +1,3 @@
+ALTER TABLE notes
+    ADD COLUMN notebook VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN tags TEXT;
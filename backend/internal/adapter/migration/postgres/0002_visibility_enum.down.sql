@@ -0,0 +1,6 @@
+ALTER TABLE notes
+    ALTER COLUMN visibility DROP DEFAULT,
+    ALTER COLUMN visibility TYPE TEXT USING visibility::TEXT,
+    ALTER COLUMN visibility SET DEFAULT 'private';
+
+DROP TYPE visibility;
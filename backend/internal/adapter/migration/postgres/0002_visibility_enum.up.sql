@@ -0,0 +1,6 @@
+CREATE TYPE visibility AS ENUM ('private', 'public', 'unlisted');
+
+ALTER TABLE notes
+    ALTER COLUMN visibility DROP DEFAULT,
+    ALTER COLUMN visibility TYPE visibility USING visibility::visibility,
+    ALTER COLUMN visibility SET DEFAULT 'private';
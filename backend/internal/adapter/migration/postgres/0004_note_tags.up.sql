@@ -0,0 +1,3 @@
+ALTER TABLE notes
+    ADD COLUMN notebook TEXT NOT NULL DEFAULT '',
+    ADD COLUMN tags TEXT;
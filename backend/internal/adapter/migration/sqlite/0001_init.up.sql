@@ -0,0 +1,104 @@
+CREATE TABLE IF NOT EXISTS users (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    created_at DATETIME,
+    updated_at DATETIME,
+    deleted_at DATETIME,
+    name TEXT NOT NULL,
+    email TEXT NOT NULL,
+    bio TEXT,
+    avatar_url TEXT,
+    password TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users (deleted_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_name ON users (name);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON users (email);
+
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    user_id INTEGER PRIMARY KEY,
+    token TEXT NOT NULL,
+    CONSTRAINT fk_users_refresh_token FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+CREATE TABLE IF NOT EXISTS notes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    created_at DATETIME,
+    updated_at DATETIME,
+    deleted_at DATETIME,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL,
+    cover_url TEXT NOT NULL,
+    content TEXT NOT NULL,
+    visibility TEXT NOT NULL DEFAULT 'private',
+    user_id INTEGER NOT NULL,
+    CONSTRAINT fk_users_notes FOREIGN KEY (user_id) REFERENCES users (id)
+);
+CREATE INDEX IF NOT EXISTS idx_notes_deleted_at ON notes (deleted_at);
+
+CREATE TABLE IF NOT EXISTS note_shares (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    note_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    permission TEXT NOT NULL DEFAULT 'viewer',
+    created_at DATETIME,
+    updated_at DATETIME,
+    CONSTRAINT fk_note_shares_note FOREIGN KEY (note_id) REFERENCES notes (id) ON DELETE CASCADE,
+    CONSTRAINT fk_note_shares_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_note_shares_note_user ON note_shares (note_id, user_id);
+
+CREATE TABLE IF NOT EXISTS share_links (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    note_id INTEGER NOT NULL,
+    token TEXT NOT NULL,
+    password TEXT,
+    expires_at DATETIME,
+    created_at DATETIME,
+    CONSTRAINT fk_share_links_note FOREIGN KEY (note_id) REFERENCES notes (id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_share_links_note_id ON share_links (note_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_share_links_token ON share_links (token);
+
+CREATE TABLE IF NOT EXISTS uploads (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL,
+    key TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size INTEGER NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    width INTEGER,
+    height INTEGER,
+    created_at DATETIME,
+    CONSTRAINT fk_uploads_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_uploads_user_id ON uploads (user_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_uploads_key ON uploads (key);
+CREATE INDEX IF NOT EXISTS idx_uploads_status ON uploads (status);
+
+CREATE TABLE IF NOT EXISTS upload_variants (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    upload_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    key TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size INTEGER NOT NULL,
+    width INTEGER NOT NULL,
+    height INTEGER NOT NULL,
+    CONSTRAINT fk_uploads_variants FOREIGN KEY (upload_id) REFERENCES uploads (id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_upload_variants_upload_id ON upload_variants (upload_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_upload_variants_key ON upload_variants (key);
+
+-- no foreign key on the note, see domain.Attachment
+CREATE TABLE IF NOT EXISTS attachments (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    note_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    key TEXT NOT NULL,
+    name TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size INTEGER NOT NULL,
+    created_at DATETIME
+);
+CREATE INDEX IF NOT EXISTS idx_attachments_note_id ON attachments (note_id);
+CREATE INDEX IF NOT EXISTS idx_attachments_user_id ON attachments (user_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_attachments_key ON attachments (key);
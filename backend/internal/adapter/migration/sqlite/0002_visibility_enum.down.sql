@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS notes_visibility_update;
+DROP TRIGGER IF EXISTS notes_visibility_insert;
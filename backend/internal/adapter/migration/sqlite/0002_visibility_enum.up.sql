@@ -0,0 +1,6 @@
+-- sqlite cannot add a check to an existing column, triggers do the same
+CREATE TRIGGER notes_visibility_insert BEFORE INSERT ON notes WHEN NEW.visibility NOT IN ('private', 'public', 'unlisted')
+BEGIN SELECT RAISE(ABORT, 'invalid visibility'); END;
+
+CREATE TRIGGER notes_visibility_update BEFORE UPDATE OF visibility ON notes WHEN NEW.visibility NOT IN ('private', 'public', 'unlisted')
+BEGIN SELECT RAISE(ABORT, 'invalid visibility'); END;
@@ -0,0 +1,2 @@
+ALTER TABLE notes ADD COLUMN notebook TEXT NOT NULL DEFAULT '';
+ALTER TABLE notes ADD COLUMN tags TEXT;
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type AttachmentRepository struct {
+	db *gorm.DB
+}
+
+func NewAttachmentRepository(db *gorm.DB) port.AttachmentRepository {
+	return &AttachmentRepository{
+		db: db,
+	}
+}
+
+// Create saves an attachment unless it takes the usage of its uploader over
+// quota bytes. The row of the user is locked while usage is summed, so
+// concurrent uploads of one user are checked one after the other. SQLite has
+// no row locks, it allows only one writer at a time anyway.
+func (r *AttachmentRepository) Create(ctx context.Context, attachment *domain.Attachment, quota int64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&domain.User{}, attachment.UserID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fiber.NewError(fiber.StatusNotFound, "user not found")
+			}
+			return err
+		}
+
+		var usage int64
+		if err := tx.Model(&domain.Attachment{}).Where("user_id = ?", attachment.UserID).Select("COALESCE(SUM(size), 0)").Scan(&usage).Error; err != nil {
+			return err
+		}
+		if usage+attachment.Size > quota {
+			return fiber.NewError(fiber.StatusRequestEntityTooLarge, fmt.Sprintf("storage quota exceeded, %d of %d bytes used", usage, quota))
+		}
+
+		return tx.Create(attachment).Error
+	})
+}
+
+func (r *AttachmentRepository) GetAll(ctx context.Context, noteID uint) ([]domain.Attachment, error) {
+	var entity []domain.Attachment
+
+	if err := r.db.WithContext(ctx).Where("note_id = ?", noteID).Order("created_at asc").Find(&entity).Error; err != nil {
+		return nil, err
+	}
+
+	if reflect.DeepEqual(entity, []domain.Attachment{}) {
+		return nil, fiber.NewError(fiber.StatusNotFound, "attachments not found")
+	}
+
+	return entity, nil
+}
+
+func (r *AttachmentRepository) GetByID(ctx context.Context, id uint) (*domain.Attachment, error) {
+	var entity domain.Attachment
+
+	if err := r.db.WithContext(ctx).First(&entity, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "attachment not found")
+		}
+		return nil, err
+	}
+
+	return &entity, nil
+}
+
+// GetOrphaned returns attachments whose note was force deleted, soft deleted
+// notes keep their attachments so they can be restored.
+func (r *AttachmentRepository) GetOrphaned(ctx context.Context, limit int) ([]domain.Attachment, error) {
+	var entity []domain.Attachment
+
+	if err := r.db.WithContext(ctx).Where("NOT EXISTS (?)", r.db.Unscoped().Model(&domain.Note{}).Select("1").Where("notes.id = attachments.note_id")).Limit(limit).Find(&entity).Error; err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (r *AttachmentRepository) Delete(ctx context.Context, attachment *domain.Attachment) error {
+	if err := r.db.WithContext(ctx).Delete(attachment).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "attachment not found")
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachmentRepository_Create(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repository := NewAttachmentRepository(db)
+	user := createTestUser(t, db, "shiron")
+	other := createTestUser(t, db, "kuro")
+	note := createTestNote(t, db, user, "mine")
+
+	attachment := func(userID uint, size int64) *domain.Attachment {
+		token, err := util.GenerateToken(8)
+		require.NoError(t, err)
+		return &domain.Attachment{NoteID: note.ID, UserID: userID, Key: "attachments/1/" + token, Name: "a", ContentType: "text/plain", Size: size}
+	}
+
+	require.NoError(t, repository.Create(ctx, attachment(user.ID, 60), 100))
+	assert.EqualError(t, repository.Create(ctx, attachment(user.ID, 50), 100), "storage quota exceeded, 60 of 100 bytes used")
+	// the quota is per uploader
+	assert.NoError(t, repository.Create(ctx, attachment(other.ID, 50), 100))
+	assert.NoError(t, repository.Create(ctx, attachment(user.ID, 40), 100))
+
+	var count int64
+	require.NoError(t, db.Model(&domain.Attachment{}).Count(&count).Error)
+	assert.Equal(t, int64(3), count)
+}
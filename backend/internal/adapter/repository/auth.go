@@ -1,10 +1,10 @@
 package repository
 
 import (
+	"context"
 	"errors"
 
 	"github.com/gofiber/fiber/v2"
-	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/shironxn/blanknotes/internal/core/domain"
 	"github.com/shironxn/blanknotes/internal/core/port"
 	"gorm.io/gorm"
@@ -20,41 +20,52 @@ func NewAuthRepository(db *gorm.DB) port.AuthRepository {
 	}
 }
 
-func (r *AuthRepository) Register(req domain.AuthRegisterRequest) (*domain.User, error) {
+func (r *AuthRepository) Register(ctx context.Context, req domain.AuthRegisterRequest) (*domain.User, error) {
 	entity := domain.User{
 		Name:     req.Name,
 		Email:    req.Email,
 		Password: req.Password,
 	}
-	if err := r.db.Create(&entity).Error; err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-			switch pgErr.ConstraintName {
-			case "idx_users_name":
-				return nil, fiber.NewError(fiber.StatusBadRequest, "user with the same name already exists")
-			case "idx_users_email":
-				return nil, fiber.NewError(fiber.StatusBadRequest, "user with the same email already exists")
-			}
+	if err := r.db.WithContext(ctx).Create(&entity).Error; err != nil {
+		return nil, translate(err)
+	}
+	return &entity, nil
+}
+
+func (r *AuthRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	var entity domain.User
+	if err := r.db.WithContext(ctx).Where("email = ?", email).First(&entity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "user not found")
 		}
 		return nil, err
 	}
 	return &entity, nil
 }
 
-func (r *AuthRepository) GetByEmail(email string) (*domain.User, error) {
+func (r *AuthRepository) GetDeletedByEmail(ctx context.Context, email string) (*domain.User, error) {
 	var entity domain.User
-	if err := r.db.Where("email = ?", email).First(&entity).Error; err != nil {
+	if err := r.db.WithContext(ctx).Unscoped().Where("email = ? AND deleted_at IS NOT NULL", email).First(&entity).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fiber.NewError(fiber.StatusNotFound, "user not found")
+			return nil, fiber.NewError(fiber.StatusNotFound, "deleted user not found")
 		}
 		return nil, err
 	}
 	return &entity, nil
 }
 
-func (r *AuthRepository) GetRefreshToken(id uint) (*domain.RefreshToken, error) {
+func (r *AuthRepository) Restore(ctx context.Context, user *domain.User) (*domain.User, error) {
+	entity := user
+	if err := r.db.WithContext(ctx).Unscoped().Model(entity).Update("deleted_at", nil).Error; err != nil {
+		return nil, err
+	}
+	entity.DeletedAt = gorm.DeletedAt{}
+	return entity, nil
+}
+
+func (r *AuthRepository) GetRefreshToken(ctx context.Context, id uint) (*domain.RefreshToken, error) {
 	var entity domain.RefreshToken
-	if err := r.db.First(&entity, id).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&entity, id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, fiber.NewError(fiber.StatusNotFound, "refresh token not found")
 		}
@@ -64,14 +75,14 @@ func (r *AuthRepository) GetRefreshToken(id uint) (*domain.RefreshToken, error)
 
 }
 
-func (r *AuthRepository) StoreRefreshToken(id uint, token string) error {
+func (r *AuthRepository) StoreRefreshToken(ctx context.Context, id uint, token string) error {
 	var entity = domain.RefreshToken{
 		UserID: id,
 		Token:  token,
 	}
-	return r.db.Save(&entity).Error
+	return r.db.WithContext(ctx).Save(&entity).Error
 }
 
-func (r *AuthRepository) DeleteRefreshToken(entity domain.RefreshToken) error {
-	return r.db.Delete(&entity).Error
+func (r *AuthRepository) DeleteRefreshToken(ctx context.Context, entity domain.RefreshToken) error {
+	return r.db.WithContext(ctx).Delete(&entity).Error
 }
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthRepository_Register(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repository := NewAuthRepository(db)
+	createTestUser(t, db, "shiron")
+
+	tests := []struct {
+		name    string
+		req     domain.AuthRegisterRequest
+		wantErr error
+	}{
+		{
+			name: "success",
+			req:  domain.AuthRegisterRequest{Name: "kuro", Email: "kuro@example.com", Password: "password"},
+		},
+		{
+			name:    "name taken",
+			req:     domain.AuthRegisterRequest{Name: "shiron", Email: "other@example.com", Password: "password"},
+			wantErr: fiber.NewError(fiber.StatusBadRequest, "user with the same name already exists"),
+		},
+		{
+			name:    "email taken",
+			req:     domain.AuthRegisterRequest{Name: "other", Email: "shiron@example.com", Password: "password"},
+			wantErr: fiber.NewError(fiber.StatusBadRequest, "user with the same email already exists"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, err := repository.Register(ctx, tt.req)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotZero(t, user.ID)
+			assert.Equal(t, domain.RoleUser, user.Role)
+		})
+	}
+}
+
+func TestAuthRepository_GetByEmail(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repository := NewAuthRepository(db)
+	user := createTestUser(t, db, "shiron")
+
+	got, err := repository.GetByEmail(ctx, "shiron@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, got.ID)
+
+	_, err = repository.GetByEmail(ctx, "nobody@example.com")
+	assert.Equal(t, fiber.NewError(fiber.StatusNotFound, "user not found"), err)
+}
+
+func TestAuthRepository_Restore(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repository := NewAuthRepository(db)
+	user := createTestUser(t, db, "shiron")
+	require.NoError(t, NewUserRepository(db, newTestPagination(t)).Delete(ctx, user))
+
+	_, err := repository.GetByEmail(ctx, "shiron@example.com")
+	assert.Error(t, err)
+
+	deleted, err := repository.GetDeletedByEmail(ctx, "shiron@example.com")
+	require.NoError(t, err)
+
+	_, err = repository.Restore(ctx, deleted)
+	require.NoError(t, err)
+
+	_, err = repository.GetByEmail(ctx, "shiron@example.com")
+	assert.NoError(t, err)
+}
+
+func TestAuthRepository_RefreshToken(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repository := NewAuthRepository(db)
+	user := createTestUser(t, db, "shiron")
+
+	require.NoError(t, repository.StoreRefreshToken(ctx, user.ID, "first"))
+	require.NoError(t, repository.StoreRefreshToken(ctx, user.ID, "second"))
+
+	token, err := repository.GetRefreshToken(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "second", token.Token)
+
+	require.NoError(t, repository.DeleteRefreshToken(ctx, *token))
+	_, err = repository.GetRefreshToken(ctx, user.ID)
+	assert.Equal(t, fiber.NewError(fiber.StatusNotFound, "refresh token not found"), err)
+}
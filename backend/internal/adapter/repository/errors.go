@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// conflicts maps unique indexes to the error reported when a write violates
+// them. Index names are the same for every driver, see the migrations.
+var conflicts = map[string]error{
+	"idx_users_name":  fiber.NewError(fiber.StatusBadRequest, "user with the same name already exists"),
+	"idx_users_email": fiber.NewError(fiber.StatusBadRequest, "user with the same email already exists"),
+}
+
+// translate turns unique violations of any supported driver into domain
+// errors, other errors are returned as they are.
+func translate(err error) error {
+	index, ok := uniqueIndex(err)
+	if !ok {
+		return err
+	}
+
+	if conflict, ok := conflicts[index]; ok {
+		return conflict
+	}
+	return fiber.NewError(fiber.StatusConflict, "resource already exists")
+}
+
+// uniqueIndex returns the name of the unique index err violated.
+func uniqueIndex(err error) (string, bool) {
+	if err == nil {
+		return "", false
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.ConstraintName, pgErr.Code == "23505"
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		if mysqlErr.Number != 1062 {
+			return "", false
+		}
+		// Duplicate entry 'x' for key 'users.idx_users_name', older servers
+		// leave out the table
+		_, key, _ := strings.Cut(mysqlErr.Message, "for key '")
+		key = strings.TrimSuffix(key, "'")
+		return key[strings.LastIndex(key, ".")+1:], true
+	}
+
+	// sqlite names the columns instead, UNIQUE constraint failed: users.name,
+	// which gives the index name for single column indexes
+	_, columns, ok := strings.Cut(err.Error(), "UNIQUE constraint failed: ")
+	if !ok {
+		return "", false
+	}
+	columns, _, _ = strings.Cut(columns, " (")
+	table, column, ok := strings.Cut(columns, ".")
+	if !ok || strings.Contains(column, ",") {
+		return "", true
+	}
+	return "idx_" + table + "_" + column, true
+}
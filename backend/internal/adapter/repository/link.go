@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type ShareLinkRepository struct {
+	db *gorm.DB
+}
+
+func NewShareLinkRepository(db *gorm.DB) port.ShareLinkRepository {
+	return &ShareLinkRepository{
+		db: db,
+	}
+}
+
+func (r *ShareLinkRepository) Create(ctx context.Context, req domain.ShareLinkRequest) (*domain.ShareLink, error) {
+	entity := domain.ShareLink{
+		NoteID:    req.NoteID,
+		Token:     req.Token,
+		Password:  req.Password,
+		ExpiresAt: req.ExpiresAt,
+	}
+
+	if err := r.db.WithContext(ctx).Create(&entity).Error; err != nil {
+		return nil, err
+	}
+
+	return &entity, nil
+}
+
+func (r *ShareLinkRepository) GetAll(ctx context.Context, noteID uint) ([]domain.ShareLink, error) {
+	var entity []domain.ShareLink
+
+	if err := r.db.WithContext(ctx).Where("note_id = ?", noteID).Order("created_at asc").Find(&entity).Error; err != nil {
+		return nil, err
+	}
+
+	if reflect.DeepEqual(entity, []domain.ShareLink{}) {
+		return nil, fiber.NewError(fiber.StatusNotFound, "share links not found")
+	}
+
+	return entity, nil
+}
+
+func (r *ShareLinkRepository) GetByID(ctx context.Context, id uint) (*domain.ShareLink, error) {
+	var entity domain.ShareLink
+
+	if err := r.db.WithContext(ctx).First(&entity, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "share link not found")
+		}
+		return nil, err
+	}
+
+	return &entity, nil
+}
+
+func (r *ShareLinkRepository) GetByToken(ctx context.Context, token string) (*domain.ShareLink, error) {
+	var entity domain.ShareLink
+
+	if err := r.db.WithContext(ctx).Where("token = ?", token).First(&entity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "share link not found")
+		}
+		return nil, err
+	}
+
+	return &entity, nil
+}
+
+func (r *ShareLinkRepository) Delete(ctx context.Context, link *domain.ShareLink) error {
+	entity := link
+
+	if err := r.db.WithContext(ctx).Delete(entity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "share link not found")
+		}
+		return err
+	}
+
+	return nil
+}
@@ -1,8 +1,10 @@
 package repository
 
 import (
+	"context"
 	"errors"
 	"reflect"
+	"time"
 
 	"github.com/shironxn/blanknotes/internal/core/domain"
 	"github.com/shironxn/blanknotes/internal/core/port"
@@ -24,10 +26,10 @@ func NewNoteRepository(db *gorm.DB, pagination util.Pagination) port.NoteReposit
 	}
 }
 
-func (r *NoteRepository) Create(req domain.NoteRequest) (*domain.Note, error) {
+func (r *NoteRepository) Create(ctx context.Context, req domain.NoteRequest) (*domain.Note, error) {
 	var entity domain.Note
 
-	if err := r.db.Table("notes").Select("user, title").Where("user_id = ? AND title = ?", req.UserID, req.Title).Scan(&entity).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&domain.Note{}).Select("id, title").Where("user_id = ? AND title = ?", req.UserID, req.Title).Scan(&entity).Error; err != nil {
 		return nil, err
 	}
 
@@ -41,30 +43,45 @@ func (r *NoteRepository) Create(req domain.NoteRequest) (*domain.Note, error) {
 		CoverURL:    req.CoverURL,
 		Content:     req.Content,
 		Visibility:  domain.Visibility(req.Visibility),
+		Notebook:    req.Notebook,
+		Tags:        req.Tags,
 		UserID:      req.UserID,
 	}
+	entity.CreatedAt = req.CreatedAt
+	entity.UpdatedAt = req.UpdatedAt
 
-	if err := r.db.Create(&entity).Preload("Author").Find(&entity).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(&entity).Preload("Author").Find(&entity).Error; err != nil {
 		return nil, err
 	}
 
 	return &entity, nil
 }
 
-func (r *NoteRepository) GetAll(req domain.NoteQuery, metadata *domain.Metadata) ([]domain.Note, error) {
+func (r *NoteRepository) GetAll(ctx context.Context, req domain.NoteQuery, metadata *domain.Metadata) ([]domain.Note, error) {
 	var entity []domain.Note
 
-	if err := r.db.
+	query := r.db.WithContext(ctx).
 		Model(&domain.Note{}).
-		Preload("Author").
 		Where(&req).
-		Count(&metadata.TotalRecords).
-		Scopes(r.pagination.Paginate(metadata)).
+		Scopes(util.Filter(req.Filters))
+
+	if !metadata.SkipCount {
+		query = query.Count(&metadata.TotalRecords)
+	}
+
+	// the selected columns would replace the count, so they come after it
+	if err := query.
+		Scopes(util.Select(domain.NoteSelection, req.Fieldset)).
+		Scopes(r.pagination.Paginate(metadata, domain.NoteFields)).
 		Find(&entity).
 		Error; err != nil {
 		return nil, err
 	}
 
+	if err := r.pagination.Cursors(r.db.WithContext(ctx), metadata, &entity); err != nil {
+		return nil, err
+	}
+
 	if reflect.DeepEqual(entity, []domain.Note{}) {
 		return nil, fiber.NewError(fiber.StatusNotFound, "notes not found")
 	}
@@ -72,10 +89,23 @@ func (r *NoteRepository) GetAll(req domain.NoteQuery, metadata *domain.Metadata)
 	return entity, nil
 }
 
-func (r *NoteRepository) GetByID(id uint) (*domain.Note, error) {
+func (r *NoteRepository) GetByID(ctx context.Context, id uint) (*domain.Note, error) {
+	var entity domain.Note
+
+	if err := r.db.WithContext(ctx).Preload("Author").First(&entity, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "note not found")
+		}
+		return nil, err
+	}
+
+	return &entity, nil
+}
+
+func (r *NoteRepository) GetByTitle(ctx context.Context, userID uint, title string) (*domain.Note, error) {
 	var entity domain.Note
 
-	if err := r.db.Preload("Author").First(&entity, id).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("Author").Where("user_id = ? AND title = ?", userID, title).First(&entity).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, fiber.NewError(fiber.StatusNotFound, "note not found")
 		}
@@ -85,10 +115,10 @@ func (r *NoteRepository) GetByID(id uint) (*domain.Note, error) {
 	return &entity, nil
 }
 
-func (r *NoteRepository) Update(req domain.NoteUpdateRequest, note *domain.Note) (*domain.Note, error) {
+func (r *NoteRepository) Update(ctx context.Context, req domain.NoteUpdateRequest, note *domain.Note) (*domain.Note, error) {
 	var entity domain.Note
 
-	if err := r.db.Table("notes").Select("id, title, user_id").Where("id != ? AND title = ? AND user_id = ?", req.ID, req.Title, req.UserID).Scan(&entity).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&domain.Note{}).Select("id, title, user_id").Where("id != ? AND title = ? AND user_id = ?", req.ID, req.Title, req.UserID).Scan(&entity).Error; err != nil {
 		return nil, err
 	}
 
@@ -98,7 +128,7 @@ func (r *NoteRepository) Update(req domain.NoteUpdateRequest, note *domain.Note)
 		entity = *note
 	}
 
-	if err := r.db.Model(&entity).Where("id = ? AND user_id = ?", req.ID, req.UserID).Updates(req).Preload("Author").Find(&entity).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&entity).Where("id = ? AND user_id = ?", req.ID, req.UserID).Updates(req).Preload("Author").Find(&entity).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, fiber.NewError(fiber.StatusNotFound, "note not found")
 		}
@@ -108,10 +138,88 @@ func (r *NoteRepository) Update(req domain.NoteUpdateRequest, note *domain.Note)
 	return &entity, nil
 }
 
-func (r *NoteRepository) Delete(note *domain.Note) error {
+func (r *NoteRepository) Delete(ctx context.Context, note *domain.Note) error {
+	entity := note
+
+	if err := r.db.WithContext(ctx).Delete(entity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "note not found")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *NoteRepository) GetTrash(ctx context.Context, req domain.NoteQuery, metadata *domain.Metadata) ([]domain.Note, error) {
+	var entity []domain.Note
+
+	query := r.db.WithContext(ctx).
+		Unscoped().
+		Model(&domain.Note{}).
+		Preload("Author").
+		Where(&req).
+		Where("deleted_at IS NOT NULL")
+
+	if !metadata.SkipCount {
+		query = query.Count(&metadata.TotalRecords)
+	}
+
+	if err := query.
+		Scopes(r.pagination.Paginate(metadata, domain.NoteFields)).
+		Find(&entity).
+		Error; err != nil {
+		return nil, err
+	}
+
+	if err := r.pagination.Cursors(r.db.WithContext(ctx), metadata, &entity); err != nil {
+		return nil, err
+	}
+
+	if reflect.DeepEqual(entity, []domain.Note{}) {
+		return nil, fiber.NewError(fiber.StatusNotFound, "notes not found")
+	}
+
+	return entity, nil
+}
+
+func (r *NoteRepository) GetTrashByID(ctx context.Context, id uint) (*domain.Note, error) {
+	var entity domain.Note
+
+	if err := r.db.WithContext(ctx).Unscoped().Preload("Author").Where("deleted_at IS NOT NULL").First(&entity, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "note not found in trash")
+		}
+		return nil, err
+	}
+
+	return &entity, nil
+}
+
+func (r *NoteRepository) Restore(ctx context.Context, note *domain.Note) (*domain.Note, error) {
+	var count int64
 	entity := note
 
-	if err := r.db.Delete(entity).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&domain.Note{}).Where("id != ? AND title = ? AND user_id = ?", entity.ID, entity.Title, entity.UserID).Count(&count).Error; err != nil {
+		return nil, err
+	}
+
+	if count > 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "note with the same title already exists")
+	}
+
+	if err := r.db.WithContext(ctx).Unscoped().Model(entity).Update("deleted_at", nil).Error; err != nil {
+		return nil, err
+	}
+	entity.DeletedAt = gorm.DeletedAt{}
+
+	return entity, nil
+}
+
+func (r *NoteRepository) ForceDelete(ctx context.Context, note *domain.Note) error {
+	entity := note
+
+	if err := r.db.WithContext(ctx).Unscoped().Delete(entity).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return fiber.NewError(fiber.StatusNotFound, "note not found")
 		}
@@ -120,3 +228,50 @@ func (r *NoteRepository) Delete(note *domain.Note) error {
 
 	return nil
 }
+
+// Purge deletes the notes trashed before before along with their attachments,
+// shares and links, and returns the keys of the files left in storage.
+func (r *NoteRepository) Purge(ctx context.Context, before time.Time) (int64, []string, error) {
+	var ids []uint
+
+	if err := r.db.WithContext(ctx).Unscoped().Model(&domain.Note{}).Where("deleted_at IS NOT NULL AND deleted_at < ?", before).Pluck("id", &ids).Error; err != nil {
+		return 0, nil, err
+	}
+
+	if len(ids) == 0 {
+		return 0, nil, nil
+	}
+
+	var keys []string
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&domain.Attachment{}).Where("note_id IN ?", ids).Pluck("key", &keys).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("note_id IN ?", ids).Delete(&domain.Attachment{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("note_id IN ?", ids).Delete(&domain.NoteShare{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("note_id IN ?", ids).Delete(&domain.ShareLink{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&domain.Note{}, ids).Error
+	}); err != nil {
+		return 0, nil, err
+	}
+
+	return int64(len(ids)), keys, nil
+}
+
+func (r *NoteRepository) Export(ctx context.Context, userID uint, batch func(notes []domain.Note) error) error {
+	var entity []domain.Note
+
+	return r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		FindInBatches(&entity, 100, func(tx *gorm.DB, _ int) error {
+			return batch(entity)
+		}).
+		Error
+}
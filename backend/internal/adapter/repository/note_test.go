@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNoteRepository_Create(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repository := NewNoteRepository(db, newTestPagination(t))
+	user := createTestUser(t, db, "shiron")
+
+	note := createTestNote(t, db, user, "Hello")
+	assert.NotZero(t, note.ID)
+	assert.Equal(t, "shiron", note.Author.Name)
+	assert.Equal(t, domain.Private, note.Visibility)
+
+	_, err := repository.Create(ctx, domain.NoteRequest{Title: "Hello", Visibility: "public", UserID: user.ID})
+	assert.Equal(t, fiber.NewError(fiber.StatusBadRequest, "note with the same title already exists"), err)
+
+	_, err = repository.Create(ctx, domain.NoteRequest{Title: "Secret", Visibility: "hidden", UserID: user.ID})
+	assert.Error(t, err, "the visibility is checked by the database too")
+
+	tagged, err := repository.Create(ctx, domain.NoteRequest{Title: "Tagged", Visibility: "private", Notebook: "Recipes", Tags: []string{"soup", "winter"}, UserID: user.ID})
+	require.NoError(t, err)
+	got, err := repository.GetByID(ctx, tagged.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Recipes", got.Notebook)
+	assert.Equal(t, []string{"soup", "winter"}, got.Tags)
+}
+
+func TestNoteRepository_GetAll(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repository := NewNoteRepository(db, newTestPagination(t))
+	user := createTestUser(t, db, "shiron")
+	for _, title := range []string{"First", "Second", "Third"} {
+		createTestNote(t, db, user, title)
+	}
+
+	metadata := domain.Metadata{Limit: 2, Sort: "title"}
+	notes, err := repository.GetAll(ctx, domain.NoteQuery{UserID: int(user.ID)}, &metadata)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"First", "Second"}, titles(notes))
+	assert.Equal(t, "shiron", notes[0].Author.Name)
+
+	metadata = domain.Metadata{Limit: 2, Cursor: metadata.NextCursor}
+	notes, err = repository.GetAll(ctx, domain.NoteQuery{UserID: int(user.ID)}, &metadata)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Third"}, titles(notes))
+
+	metadata = domain.Metadata{}
+	notes, err = repository.GetAll(ctx, domain.NoteQuery{
+		Filters:  []domain.Filter{{Field: "title", Operator: "in", Value: []interface{}{"First", "Third"}}},
+		Fieldset: domain.Fieldset{Fields: []string{"id", "title"}},
+	}, &metadata)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"First", "Third"}, titles(notes))
+	assert.Empty(t, notes[0].Content)
+
+	metadata = domain.Metadata{}
+	_, err = repository.GetAll(ctx, domain.NoteQuery{Visibility: "public"}, &metadata)
+	assert.Equal(t, fiber.NewError(fiber.StatusNotFound, "notes not found"), err)
+}
+
+func TestNoteRepository_Update(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repository := NewNoteRepository(db, newTestPagination(t))
+	user := createTestUser(t, db, "shiron")
+	note := createTestNote(t, db, user, "Hello")
+	createTestNote(t, db, user, "Taken")
+
+	_, err := repository.Update(ctx, domain.NoteUpdateRequest{ID: note.ID, Title: "Taken", UserID: user.ID}, note)
+	assert.Equal(t, fiber.NewError(fiber.StatusBadRequest, "note with the same title already exists"), err)
+
+	got, err := repository.Update(ctx, domain.NoteUpdateRequest{ID: note.ID, Title: "World", Visibility: "public", Tags: []string{"greeting"}, UserID: user.ID}, note)
+	require.NoError(t, err)
+	assert.Equal(t, "World", got.Title)
+	assert.Equal(t, domain.Public, got.Visibility)
+	assert.Equal(t, []string{"greeting"}, got.Tags)
+}
+
+func TestNoteRepository_Trash(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repository := NewNoteRepository(db, newTestPagination(t))
+	user := createTestUser(t, db, "shiron")
+	note := createTestNote(t, db, user, "Hello")
+	old := createTestNote(t, db, user, "Old")
+
+	require.NoError(t, repository.Delete(ctx, note))
+	require.NoError(t, repository.Delete(ctx, old))
+
+	_, err := repository.GetByID(ctx, note.ID)
+	assert.Equal(t, fiber.NewError(fiber.StatusNotFound, "note not found"), err)
+
+	metadata := domain.Metadata{}
+	trash, err := repository.GetTrash(ctx, domain.NoteQuery{UserID: int(user.ID)}, &metadata)
+	require.NoError(t, err)
+	assert.Len(t, trash, 2)
+
+	// a trashed note does not hold on to its title, the clash is settled when
+	// it is restored
+	clash := createTestNote(t, db, user, "Hello")
+	trashed, err := repository.GetTrashByID(ctx, note.ID)
+	require.NoError(t, err)
+	_, err = repository.Restore(ctx, trashed)
+	assert.Equal(t, fiber.NewError(fiber.StatusBadRequest, "note with the same title already exists"), err)
+
+	require.NoError(t, repository.ForceDelete(ctx, clash))
+	_, err = repository.Restore(ctx, trashed)
+	require.NoError(t, err)
+	_, err = repository.GetByID(ctx, note.ID)
+	assert.NoError(t, err)
+
+	purged, _, err := repository.Purge(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+	_, err = repository.GetTrashByID(ctx, old.ID)
+	assert.Error(t, err)
+}
+
+func TestNoteRepository_Purge(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repository := NewNoteRepository(db, newTestPagination(t))
+	user := createTestUser(t, db, "shiron")
+	other := createTestUser(t, db, "kuro")
+	note := createTestNote(t, db, user, "Old")
+	kept := createTestNote(t, db, user, "Kept")
+
+	for _, n := range []*domain.Note{note, kept} {
+		require.NoError(t, db.Create(&domain.Attachment{NoteID: n.ID, UserID: user.ID, Key: fmt.Sprintf("attachments/%d/a", n.ID), Name: "a", ContentType: "text/plain", Size: 1}).Error)
+		require.NoError(t, db.Create(&domain.ShareLink{NoteID: n.ID, Token: fmt.Sprintf("token-%d", n.ID)}).Error)
+		require.NoError(t, db.Create(&domain.NoteShare{NoteID: n.ID, UserID: other.ID, Permission: domain.Viewer}).Error)
+	}
+	require.NoError(t, repository.Delete(ctx, note))
+
+	// foreign keys off, the rows must go without cascades. The pragma is per
+	// connection, so there is only one.
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	require.NoError(t, db.Exec("PRAGMA foreign_keys = OFF").Error)
+
+	purged, keys, err := repository.Purge(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+	assert.Equal(t, []string{fmt.Sprintf("attachments/%d/a", note.ID)}, keys)
+
+	for _, model := range []interface{}{&domain.Attachment{}, &domain.ShareLink{}, &domain.NoteShare{}} {
+		var count int64
+		require.NoError(t, db.Model(model).Where("note_id = ?", note.ID).Count(&count).Error)
+		assert.Zero(t, count, "%T left behind", model)
+		require.NoError(t, db.Model(model).Where("note_id = ?", kept.ID).Count(&count).Error)
+		assert.Equal(t, int64(1), count, "%T of another note deleted", model)
+	}
+
+	purged, keys, err = repository.Purge(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Zero(t, purged)
+	assert.Empty(t, keys)
+}
+
+func TestNoteRepository_Export(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repository := NewNoteRepository(db, newTestPagination(t))
+	user := createTestUser(t, db, "shiron")
+	createTestNote(t, db, user, "First")
+	createTestNote(t, db, user, "Second")
+	createTestNote(t, db, createTestUser(t, db, "kuro"), "Other")
+
+	var exported []string
+	require.NoError(t, repository.Export(ctx, user.ID, func(notes []domain.Note) error {
+		exported = append(exported, titles(notes)...)
+		return nil
+	}))
+	assert.Equal(t, []string{"First", "Second"}, exported)
+}
+
+func titles(notes []domain.Note) []string {
+	var titles []string
+	for _, note := range notes {
+		titles = append(titles, note.Title)
+	}
+	return titles
+}
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/shironxn/blanknotes/internal/adapter/migration"
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// newTestDB opens a migrated SQLite database of its own for a test, so the
+// repositories run against real SQL without a database server.
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	cfg := &config.Config{}
+	cfg.Database.Driver = "sqlite"
+	cfg.Database.Name = filepath.Join(t.TempDir(), "test.db")
+
+	db, err := config.NewGorm(cfg).Connection()
+	require.NoError(t, err)
+	db.Logger = logger.Discard
+
+	migrator, err := migration.NewMigrator(db)
+	require.NoError(t, err)
+	_, err = migrator.Up()
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+
+	return db
+}
+
+func newTestPagination(t *testing.T) util.Pagination {
+	t.Helper()
+
+	validator, err := util.NewValidator()
+	require.NoError(t, err)
+
+	return util.NewPagination(validator, &config.Config{})
+}
+
+func createTestUser(t *testing.T, db *gorm.DB, name string) *domain.User {
+	t.Helper()
+
+	user, err := NewAuthRepository(db).Register(context.Background(), domain.AuthRegisterRequest{
+		Name:     name,
+		Email:    name + "@example.com",
+		Password: "password",
+	})
+	require.NoError(t, err)
+
+	return user
+}
+
+func createTestNote(t *testing.T, db *gorm.DB, user *domain.User, title string) *domain.Note {
+	t.Helper()
+
+	note, err := NewNoteRepository(db, newTestPagination(t)).Create(context.Background(), domain.NoteRequest{
+		Title:      title,
+		Content:    "# " + title,
+		Visibility: string(domain.Private),
+		UserID:     user.ID,
+	})
+	require.NoError(t, err)
+
+	return note
+}
+
+func TestMigration_Down(t *testing.T) {
+	db := newTestDB(t)
+
+	migrator, err := migration.NewMigrator(db)
+	require.NoError(t, err)
+
+	status, err := migrator.Status()
+	require.NoError(t, err)
+	reverted, err := migrator.Down(len(status))
+	assert.NoError(t, err)
+	assert.Len(t, reverted, len(status))
+	assert.False(t, db.Migrator().HasTable("users"))
+
+	_, err = migrator.Up()
+	assert.NoError(t, err)
+	assert.True(t, db.Migrator().HasTable("users"))
+}
+
+func TestTranslate(t *testing.T) {
+	nameTaken := fiber.NewError(fiber.StatusBadRequest, "user with the same name already exists")
+	conflict := fiber.NewError(fiber.StatusConflict, "resource already exists")
+	other := errors.New("connection refused")
+
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{
+			name: "postgres",
+			err:  &pgconn.PgError{Code: "23505", ConstraintName: "idx_users_name"},
+			want: nameTaken,
+		},
+		{
+			name: "postgres other violation",
+			err:  &pgconn.PgError{Code: "23503", ConstraintName: "fk_users_notes"},
+			want: &pgconn.PgError{Code: "23503", ConstraintName: "fk_users_notes"},
+		},
+		{
+			name: "mysql",
+			err:  &mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'shiron' for key 'users.idx_users_name'"},
+			want: nameTaken,
+		},
+		{
+			name: "mysql without table",
+			err:  &mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'shiron' for key 'idx_users_name'"},
+			want: nameTaken,
+		},
+		{
+			name: "sqlite",
+			err:  errors.New("constraint failed: UNIQUE constraint failed: users.name (2067)"),
+			want: nameTaken,
+		},
+		{
+			name: "sqlite unknown index",
+			err:  errors.New("constraint failed: UNIQUE constraint failed: note_shares.note_id, note_shares.user_id (2067)"),
+			want: conflict,
+		},
+		{
+			name: "other",
+			err:  other,
+			want: other,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, translate(tt.err))
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NoteShareRepository struct {
+	db         *gorm.DB
+	pagination util.Pagination
+}
+
+func NewNoteShareRepository(db *gorm.DB, pagination util.Pagination) port.NoteShareRepository {
+	return &NoteShareRepository{
+		db:         db,
+		pagination: pagination,
+	}
+}
+
+func (r *NoteShareRepository) GetAll(ctx context.Context, noteID uint) ([]domain.NoteShare, error) {
+	var entity []domain.NoteShare
+
+	if err := r.db.WithContext(ctx).Preload("User").Where("note_id = ?", noteID).Order("created_at asc").Find(&entity).Error; err != nil {
+		return nil, err
+	}
+
+	if reflect.DeepEqual(entity, []domain.NoteShare{}) {
+		return nil, fiber.NewError(fiber.StatusNotFound, "note shares not found")
+	}
+
+	return entity, nil
+}
+
+func (r *NoteShareRepository) Get(ctx context.Context, noteID uint, userID uint) (*domain.NoteShare, error) {
+	var entity domain.NoteShare
+
+	if err := r.db.WithContext(ctx).Preload("User").Where("note_id = ? AND user_id = ?", noteID, userID).First(&entity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "note share not found")
+		}
+		return nil, err
+	}
+
+	return &entity, nil
+}
+
+func (r *NoteShareRepository) Save(ctx context.Context, req domain.NoteShareRequest) (*domain.NoteShare, error) {
+	entity := domain.NoteShare{
+		NoteID:     req.NoteID,
+		UserID:     req.UserID,
+		Permission: domain.SharePermission(req.Permission),
+	}
+
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "note_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"permission", "updated_at"}),
+	}).Create(&entity).Error; err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, req.NoteID, req.UserID)
+}
+
+func (r *NoteShareRepository) Delete(ctx context.Context, share *domain.NoteShare) error {
+	entity := share
+
+	if err := r.db.WithContext(ctx).Delete(entity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "note share not found")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *NoteShareRepository) GetShared(ctx context.Context, userID uint, metadata *domain.Metadata) ([]domain.Note, error) {
+	var entity []domain.Note
+
+	query := r.db.WithContext(ctx).
+		Model(&domain.Note{}).
+		Preload("Author").
+		Where("id IN (?)", r.db.WithContext(ctx).Model(&domain.NoteShare{}).Select("note_id").Where("user_id = ?", userID))
+
+	if !metadata.SkipCount {
+		query = query.Count(&metadata.TotalRecords)
+	}
+
+	if err := query.
+		Scopes(r.pagination.Paginate(metadata, domain.NoteFields)).
+		Find(&entity).
+		Error; err != nil {
+		return nil, err
+	}
+
+	if err := r.pagination.Cursors(r.db.WithContext(ctx), metadata, &entity); err != nil {
+		return nil, err
+	}
+
+	if reflect.DeepEqual(entity, []domain.Note{}) {
+		return nil, fiber.NewError(fiber.StatusNotFound, "notes not found")
+	}
+
+	return entity, nil
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNoteShareRepository_Save(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repository := NewNoteShareRepository(db, newTestPagination(t))
+	owner := createTestUser(t, db, "shiron")
+	user := createTestUser(t, db, "kuro")
+	note := createTestNote(t, db, owner, "Hello")
+
+	share, err := repository.Save(ctx, domain.NoteShareRequest{NoteID: note.ID, UserID: user.ID, Permission: "viewer"})
+	require.NoError(t, err)
+	assert.Equal(t, domain.Viewer, share.Permission)
+	assert.Equal(t, "kuro", share.User.Name)
+
+	// saving again changes the permission of the existing share
+	share, err = repository.Save(ctx, domain.NoteShareRequest{NoteID: note.ID, UserID: user.ID, Permission: "editor"})
+	require.NoError(t, err)
+	assert.Equal(t, domain.Editor, share.Permission)
+
+	shares, err := repository.GetAll(ctx, note.ID)
+	require.NoError(t, err)
+	assert.Len(t, shares, 1)
+
+	require.NoError(t, repository.Delete(ctx, share))
+	_, err = repository.Get(ctx, note.ID, user.ID)
+	assert.Equal(t, fiber.NewError(fiber.StatusNotFound, "note share not found"), err)
+}
+
+func TestNoteShareRepository_GetShared(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repository := NewNoteShareRepository(db, newTestPagination(t))
+	owner := createTestUser(t, db, "shiron")
+	user := createTestUser(t, db, "kuro")
+	shared := createTestNote(t, db, owner, "Shared")
+	createTestNote(t, db, owner, "Private")
+
+	metadata := domain.Metadata{}
+	_, err := repository.GetShared(ctx, user.ID, &metadata)
+	assert.Equal(t, fiber.NewError(fiber.StatusNotFound, "notes not found"), err)
+
+	_, err = repository.Save(ctx, domain.NoteShareRequest{NoteID: shared.ID, UserID: user.ID, Permission: "viewer"})
+	require.NoError(t, err)
+
+	metadata = domain.Metadata{}
+	notes, err := repository.GetShared(ctx, user.ID, &metadata)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Shared"}, titles(notes))
+	assert.Equal(t, int64(1), metadata.TotalRecords)
+}
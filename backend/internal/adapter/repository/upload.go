@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type UploadRepository struct {
+	db *gorm.DB
+}
+
+func NewUploadRepository(db *gorm.DB) port.UploadRepository {
+	return &UploadRepository{
+		db: db,
+	}
+}
+
+func (r *UploadRepository) Create(ctx context.Context, upload *domain.Upload) error {
+	return r.db.WithContext(ctx).Create(upload).Error
+}
+
+func (r *UploadRepository) GetByID(ctx context.Context, id uint) (*domain.Upload, error) {
+	var entity domain.Upload
+
+	if err := r.db.WithContext(ctx).Preload("Variants").First(&entity, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "upload not found")
+		}
+		return nil, err
+	}
+
+	return &entity, nil
+}
+
+func (r *UploadRepository) GetByKey(ctx context.Context, key string) (*domain.Upload, error) {
+	var entity domain.Upload
+
+	if err := r.db.WithContext(ctx).Preload("Variants").Where(&domain.Upload{Key: key}).First(&entity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "upload not found")
+		}
+		return nil, err
+	}
+
+	return &entity, nil
+}
+
+func (r *UploadRepository) GetByKeys(ctx context.Context, keys []string) ([]domain.Upload, error) {
+	var entities []domain.Upload
+
+	if err := r.db.WithContext(ctx).Preload("Variants").Where(map[string]interface{}{"key": keys}).Find(&entities).Error; err != nil {
+		return nil, err
+	}
+
+	return entities, nil
+}
+
+func (r *UploadRepository) GetVariantByKey(ctx context.Context, key string) (*domain.UploadVariant, error) {
+	var entity domain.UploadVariant
+
+	if err := r.db.WithContext(ctx).Where(&domain.UploadVariant{Key: key}).First(&entity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "upload not found")
+		}
+		return nil, err
+	}
+
+	return &entity, nil
+}
+
+func (r *UploadRepository) GetPending(ctx context.Context, limit int) ([]domain.Upload, error) {
+	var entities []domain.Upload
+
+	if err := r.db.WithContext(ctx).Where(&domain.Upload{Status: domain.UploadPending}).Order("id").Limit(limit).Find(&entities).Error; err != nil {
+		return nil, err
+	}
+
+	return entities, nil
+}
+
+// Update saves the upload along with any variants that were added to it.
+func (r *UploadRepository) Update(ctx context.Context, upload *domain.Upload) error {
+	return r.db.WithContext(ctx).Session(&gorm.Session{FullSaveAssociations: true}).Omit("User").Save(upload).Error
+}
+
+func (r *UploadRepository) Delete(ctx context.Context, upload *domain.Upload) error {
+	entity := upload
+
+	if err := r.db.WithContext(ctx).Delete(entity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "upload not found")
+		}
+		return err
+	}
+
+	return nil
+}
@@ -1,11 +1,11 @@
 package repository
 
 import (
+	"context"
 	"errors"
 	"reflect"
-	"strings"
+	"time"
 
-	"github.com/go-sql-driver/mysql"
 	"github.com/shironxn/blanknotes/internal/core/domain"
 	"github.com/shironxn/blanknotes/internal/core/port"
 	"github.com/shironxn/blanknotes/internal/util"
@@ -26,23 +26,30 @@ func NewUserRepository(db *gorm.DB, pagination util.Pagination) port.UserReposit
 	}
 }
 
-func (r *UserRepository) GetAll(req domain.UserQuery, metadata *domain.Metadata) ([]domain.User, error) {
+func (r *UserRepository) GetAll(ctx context.Context, req domain.UserQuery, metadata *domain.Metadata) ([]domain.User, error) {
 	var entity []domain.User
-	query := r.db.Model(&domain.User{})
+	query := r.db.WithContext(ctx).
+		Model(&domain.User{}).
+		Where(&domain.UserQuery{Name: req.Name}).
+		Scopes(util.Filter(req.Filters))
 
-	if !req.Details {
-		query = r.db.Model(&domain.User{}).Select("id", "name", "created_at", "updated_at")
+	if !metadata.SkipCount {
+		query = query.Count(&metadata.TotalRecords)
 	}
 
+	// the selected columns would replace the count, so they come after it
 	if err := query.
-		Where(&domain.UserQuery{Name: req.Name}).
-		Count(&metadata.TotalRecords).
-		Scopes(r.pagination.Paginate(metadata)).
+		Scopes(util.Select(domain.UserSelection, req.Fieldset)).
+		Scopes(r.pagination.Paginate(metadata, domain.UserFields)).
 		Find(&entity).
 		Error; err != nil {
 		return nil, err
 	}
 
+	if err := r.pagination.Cursors(r.db.WithContext(ctx), metadata, &entity); err != nil {
+		return nil, err
+	}
+
 	if reflect.DeepEqual(entity, []domain.User{}) {
 		return nil, fiber.NewError(fiber.StatusNotFound, "user not found")
 	}
@@ -50,10 +57,10 @@ func (r *UserRepository) GetAll(req domain.UserQuery, metadata *domain.Metadata)
 	return entity, nil
 }
 
-func (r *UserRepository) GetByID(id uint) (*domain.User, error) {
+func (r *UserRepository) GetByID(ctx context.Context, id uint) (*domain.User, error) {
 	var entity domain.User
 
-	if err := r.db.First(&entity, id).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&entity, id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, fiber.NewError(fiber.StatusNotFound, "user not found")
 		}
@@ -63,40 +70,36 @@ func (r *UserRepository) GetByID(id uint) (*domain.User, error) {
 	return &entity, nil
 }
 
-func (r *UserRepository) Update(req domain.UserRequest, user *domain.User) (*domain.User, error) {
+func (r *UserRepository) Update(ctx context.Context, req domain.UserRequest, user *domain.User) (*domain.User, error) {
 	entity := user
 
 	if req.Bio == "" {
-		r.db.Model(entity).Update("bio", "")
+		r.db.WithContext(ctx).Model(entity).Update("bio", "")
 	}
 
 	if req.AvatarURL == "" {
-		r.db.Model(entity).Update("avatar_url", "")
+		r.db.WithContext(ctx).Model(entity).Update("avatar_url", "")
 	}
 
-	if err := r.db.Model(entity).Updates(req).Error; err != nil {
-		var mysqlErr *mysql.MySQLError
-		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
-			if strings.Contains(mysqlErr.Message, "name") {
-				return nil, fiber.NewError(fiber.StatusBadRequest, "user with the same name already exists")
-			}
-			if strings.Contains(mysqlErr.Message, "email") {
-				return nil, fiber.NewError(fiber.StatusBadRequest, "user with the same email already exists")
-			}
-		}
+	if err := r.db.WithContext(ctx).Model(entity).Updates(req).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, fiber.NewError(fiber.StatusNotFound, "user not found")
 		}
-		return nil, err
+		return nil, translate(err)
 	}
 
 	return entity, nil
 }
 
-func (r *UserRepository) Delete(user *domain.User) error {
+func (r *UserRepository) Delete(ctx context.Context, user *domain.User) error {
 	entity := user
 
-	if err := r.db.Delete(entity).Error; err != nil {
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", entity.ID).Delete(&domain.RefreshToken{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(entity).Error
+	}); err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return fiber.NewError(fiber.StatusNotFound, "user not found")
 		}
@@ -105,3 +108,70 @@ func (r *UserRepository) Delete(user *domain.User) error {
 
 	return nil
 }
+
+// Purge deletes the users deleted before the given time along with
+// everything they own. The rows that depend on a user are deleted here rather
+// than left to the foreign keys, sqlite only enforces them when told to and
+// attachments have none. The keys of their files are returned so the caller
+// can remove them from storage once the rows are gone.
+func (r *UserRepository) Purge(ctx context.Context, before time.Time) (int64, []string, error) {
+	var ids []uint
+
+	if err := r.db.WithContext(ctx).Unscoped().Model(&domain.User{}).Where("deleted_at IS NOT NULL AND deleted_at < ?", before).Pluck("id", &ids).Error; err != nil {
+		return 0, nil, err
+	}
+
+	if len(ids) == 0 {
+		return 0, nil, nil
+	}
+
+	var keys []string
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		notes := tx.Unscoped().Model(&domain.Note{}).Select("id").Where("user_id IN ?", ids)
+		uploads := tx.Model(&domain.Upload{}).Select("id").Where("user_id IN ?", ids)
+
+		var attachmentKeys, uploadKeys, variantKeys []string
+		if err := tx.Model(&domain.Attachment{}).Where("note_id IN (?)", notes).Pluck("key", &attachmentKeys).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&domain.Upload{}).Where("user_id IN ?", ids).Pluck("key", &uploadKeys).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&domain.UploadVariant{}).Where("upload_id IN (?)", uploads).Pluck("key", &variantKeys).Error; err != nil {
+			return err
+		}
+		keys = append(append(attachmentKeys, uploadKeys...), variantKeys...)
+		// uploads that were never processed are still under their pending key
+		for _, key := range uploadKeys {
+			keys = append(keys, util.PendingKey(key))
+		}
+
+		// children first, the subqueries above read the rows deleted last
+		if err := tx.Where("note_id IN (?)", notes).Delete(&domain.Attachment{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("note_id IN (?) OR user_id IN ?", notes, ids).Delete(&domain.NoteShare{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("note_id IN (?)", notes).Delete(&domain.ShareLink{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("upload_id IN (?)", uploads).Delete(&domain.UploadVariant{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id IN ?", ids).Delete(&domain.Upload{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("user_id IN ?", ids).Delete(&domain.Note{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id IN ?", ids).Delete(&domain.RefreshToken{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&domain.User{}, ids).Error
+	}); err != nil {
+		return 0, nil, err
+	}
+
+	return int64(len(ids)), keys, nil
+}
@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserRepository_GetAll(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repository := NewUserRepository(db, newTestPagination(t))
+	for _, name := range []string{"alice", "bob", "carol"} {
+		createTestUser(t, db, name)
+	}
+
+	metadata := domain.Metadata{Limit: 2, Sort: "-name"}
+	users, err := repository.GetAll(ctx, domain.UserQuery{}, &metadata)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"carol", "bob"}, names(users))
+	assert.Equal(t, int64(3), metadata.TotalRecords)
+	assert.NotEmpty(t, metadata.NextCursor)
+
+	metadata = domain.Metadata{Limit: 2, Cursor: metadata.NextCursor}
+	users, err = repository.GetAll(ctx, domain.UserQuery{}, &metadata)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice"}, names(users))
+	assert.Empty(t, metadata.NextCursor)
+	assert.NotEmpty(t, metadata.PrevCursor)
+
+	metadata = domain.Metadata{}
+	users, err = repository.GetAll(ctx, domain.UserQuery{Filters: []domain.Filter{{Field: "name", Operator: "contains", Value: "AR"}}}, &metadata)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"carol"}, names(users))
+
+	metadata = domain.Metadata{}
+	_, err = repository.GetAll(ctx, domain.UserQuery{Name: "dave"}, &metadata)
+	assert.Equal(t, fiber.NewError(fiber.StatusNotFound, "user not found"), err)
+}
+
+func TestUserRepository_Update(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repository := NewUserRepository(db, newTestPagination(t))
+	user := createTestUser(t, db, "shiron")
+	createTestUser(t, db, "kuro")
+
+	tests := []struct {
+		name    string
+		req     domain.UserRequest
+		wantErr error
+	}{
+		{
+			name: "success",
+			req:  domain.UserRequest{Name: "shiro", Bio: "hello world"},
+		},
+		{
+			name:    "name taken",
+			req:     domain.UserRequest{Name: "kuro"},
+			wantErr: fiber.NewError(fiber.StatusBadRequest, "user with the same name already exists"),
+		},
+		{
+			name:    "email taken",
+			req:     domain.UserRequest{Email: "kuro@example.com"},
+			wantErr: fiber.NewError(fiber.StatusBadRequest, "user with the same email already exists"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repository.Update(ctx, tt.req, user)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.req.Name, got.Name)
+			assert.Equal(t, tt.req.Bio, got.Bio)
+		})
+	}
+}
+
+func TestUserRepository_Delete(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repository := NewUserRepository(db, newTestPagination(t))
+	user := createTestUser(t, db, "shiron")
+	require.NoError(t, NewAuthRepository(db).StoreRefreshToken(ctx, user.ID, "token"))
+
+	require.NoError(t, repository.Delete(ctx, user))
+
+	_, err := repository.GetByID(ctx, user.ID)
+	assert.Equal(t, fiber.NewError(fiber.StatusNotFound, "user not found"), err)
+	_, err = NewAuthRepository(db).GetRefreshToken(ctx, user.ID)
+	assert.Error(t, err)
+}
+
+func TestUserRepository_Purge(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repository := NewUserRepository(db, newTestPagination(t))
+	user := createTestUser(t, db, "shiron")
+	other := createTestUser(t, db, "kuro")
+	note := createTestNote(t, db, user, "mine")
+	otherNote := createTestNote(t, db, other, "theirs")
+
+	upload := &domain.Upload{UserID: user.ID, Key: "uploads/1/original", ContentType: "image/png", Size: 1, Variants: []domain.UploadVariant{
+		{Name: "small", Key: "uploads/1/small", ContentType: "image/webp", Size: 1, Width: 1, Height: 1},
+	}}
+	require.NoError(t, db.Create(upload).Error)
+	require.NoError(t, db.Create(&domain.Attachment{NoteID: note.ID, UserID: user.ID, Key: "attachments/1/a", Name: "a", ContentType: "text/plain", Size: 1}).Error)
+	require.NoError(t, db.Create(&domain.ShareLink{NoteID: note.ID, Token: "token"}).Error)
+	require.NoError(t, db.Create(&domain.NoteShare{NoteID: note.ID, UserID: other.ID, Permission: domain.Editor}).Error)
+	require.NoError(t, db.Create(&domain.NoteShare{NoteID: otherNote.ID, UserID: user.ID, Permission: domain.Viewer}).Error)
+	require.NoError(t, NewAuthRepository(db).StoreRefreshToken(ctx, user.ID, "token"))
+	require.NoError(t, repository.Delete(ctx, user))
+
+	// foreign keys off, the rows must go without cascades. The pragma is per
+	// connection, so there is only one.
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	require.NoError(t, db.Exec("PRAGMA foreign_keys = OFF").Error)
+
+	purged, keys, err := repository.Purge(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+	assert.ElementsMatch(t, []string{"attachments/1/a", "uploads/1/original", "pending/uploads/1/original", "uploads/1/small"}, keys)
+
+	for _, model := range []interface{}{&domain.Upload{}, &domain.UploadVariant{}, &domain.Attachment{}, &domain.ShareLink{}, &domain.NoteShare{}, &domain.RefreshToken{}} {
+		var count int64
+		require.NoError(t, db.Model(model).Count(&count).Error)
+		assert.Zero(t, count, "%T left behind", model)
+	}
+
+	var notes []domain.Note
+	require.NoError(t, db.Unscoped().Find(&notes).Error)
+	require.Len(t, notes, 1)
+	assert.Equal(t, otherNote.ID, notes[0].ID)
+}
+
+func names(users []domain.User) []string {
+	var names []string
+	for _, user := range users {
+		names = append(names, user.Name)
+	}
+	return names
+}
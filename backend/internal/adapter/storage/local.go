@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/shironxn/blanknotes/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// LocalStorage keeps files in a directory on the local filesystem.
+type LocalStorage struct {
+	root string
+}
+
+func NewLocalStorage(root string) (port.Storage, error) {
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &LocalStorage{
+		root: root,
+	}, nil
+}
+
+func (s *LocalStorage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	// write to a temporary file first so readers never see a partial file
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *LocalStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "file not found")
+		}
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *LocalStorage) path(key string) (string, error) {
+	path := filepath.Join(s.root, filepath.FromSlash(key))
+
+	rel, err := filepath.Rel(s.root, path)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return "", fiber.NewError(fiber.StatusBadRequest, "invalid file key")
+	}
+
+	return path, nil
+}
@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStorage(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewLocalStorage(t.TempDir())
+	assert.NoError(t, err)
+
+	assert.NoError(t, s.Put(ctx, "1/image.png", strings.NewReader("image"), 5, "image/png"))
+
+	file, err := s.Get(ctx, "1/image.png")
+	assert.NoError(t, err)
+	data, err := io.ReadAll(file)
+	file.Close()
+	assert.NoError(t, err)
+	assert.Equal(t, "image", string(data))
+
+	assert.NoError(t, s.Delete(ctx, "1/image.png"))
+	_, err = s.Get(ctx, "1/image.png")
+	assert.EqualError(t, err, "file not found")
+
+	// deleting a missing file is not an error
+	assert.NoError(t, s.Delete(ctx, "1/image.png"))
+}
+
+func TestLocalStorage_InvalidKey(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewLocalStorage(t.TempDir())
+	assert.NoError(t, err)
+
+	for _, key := range []string{"", "../image.png", "1/../../image.png"} {
+		_, err := s.Get(ctx, key)
+		assert.EqualError(t, err, "invalid file key", key)
+	}
+}
@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"context"
+	"io"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3Storage keeps files in a bucket of any S3 compatible service such as AWS
+// S3, MinIO or R2.
+type S3Storage struct {
+	client *minio.Client
+	bucket string
+}
+
+func NewS3Storage(cfg *config.Config) (port.Storage, error) {
+	client, err := minio.New(cfg.Storage.S3Endpoint, &minio.Options{
+		Creds:        credentials.NewStaticV4(cfg.Storage.S3AccessKey, cfg.Storage.S3SecretKey, ""),
+		Secure:       cfg.Storage.S3UseSSL,
+		Region:       cfg.Storage.S3Region,
+		BucketLookup: minio.BucketLookupPath,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &S3Storage{
+		client: client,
+		bucket: cfg.Storage.S3Bucket,
+	}, nil
+}
+
+func (s *S3Storage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, r, size, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	return err
+}
+
+func (s *S3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	// the object is read after the request opening it has returned, only the
+	// values of ctx carry over to it
+	object, err := s.client.GetObject(context.WithoutCancel(ctx), s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// GetObject is lazy, stat it so a missing key is reported here
+	if _, err := object.Stat(); err != nil {
+		object.Close()
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, fiber.NewError(fiber.StatusNotFound, "file not found")
+		}
+		return nil, err
+	}
+
+	return object, nil
+}
+
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	return s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+}
@@ -0,0 +1,144 @@
+package storage
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/shironxn/blanknotes/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeObject struct {
+	data        []byte
+	contentType string
+}
+
+// fakeS3 answers the few requests S3Storage makes with objects kept in memory,
+// path style so the bucket is the first segment.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string]fakeObject
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		data, err := readS3Body(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.objects[r.URL.Path] = fakeObject{data: data, contentType: r.Header.Get("Content-Type")}
+		w.Header().Set("ETag", `"etag"`)
+	case http.MethodGet, http.MethodHead:
+		object, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			if r.Method == http.MethodGet {
+				io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`)
+			}
+			return
+		}
+		w.Header().Set("Content-Type", object.contentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(object.data)))
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("Last-Modified", "Mon, 01 Jan 2024 00:00:00 GMT")
+		if r.Method == http.MethodGet {
+			w.Write(object.data)
+		}
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// readS3Body reads a request body, undoing the aws-chunked encoding the
+// client uses to sign uploads over plain http.
+func readS3Body(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return io.ReadAll(r.Body)
+	}
+
+	var data []byte
+	reader := bufio.NewReader(r.Body)
+	for {
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, _, _ := strings.Cut(strings.TrimSpace(header), ";")
+		n, err := strconv.ParseInt(size, 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			return data, nil
+		}
+
+		chunk := make([]byte, n+2)
+		if _, err := io.ReadFull(reader, chunk); err != nil {
+			return nil, err
+		}
+		data = append(data, chunk[:n]...)
+	}
+}
+
+func TestS3Storage(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeS3{objects: make(map[string]fakeObject)}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	cfg := &config.Config{}
+	cfg.Storage.S3Endpoint = strings.TrimPrefix(server.URL, "http://")
+	cfg.Storage.S3AccessKey = "access"
+	cfg.Storage.S3SecretKey = "secret"
+	cfg.Storage.S3Region = "us-east-1"
+	cfg.Storage.S3Bucket = "blanknotes"
+	cfg.Storage.PublicURL = server.URL + "/blanknotes"
+
+	s, err := NewS3Storage(cfg)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Put(ctx, "1/image.png", strings.NewReader("image"), 5, "image/png"))
+	fake.mu.Lock()
+	assert.Equal(t, "image/png", fake.objects["/blanknotes/1/image.png"].contentType)
+	fake.mu.Unlock()
+
+	file, err := s.Get(ctx, "1/image.png")
+	require.NoError(t, err)
+	data, err := io.ReadAll(file)
+	file.Close()
+	assert.NoError(t, err)
+	assert.Equal(t, "image", string(data))
+
+	// files are served from the public url under their key
+	res, err := http.Get(cfg.Storage.PublicURL + "/1/image.png")
+	require.NoError(t, err)
+	data, err = io.ReadAll(res.Body)
+	res.Body.Close()
+	assert.NoError(t, err)
+	assert.Equal(t, "image", string(data))
+
+	assert.NoError(t, s.Delete(ctx, "1/image.png"))
+	_, err = s.Get(ctx, "1/image.png")
+	assert.EqualError(t, err, "file not found")
+
+	// deleting a missing file is not an error
+	assert.NoError(t, s.Delete(ctx, "1/image.png"))
+}
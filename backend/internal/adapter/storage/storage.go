@@ -0,0 +1,19 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/port"
+)
+
+func NewStorage(cfg *config.Config) (port.Storage, error) {
+	switch cfg.Storage.Driver {
+	case "local":
+		return NewLocalStorage(cfg.Storage.Path)
+	case "s3":
+		return NewS3Storage(cfg)
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.Storage.Driver)
+	}
+}
@@ -0,0 +1,145 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	gormtracing "gorm.io/plugin/opentelemetry/tracing"
+)
+
+const name = "github.com/shironxn/blanknotes/internal/adapter/tracing"
+
+// NewProvider sets the global tracer provider up for the exporter of cfg and
+// returns the function flushing the spans still buffered, called on shutdown.
+// The W3C trace context is propagated with every exporter, none included, so
+// a trace started by the caller carries on past this service.
+func NewProvider(cfg *config.Config) (func(ctx context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch cfg.Tracing.Exporter {
+	case "none":
+		return func(ctx context.Context) error { return nil }, nil
+	case "stdout":
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case "otlp":
+		var opts []otlptracehttp.Option
+		if cfg.Tracing.Endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpointURL(cfg.Tracing.Endpoint))
+		}
+		exporter, err = otlptracehttp.New(context.Background(), opts...)
+	default:
+		return nil, fmt.Errorf("unsupported tracing exporter %q", cfg.Tracing.Exporter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	version, _, _ := config.Build()
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(cfg.Tracing.ServiceName),
+		semconv.ServiceVersion(version),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.Tracing.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// Instrument adds a span for every query of db. The values bound to a query
+// are left out, they hold password hashes and tokens.
+func Instrument(db *gorm.DB) error {
+	return db.Use(gormtracing.NewPlugin(gormtracing.WithoutMetrics(), gormtracing.WithoutQueryVariables()))
+}
+
+// Middleware starts a span for every request, as a child of the trace of the
+// caller when the request carries a traceparent header. Handlers pass the
+// span down with ctx.UserContext(). Errors are handled here rather than by the
+// app so the status they end up with is the one recorded.
+func Middleware() fiber.Handler {
+	tracer := otel.Tracer(name)
+
+	return func(ctx *fiber.Ctx) error {
+		method := strings.Clone(ctx.Method())
+
+		parent := otel.GetTextMapPropagator().Extract(ctx.UserContext(), carrier{ctx})
+		spanCtx, span := tracer.Start(parent, method,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(method),
+				semconv.URLPath(strings.Clone(ctx.Path())),
+				semconv.ClientAddress(strings.Clone(ctx.IP())),
+				semconv.UserAgentOriginal(strings.Clone(ctx.Get(fiber.HeaderUserAgent))),
+			),
+		)
+		defer span.End()
+		ctx.SetUserContext(spanCtx)
+
+		if err := ctx.Next(); err != nil {
+			if err := ctx.App().ErrorHandler(ctx, err); err != nil {
+				_ = ctx.SendStatus(fiber.StatusInternalServerError)
+			}
+		}
+
+		if route, ok := util.Route(ctx); ok {
+			span.SetName(method + " " + route)
+			span.SetAttributes(semconv.HTTPRoute(route))
+		}
+
+		status := ctx.Response().StatusCode()
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+		// client errors are the fault of the client, not of this span
+		if status >= fiber.StatusInternalServerError {
+			span.SetStatus(codes.Error, fiber.ErrInternalServerError.Message)
+		}
+
+		return nil
+	}
+}
+
+// carrier reads the trace context from the headers of a request.
+type carrier struct {
+	ctx *fiber.Ctx
+}
+
+func (c carrier) Get(key string) string {
+	return c.ctx.Get(key)
+}
+
+func (c carrier) Set(key string, value string) {
+	c.ctx.Request().Header.Set(key, value)
+}
+
+func (c carrier) Keys() []string {
+	var keys []string
+	c.ctx.Request().Header.VisitAll(func(key, _ []byte) {
+		keys = append(keys, string(key))
+	})
+	return keys
+}
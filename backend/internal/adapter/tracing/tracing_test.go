@@ -0,0 +1,84 @@
+package tracing
+
+import (
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/shironxn/blanknotes/internal/config"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"gorm.io/gorm/logger"
+)
+
+func TestMiddleware(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	cfg := &config.Config{}
+	cfg.Database.Driver = "sqlite"
+	cfg.Database.Name = filepath.Join(t.TempDir(), "test.db")
+
+	db, err := config.NewGorm(cfg).Connection()
+	require.NoError(t, err)
+	db.Logger = logger.Discard
+	require.NoError(t, Instrument(db))
+
+	app := fiber.New(fiber.Config{ErrorHandler: config.ErrorHandler()})
+	app.Use(Middleware())
+	app.Get("/api/v1/notes/:id", func(ctx *fiber.Ctx) error {
+		var one int
+		if err := db.WithContext(ctx.UserContext()).Raw("SELECT ?", ctx.Params("id")).Scan(&one).Error; err != nil {
+			return err
+		}
+		if one == 2 {
+			return fiber.ErrInternalServerError
+		}
+		return ctx.SendStatus(fiber.StatusOK)
+	})
+
+	req := httptest.NewRequest(fiber.MethodGet, "/api/v1/notes/1", nil)
+	req.Header.Set("traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+	res, err := app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	query, request := spans[0], spans[1]
+
+	assert.Equal(t, "GET /api/v1/notes/:id", request.Name)
+	assert.Equal(t, "0af7651916cd43dd8448eb211c80319c", request.SpanContext.TraceID().String())
+	assert.Equal(t, "b7ad6b7169203331", request.Parent.SpanID().String())
+	assert.Contains(t, request.Attributes, attribute.String("http.route", "/api/v1/notes/:id"))
+	assert.Contains(t, request.Attributes, attribute.Int("http.response.status_code", fiber.StatusOK))
+	assert.Equal(t, codes.Unset, request.Status.Code)
+
+	assert.Equal(t, request.SpanContext.SpanID(), query.Parent.SpanID())
+	assert.Contains(t, query.Attributes, attribute.String("db.statement", "SELECT ?"), "values are left out")
+
+	exporter.Reset()
+	_, err = app.Test(httptest.NewRequest(fiber.MethodGet, "/api/v1/notes/2", nil))
+	require.NoError(t, err)
+	spans = exporter.GetSpans()
+	require.Len(t, spans, 2)
+	assert.Equal(t, codes.Error, spans[1].Status.Code)
+	assert.False(t, spans[1].Parent.IsValid())
+
+	exporter.Reset()
+	_, err = app.Test(httptest.NewRequest(fiber.MethodGet, "/wp-login.php", nil))
+	require.NoError(t, err)
+	spans = exporter.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "GET", spans[0].Name)
+	assert.Contains(t, spans[0].Attributes, attribute.Int("http.response.status_code", fiber.StatusNotFound))
+}
@@ -0,0 +1,74 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/port"
+)
+
+// ImageWorker processes uploaded images as soon as they are queued. It also
+// scans for pending uploads periodically, which picks up the ones left behind
+// by a restart or a full queue.
+type ImageWorker struct {
+	uploadService port.UploadService
+	cfg           *config.Config
+	stop          chan struct{}
+	done          chan struct{}
+}
+
+func NewImageWorker(uploadService port.UploadService, cfg *config.Config) port.Worker {
+	return &ImageWorker{
+		uploadService: uploadService,
+		cfg:           cfg,
+		stop:          make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+}
+
+func (w *ImageWorker) Start() {
+	go func() {
+		defer close(w.done)
+
+		ticker := time.NewTicker(w.cfg.Images.Interval)
+		defer ticker.Stop()
+
+		w.scan()
+
+		for {
+			select {
+			case id := <-w.uploadService.Queue():
+				w.process(id)
+			case <-ticker.C:
+				w.scan()
+			case <-w.stop:
+				return
+			}
+		}
+	}()
+}
+
+func (w *ImageWorker) Stop() {
+	close(w.stop)
+	<-w.done
+}
+
+func (w *ImageWorker) scan() {
+	ids, err := w.uploadService.Pending(context.Background())
+	if err != nil {
+		slog.Error("failed to get pending uploads", "err", err)
+		return
+	}
+
+	for _, id := range ids {
+		w.process(id)
+	}
+}
+
+func (w *ImageWorker) process(id uint) {
+	if err := w.uploadService.Process(context.Background(), id); err != nil {
+		slog.Error("failed to process upload", "id", id, "err", err)
+	}
+}
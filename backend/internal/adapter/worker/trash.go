@@ -0,0 +1,78 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/port"
+)
+
+type TrashWorker struct {
+	noteService       port.NoteService
+	userService       port.UserService
+	attachmentService port.AttachmentService
+	cfg               *config.Config
+	stop              chan struct{}
+	done              chan struct{}
+}
+
+func NewTrashWorker(noteService port.NoteService, userService port.UserService, attachmentService port.AttachmentService, cfg *config.Config) port.Worker {
+	return &TrashWorker{
+		noteService:       noteService,
+		userService:       userService,
+		attachmentService: attachmentService,
+		cfg:               cfg,
+		stop:              make(chan struct{}),
+		done:              make(chan struct{}),
+	}
+}
+
+func (w *TrashWorker) Start() {
+	go func() {
+		defer close(w.done)
+
+		ticker := time.NewTicker(w.cfg.Trash.Interval)
+		defer ticker.Stop()
+
+		for {
+			w.purge()
+
+			select {
+			case <-ticker.C:
+			case <-w.stop:
+				return
+			}
+		}
+	}()
+}
+
+func (w *TrashWorker) Stop() {
+	close(w.stop)
+	<-w.done
+}
+
+func (w *TrashWorker) purge() {
+	notes, err := w.noteService.Purge(context.Background(), w.cfg.Trash.NoteRetention)
+	if err != nil {
+		slog.Error("failed to purge trashed notes", "err", err)
+	} else if notes > 0 {
+		slog.Info("purged trashed notes", "count", notes)
+	}
+
+	users, err := w.userService.Purge(context.Background(), w.cfg.Trash.UserGracePeriod)
+	if err != nil {
+		slog.Error("failed to purge deleted users", "err", err)
+	} else if users > 0 {
+		slog.Info("purged deleted users", "count", users)
+	}
+
+	// attachments of the notes purged above, or of notes deleted for good
+	attachments, err := w.attachmentService.Purge(context.Background())
+	if err != nil {
+		slog.Error("failed to purge orphaned attachments", "err", err)
+	} else if attachments > 0 {
+		slog.Info("purged orphaned attachments", "count", attachments)
+	}
+}
@@ -0,0 +1,39 @@
+package config
+
+import (
+	"runtime/debug"
+)
+
+// Version, Commit and BuildTime are set when linking, see the build target of
+// the Makefile.
+var (
+	Version   = "dev"
+	Commit    = ""
+	BuildTime = ""
+)
+
+// Build returns the build info, falling back to what the go command stamps
+// into the binary when the linker flags were not given.
+func Build() (version, commit, buildTime string) {
+	version, commit, buildTime = Version, Commit, BuildTime
+
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range info.Settings {
+			switch {
+			case setting.Key == "vcs.revision" && commit == "":
+				commit = setting.Value
+			case setting.Key == "vcs.time" && buildTime == "":
+				buildTime = setting.Value
+			}
+		}
+	}
+
+	if commit == "" {
+		commit = "unknown"
+	}
+	if buildTime == "" {
+		buildTime = "unknown"
+	}
+
+	return version, commit, buildTime
+}
@@ -1,91 +1,116 @@
 package config
 
 import (
-	"flag"
-	"os"
-
-	"github.com/joho/godotenv"
+	"time"
 )
 
+// Config is read by Load from, in increasing precedence, the defaults below,
+// a YAML or TOML file, environment variables and command line flags. The key
+// tags name the settings in files and flags, server.port in a file is the
+// -server.port flag and the APP_PORT variable.
 type Config struct {
 	Server struct {
-		Host string
-		Port string
-		Dev  string
-		Web  string
-	}
+		Host string `key:"host" env:"APP_HOST"`
+		Port int    `key:"port" env:"APP_PORT" default:"8080" validate:"min=1,max=65535"`
+		// Dev relaxes cookies for a web app served from another origin over http
+		Dev bool   `key:"dev" env:"APP_DEV"`
+		Web string `key:"web" env:"APP_WEB" default:"http://localhost:3000" validate:"required,url"`
+		// ShutdownTimeout is how long in-flight requests get to finish on SIGTERM
+		ShutdownTimeout time.Duration `key:"shutdown_timeout" env:"APP_SHUTDOWN_TIMEOUT" default:"30s" validate:"gt=0"`
+		// RequestTimeout bounds the work done for a request, streamed responses
+		// and websockets excepted
+		RequestTimeout time.Duration `key:"request_timeout" env:"APP_REQUEST_TIMEOUT" default:"30s" validate:"gt=0"`
+		// HealthTimeout bounds every check of /readyz
+		HealthTimeout time.Duration `key:"health_timeout" env:"APP_HEALTH_TIMEOUT" default:"2s" validate:"gt=0"`
+		// MetricsPort serves /metrics on a listener of its own, kept off the
+		// public port. Zero serves it next to the API.
+		MetricsPort int `key:"metrics_port" env:"APP_METRICS_PORT" validate:"omitempty,min=1,max=65535,nefield=Port"`
+	} `key:"server"`
 	Database struct {
-		Host string
-		Port string
-		Name string
-		User string
-		Pass string
-	}
+		Driver string `key:"driver" env:"DB_DRIVER" default:"postgres" validate:"oneof=postgres mysql sqlite"`
+		Host   string `key:"host" env:"DB_HOST" validate:"required_unless=Driver sqlite"`
+		// Port defaults to the one of the driver
+		Port int `key:"port" env:"DB_PORT" validate:"min=0,max=65535"`
+		// Name is the path of the database file for sqlite
+		Name string `key:"name" env:"DB_NAME" validate:"required"`
+		User string `key:"user" env:"DB_USER" validate:"required_unless=Driver sqlite"`
+		Pass string `key:"pass" env:"DB_PASS"`
+		// Migrate runs pending migrations on startup
+		Migrate bool `key:"migrate" env:"DB_MIGRATE" default:"true"`
+		// pool settings, zero means no limit
+		MaxOpenConns    int           `key:"max_open_conns" env:"DB_MAX_OPEN_CONNS" default:"25" validate:"min=0"`
+		MaxIdleConns    int           `key:"max_idle_conns" env:"DB_MAX_IDLE_CONNS" default:"10" validate:"min=0"`
+		ConnMaxLifetime time.Duration `key:"conn_max_lifetime" env:"DB_CONN_MAX_LIFETIME" default:"30m" validate:"min=0"`
+		ConnMaxIdleTime time.Duration `key:"conn_max_idle_time" env:"DB_CONN_MAX_IDLE_TIME" default:"5m" validate:"min=0"`
+	} `key:"database"`
 	JWT struct {
-		Access  string
-		Refresh string
-	}
-}
-
-var (
-	config *Config
-)
-
-func NewConfig() (*Config, error) {
-	if err := LoadConfig(); err != nil {
-		return nil, err
-	}
-	return config, nil
-}
-
-func LoadConfig() error {
-	if config != nil {
-		return nil
-	}
-
-	if flag.Lookup("test.v") != nil {
-		if err := godotenv.Load("../../.env"); err != nil {
-			return err
-		}
-	} else {
-		if err := godotenv.Load(); err != nil {
-			return err
-		}
-	}
-
-	config = &Config{
-		Server: struct {
-			Host string
-			Port string
-			Dev  string
-			Web  string
-		}{
-			Host: os.Getenv("APP_HOST"),
-			Port: os.Getenv("APP_PORT"),
-			Dev:  os.Getenv("APP_DEV"),
-			Web:  os.Getenv("APP_WEB"),
-		},
-		Database: struct {
-			Host string
-			Port string
-			Name string
-			User string
-			Pass string
-		}{
-			Host: os.Getenv("DB_HOST"),
-			Port: os.Getenv("DB_PORT"),
-			Name: os.Getenv("DB_NAME"),
-			User: os.Getenv("DB_USER"),
-			Pass: os.Getenv("DB_PASS"),
-		},
-		JWT: struct {
-			Access  string
-			Refresh string
-		}{
-			Access:  os.Getenv("JWT_ACCESS_SECRET"),
-			Refresh: os.Getenv("JWT_REFRESH_SECRET"),
-		},
-	}
+		Access  string `key:"access_secret" env:"JWT_ACCESS_SECRET" validate:"required"`
+		Refresh string `key:"refresh_secret" env:"JWT_REFRESH_SECRET" validate:"required,nefield=Access"`
+	} `key:"jwt"`
+	Pagination struct {
+		// Secret signs page cursors, apart from the JWT secrets so rotating
+		// those does not break the cursors clients hold
+		Secret string `key:"secret" env:"PAGINATION_SECRET" validate:"required"`
+	} `key:"pagination"`
+	Trash struct {
+		Interval        time.Duration `key:"interval" env:"TRASH_INTERVAL" default:"1h" validate:"gt=0"`
+		NoteRetention   time.Duration `key:"note_retention" env:"TRASH_NOTE_RETENTION" default:"720h" validate:"gt=0"`
+		UserGracePeriod time.Duration `key:"user_grace_period" env:"TRASH_USER_GRACE_PERIOD" default:"720h" validate:"gt=0"`
+	} `key:"trash"`
+	Collab struct {
+		PersistInterval time.Duration `key:"persist_interval" env:"COLLAB_PERSIST_INTERVAL" default:"5s" validate:"gt=0"`
+	} `key:"collab"`
+	Events struct {
+		LogSize   int           `key:"log_size" env:"EVENTS_LOG_SIZE" default:"1000" validate:"gt=0"`
+		Heartbeat time.Duration `key:"heartbeat" env:"EVENTS_HEARTBEAT" default:"15s" validate:"gt=0"`
+	} `key:"events"`
+	Markdown struct {
+		CacheSize int `key:"cache_size" env:"MARKDOWN_CACHE_SIZE" default:"500" validate:"gt=0"`
+	} `key:"markdown"`
+	Import struct {
+		// DefaultCoverURL defaults to the cover the web app ships
+		DefaultCoverURL string `key:"default_cover_url" env:"IMPORT_DEFAULT_COVER_URL" validate:"omitempty,url"`
+		// Timeout bounds an import in place of the request timeout, large
+		// uploads take longer to import
+		Timeout time.Duration `key:"timeout" env:"IMPORT_TIMEOUT" default:"10m" validate:"gt=0"`
+	} `key:"import"`
+	Storage struct {
+		Driver string `key:"driver" env:"STORAGE_DRIVER" default:"local" validate:"oneof=local s3"`
+		Path   string `key:"path" env:"STORAGE_PATH" default:"uploads" validate:"required_if=Driver local"`
+		// PublicURL defaults to the uploads route of this server
+		PublicURL   string `key:"public_url" env:"STORAGE_PUBLIC_URL" validate:"omitempty,url"`
+		MaxSize     int64  `key:"max_size" env:"STORAGE_MAX_SIZE" default:"3145728" validate:"gt=0"`
+		S3Endpoint  string `key:"s3_endpoint" env:"S3_ENDPOINT" validate:"required_if=Driver s3"`
+		S3Region    string `key:"s3_region" env:"S3_REGION"`
+		S3Bucket    string `key:"s3_bucket" env:"S3_BUCKET" validate:"required_if=Driver s3"`
+		S3AccessKey string `key:"s3_access_key" env:"S3_ACCESS_KEY" validate:"required_if=Driver s3"`
+		S3SecretKey string `key:"s3_secret_key" env:"S3_SECRET_KEY" validate:"required_if=Driver s3"`
+		S3UseSSL    bool   `key:"s3_use_ssl" env:"S3_USE_SSL"`
+	} `key:"storage"`
+	Images struct {
+		Interval time.Duration `key:"interval" env:"IMAGES_INTERVAL" default:"1m" validate:"gt=0"`
+		MaxSize  int           `key:"max_size" env:"IMAGES_MAX_SIZE" default:"2560" validate:"gt=0"`
+		Quality  int           `key:"quality" env:"IMAGES_QUALITY" default:"85" validate:"min=1,max=100"`
+	} `key:"images"`
+	Attachments struct {
+		// MaxSize is capped at BodyLimit
+		MaxSize int64 `key:"max_size" env:"ATTACHMENTS_MAX_SIZE" default:"26214400" validate:"gt=0"`
+		Quota   int64 `key:"quota" env:"ATTACHMENTS_QUOTA" default:"104857600" validate:"gt=0"`
+	} `key:"attachments"`
+	Log struct {
+		// Level is the least severe level written, debug adds every query
+		Level string `key:"level" env:"LOG_LEVEL" default:"info" validate:"oneof=debug info warn error"`
+	} `key:"log"`
 
-	return nil
+	Tracing struct {
+		// Exporter is none, stdout to print spans for local runs, or otlp
+		Exporter string `key:"exporter" env:"TRACING_EXPORTER" default:"none" validate:"oneof=none stdout otlp"`
+		// Endpoint of the OTLP/HTTP collector, defaults to
+		// OTEL_EXPORTER_OTLP_ENDPOINT or http://localhost:4318
+		Endpoint    string `key:"endpoint" env:"TRACING_ENDPOINT" validate:"omitempty,url"`
+		ServiceName string `key:"service_name" env:"TRACING_SERVICE_NAME" default:"blanknotes" validate:"required"`
+		// SampleRatio applies to traces started here, requests carrying a trace
+		// keep the decision of the caller
+		SampleRatio float64 `key:"sample_ratio" env:"TRACING_SAMPLE_RATIO" default:"1" validate:"min=0,max=1"`
+	} `key:"tracing"`
 }
@@ -0,0 +1,154 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefault(t *testing.T) {
+	config := Default()
+
+	assert.Equal(t, 8080, config.Server.Port)
+	assert.Equal(t, "postgres", config.Database.Driver)
+	assert.Equal(t, 5432, config.Database.Port)
+	assert.True(t, config.Database.Migrate)
+	assert.Equal(t, 720*time.Hour, config.Trash.NoteRetention)
+	assert.Equal(t, "http://localhost:3000/cover.jpg", config.Import.DefaultCoverURL)
+	assert.Equal(t, "http://localhost:8080/uploads", config.Storage.PublicURL)
+	assert.Equal(t, int64(25<<20), config.Attachments.MaxSize)
+	assert.Equal(t, "none", config.Tracing.Exporter)
+	assert.Equal(t, 1.0, config.Tracing.SampleRatio)
+	assert.Equal(t, "info", config.Log.Level)
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(`
+server:
+  port: 9000
+  dev: true
+database:
+  driver: sqlite
+  name: notes.db
+jwt:
+  access_secret: from-file
+  refresh_secret: refresh
+pagination:
+  secret: pagination
+trash:
+  interval: 2h
+`), 0o600))
+
+	secret := filepath.Join(dir, "secret")
+	require.NoError(t, os.WriteFile(secret, []byte("from-secret-file\n"), 0o600))
+
+	t.Setenv("CONFIG_FILE", file)
+	t.Setenv("APP_PORT", "9001")
+	t.Setenv("JWT_ACCESS_SECRET_FILE", secret)
+	t.Setenv("TRASH_INTERVAL", "")
+
+	config, args, err := Load([]string{"-server.port", "9002", "migrate", "up"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"migrate", "up"}, args)
+	assert.Equal(t, 9002, config.Server.Port, "flags win over the environment")
+	assert.True(t, config.Server.Dev)
+	assert.Equal(t, "sqlite", config.Database.Driver)
+	assert.Equal(t, "notes.db", config.Database.Name)
+	assert.Equal(t, "from-secret-file", config.JWT.Access, "the environment wins over the file")
+	assert.Equal(t, 2*time.Hour, config.Trash.Interval, "empty variables are ignored")
+	assert.Equal(t, "http://localhost:9002/uploads", config.Storage.PublicURL)
+}
+
+func TestLoad_TOML(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "config.toml")
+	require.NoError(t, os.WriteFile(file, []byte(`
+[database]
+driver = "mysql"
+host = "db"
+name = "notes"
+user = "notes"
+
+[jwt]
+access_secret = "access"
+refresh_secret = "refresh"
+
+[pagination]
+secret = "pagination"
+
+[images]
+quality = 90
+
+[tracing]
+sample_ratio = 0.25
+`), 0o600))
+
+	config, _, err := Load([]string{"-config", file})
+	require.NoError(t, err)
+
+	assert.Equal(t, "mysql", config.Database.Driver)
+	assert.Equal(t, 3306, config.Database.Port)
+	assert.Equal(t, 90, config.Images.Quality)
+	assert.Equal(t, 0.25, config.Tracing.SampleRatio)
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	dir := t.TempDir()
+	typo := filepath.Join(dir, "typo.yaml")
+	require.NoError(t, os.WriteFile(typo, []byte("server:\n  prot: 8080\n"), 0o600))
+
+	tests := []struct {
+		name  string
+		args  []string
+		env   map[string]string
+		error string
+	}{
+		{
+			name:  "unknown key",
+			args:  []string{"-config", typo},
+			error: typo + ": unknown key server.prot",
+		},
+		{
+			name:  "bad value",
+			env:   map[string]string{"EVENTS_HEARTBEAT": "soon"},
+			error: `EVENTS_HEARTBEAT: "soon" is not a duration like 30s or 1h`,
+		},
+		{
+			name:  "variable and file",
+			env:   map[string]string{"JWT_ACCESS_SECRET": "access", "JWT_ACCESS_SECRET_FILE": typo},
+			error: "set either JWT_ACCESS_SECRET or JWT_ACCESS_SECRET_FILE, not both",
+		},
+		{
+			name: "missing settings",
+			args: []string{"-server.metrics_port", "8080", "-database.host", "db", "-database.user", "notes", "-storage.driver", "s3", "-images.quality", "0", "-log.level", "trace", "-tracing.sample_ratio", "2"},
+			env:  map[string]string{"JWT_ACCESS_SECRET": "secret", "JWT_REFRESH_SECRET": "secret"},
+			error: "invalid config: server.metrics_port (APP_METRICS_PORT) must differ from server.port\n" +
+				"database.name (DB_NAME) is required\n" +
+				"jwt.refresh_secret (JWT_REFRESH_SECRET) must differ from jwt.access_secret\n" +
+				"pagination.secret (PAGINATION_SECRET) is required\n" +
+				"storage.s3_endpoint (S3_ENDPOINT) is required\n" +
+				"storage.s3_bucket (S3_BUCKET) is required\n" +
+				"storage.s3_access_key (S3_ACCESS_KEY) is required\n" +
+				"storage.s3_secret_key (S3_SECRET_KEY) is required\n" +
+				"images.quality (IMAGES_QUALITY) must be at least 1\n" +
+				"log.level (LOG_LEVEL) must be one of debug, info, warn, error\n" +
+				"tracing.sample_ratio (TRACING_SAMPLE_RATIO) must be at most 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			_, _, err := Load(tt.args)
+			assert.EqualError(t, err, tt.error)
+		})
+	}
+}
@@ -1,14 +1,24 @@
 package config
 
 import (
+	"context"
+	"errors"
+
 	"github.com/shironxn/blanknotes/internal/core/domain"
 
 	"github.com/gofiber/fiber/v2"
 )
 
+// BodyLimit is the largest request body accepted, it has to fit the largest
+// attachment and import. Smaller limits are checked by the services.
+const BodyLimit = 32 << 20
+
 func NewFiber() *fiber.App {
 	return fiber.New(fiber.Config{
 		ErrorHandler: ErrorHandler(),
+		BodyLimit:    BodyLimit,
+		// the banner would break the JSON lines of the log
+		DisableStartupMessage: true,
 	})
 }
 
@@ -18,6 +28,9 @@ func ErrorHandler() fiber.ErrorHandler {
 
 		if e, ok := err.(*fiber.Error); ok {
 			code = e.Code
+		} else if errors.Is(err, context.DeadlineExceeded) {
+			code = fiber.StatusServiceUnavailable
+			err = fiber.NewError(code, "request timed out")
 		}
 
 		return ctx.Status(code).JSON(domain.ErrorResponse{
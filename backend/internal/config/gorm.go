@@ -2,7 +2,10 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 
+	"github.com/glebarez/sqlite"
+	"gorm.io/driver/mysql"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -18,18 +21,55 @@ func NewGorm(config *Config) *DB {
 }
 
 func (d *DB) Connection() (*gorm.DB, error) {
-	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=disable TimeZone=Asia/Shanghai",
-		d.config.Database.Host,
-		d.config.Database.User,
-		d.config.Database.Pass,
-		d.config.Database.Name,
-		d.config.Database.Port,
-	)
-
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	dialector, err := d.dialector()
 	if err != nil {
 		return nil, err
 	}
 
+	db, err := gorm.Open(dialector, &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	pool.SetMaxOpenConns(d.config.Database.MaxOpenConns)
+	pool.SetMaxIdleConns(d.config.Database.MaxIdleConns)
+	pool.SetConnMaxLifetime(d.config.Database.ConnMaxLifetime)
+	pool.SetConnMaxIdleTime(d.config.Database.ConnMaxIdleTime)
+
 	return db, nil
 }
+
+// dialector picks the driver of the database setting. Timestamps are kept in
+// UTC whatever the time zone of the server is.
+func (d *DB) dialector() (gorm.Dialector, error) {
+	cfg := d.config.Database
+
+	switch cfg.Driver {
+	case "postgres":
+		return postgres.Open(fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%d sslmode=disable TimeZone=UTC",
+			cfg.Host,
+			cfg.User,
+			cfg.Pass,
+			cfg.Name,
+			cfg.Port,
+		)), nil
+	case "mysql":
+		return mysql.Open(fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=true&loc=UTC",
+			cfg.User,
+			cfg.Pass,
+			cfg.Host,
+			cfg.Port,
+			cfg.Name,
+		)), nil
+	case "sqlite":
+		// the name is the path of the database file
+		pragmas := url.Values{"_pragma": {"foreign_keys(1)", "busy_timeout(5000)", "journal_mode(WAL)"}}
+		return sqlite.Open(cfg.Name + "?" + pragmas.Encode()), nil
+	default:
+		return nil, fmt.Errorf("unsupported database driver %q, use postgres, mysql or sqlite", cfg.Driver)
+	}
+}
@@ -0,0 +1,349 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/go-playground/validator/v10"
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+// setting is a single value of Config with the names it goes by.
+type setting struct {
+	key   string
+	env   string
+	value reflect.Value
+	field reflect.StructField
+}
+
+// Default returns a config holding only the defaults, tests can build on it
+// without any file or environment.
+func Default() *Config {
+	config := &Config{}
+	if err := setDefaults(settings(config)); err != nil {
+		panic(err)
+	}
+	config.derive()
+	return config
+}
+
+// NewConfig loads the config without command line flags.
+func NewConfig() (*Config, error) {
+	config, _, err := Load(nil)
+	return config, err
+}
+
+// Load reads the config and returns the arguments left after the flags. A
+// .env file in the working directory is loaded into the environment when there
+// is one, the config file is given with -config or CONFIG_FILE. Every value of
+// an environment variable can also be read from the file named by the variable
+// with a _FILE suffix, which is how secrets are mounted in containers.
+func Load(args []string) (*Config, []string, error) {
+	if err := godotenv.Load(); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, nil, fmt.Errorf(".env: %w", err)
+	}
+
+	config := &Config{}
+	all := settings(config)
+	if err := setDefaults(all); err != nil {
+		return nil, nil, err
+	}
+
+	flags := flag.NewFlagSet("main", flag.ContinueOnError)
+	file := flags.String("config", os.Getenv("CONFIG_FILE"), "YAML or TOML config file")
+	values := map[string]string{}
+	for _, s := range all {
+		flags.Func(s.key, "overrides "+s.env, func(value string) error {
+			values[s.key] = value
+			return nil
+		})
+	}
+	if err := flags.Parse(args); err != nil {
+		return nil, nil, err
+	}
+
+	if *file != "" {
+		if err := config.loadFile(*file, all); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for _, s := range all {
+		value, ok, err := lookupEnv(s.env)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			continue
+		}
+		if err := set(s.value, value); err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", s.env, err)
+		}
+	}
+
+	for _, s := range all {
+		if value, ok := values[s.key]; ok {
+			if err := set(s.value, value); err != nil {
+				return nil, nil, fmt.Errorf("-%s: %w", s.key, err)
+			}
+		}
+	}
+
+	config.derive()
+	if err := config.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	return config, flags.Args(), nil
+}
+
+// Validate reports every invalid setting at once, named the way it is set in
+// files and in the environment.
+func (c *Config) Validate() error {
+	err := validator.New().Struct(c)
+
+	var invalid validator.ValidationErrors
+	if !errors.As(err, &invalid) {
+		return err
+	}
+
+	byNamespace := map[string]setting{}
+	for _, s := range settings(c) {
+		byNamespace[s.namespace()] = s
+	}
+
+	var errs []error
+	for _, e := range invalid {
+		s := byNamespace[e.StructNamespace()]
+
+		var message string
+		switch e.Tag() {
+		case "required", "required_if", "required_unless":
+			message = "is required"
+		case "oneof":
+			message = "must be one of " + strings.ReplaceAll(e.Param(), " ", ", ")
+		case "gt":
+			message = "must be greater than " + e.Param()
+		case "min":
+			message = "must be at least " + e.Param()
+		case "max":
+			message = "must be at most " + e.Param()
+		case "url":
+			message = "must be a url"
+		case "nefield":
+			// the param is a field of the same group
+			namespace := e.StructNamespace()
+			other := byNamespace[namespace[:strings.LastIndex(namespace, ".")+1]+e.Param()]
+			message = "must differ from " + other.key
+		default:
+			message = "is invalid"
+		}
+		errs = append(errs, fmt.Errorf("%s (%s) %s", s.key, s.env, message))
+	}
+
+	return fmt.Errorf("invalid config: %w", errors.Join(errs...))
+}
+
+// derive fills in settings whose defaults depend on other settings.
+func (c *Config) derive() {
+	if c.Database.Port == 0 {
+		switch c.Database.Driver {
+		case "postgres":
+			c.Database.Port = 5432
+		case "mysql":
+			c.Database.Port = 3306
+		}
+	}
+
+	// notes from other apps have no cover, fall back to the one the web app ships
+	if c.Import.DefaultCoverURL == "" {
+		c.Import.DefaultCoverURL = c.Server.Web + "/cover.jpg"
+	}
+
+	if c.Storage.PublicURL == "" {
+		c.Storage.PublicURL = "http://localhost:" + strconv.Itoa(c.Server.Port) + "/uploads"
+	}
+	c.Storage.PublicURL = strings.TrimSuffix(c.Storage.PublicURL, "/")
+
+	if c.Attachments.MaxSize > BodyLimit {
+		c.Attachments.MaxSize = BodyLimit
+	}
+}
+
+// loadFile sets the values of a YAML or TOML file. Sections of the file are
+// the groups of Config and unknown keys are an error, they are likely typos.
+func (c *Config) loadFile(path string, all []setting) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var tree map[string]interface{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &tree)
+	case ".toml":
+		err = toml.Unmarshal(data, &tree)
+	default:
+		return fmt.Errorf("%s: config files must be .yaml, .yml or .toml", path)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	values := map[string]interface{}{}
+	flatten("", tree, values)
+
+	byKey := map[string]setting{}
+	for _, s := range all {
+		byKey[s.key] = s
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var errs []error
+	for _, key := range keys {
+		s, ok := byKey[key]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: unknown key %s", path, key))
+			continue
+		}
+		if err := set(s.value, fmt.Sprint(values[key])); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %s: %w", path, key, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func flatten(prefix string, tree map[string]interface{}, values map[string]interface{}) {
+	for key, value := range tree {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		if section, ok := value.(map[string]interface{}); ok {
+			flatten(key, section, values)
+			continue
+		}
+		values[key] = value
+	}
+}
+
+// lookupEnv reads the variable key or the file named by key_FILE. Empty
+// variables count as unset, docker compose passes unset ones on empty.
+func lookupEnv(key string) (string, bool, error) {
+	value := os.Getenv(key)
+
+	path := os.Getenv(key + "_FILE")
+	if path == "" {
+		return value, value != "", nil
+	}
+	if value != "" {
+		return "", false, fmt.Errorf("set either %s or %s_FILE, not both", key, key)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", false, fmt.Errorf("%s_FILE: %w", key, err)
+	}
+	// editors and echo leave a newline at the end
+	return strings.TrimRight(string(data), "\r\n"), true, nil
+}
+
+func setDefaults(all []setting) error {
+	for _, s := range all {
+		if def, ok := s.field.Tag.Lookup("default"); ok {
+			if err := set(s.value, def); err != nil {
+				return fmt.Errorf("default of %s: %w", s.key, err)
+			}
+		}
+	}
+	return nil
+}
+
+// settings lists the fields of config in order, with keys like server.port.
+func settings(config *Config) []setting {
+	var all []setting
+
+	groups := reflect.ValueOf(config).Elem()
+	for i := 0; i < groups.NumField(); i++ {
+		group, groupField := groups.Field(i), groups.Type().Field(i)
+		for j := 0; j < group.NumField(); j++ {
+			field := group.Type().Field(j)
+			all = append(all, setting{
+				key:   groupField.Tag.Get("key") + "." + field.Tag.Get("key"),
+				env:   field.Tag.Get("env"),
+				value: group.Field(j),
+				field: reflect.StructField{Name: groupField.Name + "." + field.Name, Tag: field.Tag},
+			})
+		}
+	}
+
+	return all
+}
+
+// namespace is the name the validator gives the field, like Config.Server.Port.
+func (s setting) namespace() string {
+	return "Config." + s.field.Name
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+func set(v reflect.Value, value string) error {
+	value = strings.TrimSpace(value)
+
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("%q is not a duration like 30s or 1h", value)
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Bool:
+		if value == "" {
+			v.SetBool(false)
+			return nil
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("%q is not true or false", value)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not a whole number", value)
+		}
+		v.SetInt(n)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not a number", value)
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package domain
+
+import "time"
+
+// Attachment is a file attached to a note. It has no foreign key on the note
+// so rows of force deleted notes survive long enough to remove their files.
+type Attachment struct {
+	ID          uint   `gorm:"primarykey"`
+	NoteID      uint   `gorm:"not null;index"`
+	UserID      uint   `gorm:"not null;index"`
+	Key         string `gorm:"not null;uniqueIndex"`
+	Name        string `gorm:"not null"`
+	ContentType string `gorm:"not null"`
+	Size        int64  `gorm:"not null"`
+	CreatedAt   time.Time
+}
+
+type AttachmentRequest struct {
+	ID     uint `json:"id" params:"attachment_id"`
+	NoteID uint `json:"note_id" params:"id"`
+}
+
+type AttachmentResponse struct {
+	ID          uint      `json:"id"`
+	NoteID      uint      `json:"note_id"`
+	Name        string    `json:"name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	URL         string    `json:"url"`
+	CreatedAt   time.Time `json:"created_at"`
+}
@@ -0,0 +1,39 @@
+package domain
+
+import "encoding/json"
+
+const (
+	CollabInit      = "init"
+	CollabOperation = "operation"
+	CollabAck       = "ack"
+	CollabCursor    = "cursor"
+	CollabPresence  = "presence"
+	CollabError     = "error"
+)
+
+type CollabUser struct {
+	ID       uint   `json:"id"`
+	Name     string `json:"name"`
+	Editable bool   `json:"editable"`
+	Position int    `json:"position"`
+}
+
+type CollabMessage struct {
+	Type      string          `json:"type"`
+	Revision  int             `json:"revision"`
+	Operation json.RawMessage `json:"operation,omitempty"`
+	Position  int             `json:"position"`
+	UserID    uint            `json:"user_id,omitempty"`
+	Content   string          `json:"content,omitempty"`
+	Users     []CollabUser    `json:"users,omitempty"`
+	Error     string          `json:"error,omitempty"`
+}
+
+type CollabClient struct {
+	NoteID   uint
+	UserID   uint
+	Name     string
+	Editable bool
+	Position int
+	Send     chan CollabMessage
+}
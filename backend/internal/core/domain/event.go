@@ -0,0 +1,45 @@
+package domain
+
+import "time"
+
+type NoteEventType string
+
+const (
+	NoteCreated NoteEventType = "note.created"
+	NoteUpdated NoteEventType = "note.updated"
+	NoteDeleted NoteEventType = "note.deleted"
+)
+
+type NoteEvent struct {
+	ID         uint64
+	Type       NoteEventType
+	Note       Note
+	SharedWith []uint
+	CreatedAt  time.Time
+}
+
+// VisibleTo reports whether the user is allowed to see the note the event is about.
+func (e NoteEvent) VisibleTo(userID uint) bool {
+	if e.Note.UserID == userID || e.Note.Visibility == Public {
+		return true
+	}
+
+	for _, id := range e.SharedWith {
+		if id == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
+type NoteSubscription struct {
+	UserID uint
+	Events chan NoteEvent
+}
+
+type NoteEventResponse struct {
+	NoteID    uint          `json:"note_id"`
+	Note      *NoteResponse `json:"note,omitempty"`
+	CreatedAt time.Time     `json:"created_at"`
+}
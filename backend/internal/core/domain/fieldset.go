@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"encoding/json"
+	"slices"
+)
+
+// Relation is something a listing can embed with the include parameter.
+type Relation struct {
+	// Preload is the association that is loaded for it
+	Preload string
+	// Columns are read from the parent row to load it
+	Columns []string
+}
+
+// Selection is the allow-list of the fields and include parameters of a
+// resource. Fields maps the json keys of the response to the columns they are
+// read from.
+type Selection struct {
+	Fields  map[string][]string
+	Include map[string]Relation
+	// Default is included when there is no include parameter
+	Default []string
+}
+
+var NoteSelection = Selection{
+	Fields: map[string][]string{
+		"id":          {"id"},
+		"title":       {"title"},
+		"description": {"description"},
+		"cover":       {"cover_url"},
+		"content":     {"content"},
+		"visibility":  {"visibility"},
+		"notebook":    {"notebook"},
+		"tags":        {"tags"},
+		"created_at":  {"created_at"},
+		"updated_at":  {"updated_at"},
+	},
+	Include: map[string]Relation{
+		"author":      {Preload: "Author", Columns: []string{"user_id"}},
+		"attachments": {Preload: "Attachments"},
+	},
+	Default: []string{"author"},
+}
+
+var UserSelection = Selection{
+	Fields: map[string][]string{
+		"id":         {"id"},
+		"name":       {"name"},
+		"bio":        {"bio"},
+		"avatar":     {"avatar_url"},
+		"created_at": {"created_at"},
+		"updated_at": {"updated_at"},
+	},
+}
+
+// Fieldset is a parsed fields and include query, both hold json keys of the
+// response. The zero value selects every column and the default relations.
+type Fieldset struct {
+	Fields  []string
+	Include []string
+}
+
+// sparse marshals v and keeps only the keys in fieldset, all of them when
+// fieldset is nil.
+func sparse(v interface{}, fieldset *Fieldset) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || fieldset == nil {
+		return data, err
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+
+	for key := range object {
+		if !slices.Contains(fieldset.Fields, key) && !slices.Contains(fieldset.Include, key) {
+			delete(object, key)
+		}
+	}
+
+	return json.Marshal(object)
+}
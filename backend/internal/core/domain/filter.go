@@ -0,0 +1,51 @@
+package domain
+
+type FieldType int
+
+const (
+	FieldString FieldType = iota
+	FieldEnum
+	FieldNumber
+	FieldTime
+)
+
+// Field describes a column that list endpoints can filter and sort by.
+type Field struct {
+	Type   FieldType
+	Values []string
+}
+
+// Fields is the allow-list of a resource, keyed by column name.
+type Fields map[string]Field
+
+var NoteFields = Fields{
+	"id":          {Type: FieldNumber},
+	"title":       {Type: FieldString},
+	"description": {Type: FieldString},
+	"visibility":  {Type: FieldEnum, Values: []string{string(Public), string(Private), string(Unlisted)}},
+	"notebook":    {Type: FieldString},
+	"user_id":     {Type: FieldNumber},
+	"created_at":  {Type: FieldTime},
+	"updated_at":  {Type: FieldTime},
+	"deleted_at":  {Type: FieldTime},
+}
+
+var UserFields = Fields{
+	"id":         {Type: FieldNumber},
+	"name":       {Type: FieldString},
+	"created_at": {Type: FieldTime},
+	"updated_at": {Type: FieldTime},
+}
+
+// Filter is a parsed filter[field][operator]=value query parameter. Value is
+// already converted to the type of the field, a slice for the in operator.
+type Filter struct {
+	Field    string
+	Operator string
+	Value    interface{}
+}
+
+type SortField struct {
+	Field string
+	Desc  bool
+}
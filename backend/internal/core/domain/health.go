@@ -0,0 +1,27 @@
+package domain
+
+type HealthStatus string
+
+const (
+	HealthOK   HealthStatus = "ok"
+	HealthFail HealthStatus = "fail"
+)
+
+type HealthResponse struct {
+	Status HealthStatus           `json:"status"`
+	Checks map[string]CheckResult `json:"checks,omitempty"`
+}
+
+type CheckResult struct {
+	Status HealthStatus `json:"status"`
+	// Duration is how long the check took, like 1.2ms
+	Duration string `json:"duration"`
+	Error    string `json:"error,omitempty"`
+}
+
+type VersionResponse struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildTime string `json:"build_time"`
+	GoVersion string `json:"go_version"`
+}
@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+type ShareLink struct {
+	ID        uint   `gorm:"primarykey"`
+	NoteID    uint   `gorm:"not null;index"`
+	Token     string `gorm:"not null;uniqueIndex"`
+	Password  string
+	ExpiresAt *time.Time
+	Note      Note `gorm:"foreignKey:NoteID;constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time
+}
+
+type ShareLinkRequest struct {
+	ID        uint       `json:"id" params:"link_id"`
+	NoteID    uint       `json:"note_id" params:"id"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	Password  string     `json:"password" validate:"omitempty,min=4,max=100"`
+	Token     string     `json:"-"`
+}
+
+type ShareLinkResponse struct {
+	ID        uint       `json:"id"`
+	NoteID    uint       `json:"note_id"`
+	Token     string     `json:"token"`
+	Protected bool       `json:"protected"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
@@ -1,10 +1,14 @@
 package domain
 
 type Metadata struct {
-	Sort         string `query:"sort" json:"sort" validate:"oneof=id user_id name title created_at updated_at"`
+	Sort         string `query:"sort" json:"sort"`
 	Order        string `query:"order" json:"order" validate:"oneof=asc desc"`
-	TotalRecords int64  `json:"total_records"`
-	TotalPages   int    `json:"total_pages"`
+	TotalRecords int64  `json:"total_records,omitempty"`
+	TotalPages   int    `json:"total_pages,omitempty"`
 	Limit        int    `query:"limit" json:"limit"`
-	Page         int    `query:"page" json:"page"`
+	Page         int    `query:"page" json:"page,omitempty"`
+	Cursor       string `query:"cursor" json:"-"`
+	SkipCount    bool   `query:"skip_count" json:"-"`
+	NextCursor   string `json:"next_cursor,omitempty"`
+	PrevCursor   string `json:"prev_cursor,omitempty"`
 }
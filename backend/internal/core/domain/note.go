@@ -9,8 +9,9 @@ import (
 type Visibility string
 
 const (
-	Public  Visibility = "public"
-	Private Visibility = "private"
+	Public   Visibility = "public"
+	Private  Visibility = "private"
+	Unlisted Visibility = "unlisted"
 )
 
 type Note struct {
@@ -19,53 +20,154 @@ type Note struct {
 	Description string     `gorm:"not null"`
 	CoverURL    string     `gorm:"not null"`
 	Content     string     `gorm:"not null"`
-	Visibility  Visibility `gorm:"not null;default:'private'" sql:"type:visibility"`
+	Visibility  Visibility `gorm:"type:visibility;not null;default:'private'"`
+	Notebook    string     `gorm:"not null;default:''"`
+	Tags        []string   `gorm:"serializer:json"`
 	UserID      uint       `gorm:"not null"`
 	Author      User       `gorm:"foreignKey:UserID"`
+	// Attachments have no foreign key, see Attachment
+	Attachments []Attachment `gorm:"foreignKey:NoteID;constraint:-"`
 }
 
 type NoteRequest struct {
-	ID          uint   `json:"id"`
-	Title       string `json:"title" validate:"required,max=25" conform:"name,title,alpha"`
-	Description string `json:"description" validate:"required,max=50" conform:"trim"`
-	CoverURL    string `json:"cover_url" validate:"required,url,image" conform:"trim"`
-	Content     string `json:"content" validate:"required" conform:"trim"`
-	Visibility  string `json:"visibility" validate:"required,oneof=private public"`
-	UserID      uint   `json:"user_id"`
+	ID          uint     `json:"id"`
+	Title       string   `json:"title" validate:"required,max=25" conform:"name,title,alpha"`
+	Description string   `json:"description" validate:"required,max=50" conform:"trim"`
+	CoverURL    string   `json:"cover_url" validate:"required,url,image" conform:"trim"`
+	Content     string   `json:"content" validate:"required" conform:"trim"`
+	Visibility  string   `json:"visibility" validate:"required,oneof=private public unlisted"`
+	Notebook    string   `json:"notebook" validate:"omitempty,max=100" conform:"trim"`
+	Tags        []string `json:"tags" validate:"omitempty,max=20,dive,required,max=50"`
+	UserID      uint     `json:"user_id"`
+	// CreatedAt and UpdatedAt keep the original timestamps of imported notes
+	CreatedAt time.Time `json:"-"`
+	UpdatedAt time.Time `json:"-"`
 }
 
 type NoteUpdateRequest struct {
-	ID          uint   `json:"id"`
-	Title       string `json:"title" validate:"omitempty,max=25" conform:"name,title"`
-	Description string `json:"description" validate:"omitempty,max=50" conform:"trim"`
-	CoverURL    string `json:"cover_url" validate:"omitempty,url,image" conform:"trim"`
-	Content     string `json:"content" validate:"omitempty" conform:"trim"`
-	Visibility  string `json:"visibility" validate:"omitempty,oneof=private public"`
-	UserID      uint   `json:"user_id"`
+	ID          uint     `json:"id"`
+	Title       string   `json:"title" validate:"omitempty,max=25" conform:"name,title"`
+	Description string   `json:"description" validate:"omitempty,max=50" conform:"trim"`
+	CoverURL    string   `json:"cover_url" validate:"omitempty,url,image" conform:"trim"`
+	Content     string   `json:"content" validate:"omitempty" conform:"trim"`
+	Visibility  string   `json:"visibility" validate:"omitempty,oneof=private public unlisted"`
+	Notebook    string   `json:"notebook" validate:"omitempty,max=100" conform:"trim"`
+	Tags        []string `json:"tags" validate:"omitempty,max=20,dive,required,max=50" gorm:"serializer:json"`
+	UserID      uint     `json:"user_id"`
 }
 
 type NoteQuery struct {
-	Title      string `query:"title"`
-	Visibility string `query:"visibility"`
-	UserID     int    `query:"user_id"`
+	Title      string   `query:"title"`
+	Visibility string   `query:"visibility"`
+	UserID     int      `query:"user_id"`
+	Filters    []Filter `query:"-" gorm:"-"`
+	Fieldset   Fieldset `query:"-" gorm:"-"`
+}
+
+type NoteFormatQuery struct {
+	Format string `query:"format" validate:"omitempty,oneof=markdown html"`
+}
+
+type NoteFrontMatter struct {
+	Title       string    `yaml:"title"`
+	Description string    `yaml:"description"`
+	CoverURL    string    `yaml:"cover_url"`
+	Visibility  string    `yaml:"visibility"`
+	Notebook    string    `yaml:"notebook,omitempty"`
+	Tags        []string  `yaml:"tags,omitempty"`
+	CreatedAt   time.Time `yaml:"created_at"`
+	UpdatedAt   time.Time `yaml:"updated_at"`
+}
+
+type ImportConflict string
+
+const (
+	ImportSkip      ImportConflict = "skip"
+	ImportRename    ImportConflict = "rename"
+	ImportOverwrite ImportConflict = "overwrite"
+)
+
+type NoteImportQuery struct {
+	Format   string `query:"format" validate:"omitempty,oneof=markdown enex keep"`
+	Conflict string `query:"conflict" validate:"omitempty,oneof=skip rename overwrite"`
+}
+
+type ImportedAttachment struct {
+	Name     string
+	MimeType string
+	Data     []byte
+}
+
+// ImportedNote is a note read from an export of another app before it is
+// validated and turned into a NoteRequest. Err is set when the source could
+// not be parsed, so the file still shows up in the import report.
+type ImportedNote struct {
+	File        string
+	Title       string
+	Description string
+	CoverURL    string
+	Visibility  string
+	Content     string
+	Notebook    string
+	Tags        []string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Attachments []ImportedAttachment
+	Err         error
+}
+
+type NoteImportResult struct {
+	File     string   `json:"file"`
+	Status   string   `json:"status"`
+	NoteID   uint     `json:"note_id,omitempty"`
+	Title    string   `json:"title,omitempty"`
+	Notebook string   `json:"notebook,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+	// Attachments counts the files stored, SkippedAttachments names the
+	// ones that were not with the reason
+	Attachments        int         `json:"attachments,omitempty"`
+	SkippedAttachments []string    `json:"skipped_attachments,omitempty"`
+	Error              interface{} `json:"error,omitempty"`
+}
+
+type NoteImportResponse struct {
+	Imported  int                `json:"imported"`
+	Skipped   int                `json:"skipped"`
+	Failed    int                `json:"failed"`
+	Truncated bool               `json:"truncated,omitempty"`
+	Results   []NoteImportResult `json:"results"`
 }
 
 type NoteAuthor struct {
-	ID        uint   `json:"id"`
-	Name      string `json:"name"`
-	AvatarURL string `json:"avatar_url,omitempty"`
+	ID     uint   `json:"id"`
+	Name   string `json:"name"`
+	Avatar *Image `json:"avatar,omitempty"`
 }
 
 type NoteResponse struct {
 	ID          uint       `json:"id"`
 	Title       string     `json:"title"`
 	Description string     `json:"description"`
-	CoverURL    string     `json:"cover_url"`
+	Cover       Image      `json:"cover"`
 	Content     string     `json:"content"`
+	ContentHTML string     `json:"content_html,omitempty"`
 	Visibility  string     `json:"visibility"`
+	Notebook    string     `json:"notebook,omitempty"`
+	Tags        []string   `json:"tags,omitempty"`
 	Author      NoteAuthor `json:"author"`
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+	// Attachments is only set when they are included, a pointer so that a
+	// note without any is still rendered as an empty list
+	Attachments *[]AttachmentResponse `json:"attachments,omitempty"`
+	// Fieldset limits the keys that are rendered, all are when it is nil
+	Fieldset *Fieldset `json:"-" swaggerignore:"true"`
+}
+
+func (r NoteResponse) MarshalJSON() ([]byte, error) {
+	type response NoteResponse
+	return sparse(response(r), r.Fieldset)
 }
 
 type NotePaginationResponse struct {
@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+type SharePermission string
+
+const (
+	Viewer SharePermission = "viewer"
+	Editor SharePermission = "editor"
+)
+
+type NoteShare struct {
+	ID         uint            `gorm:"primarykey"`
+	NoteID     uint            `gorm:"not null;uniqueIndex:idx_note_shares_note_user"`
+	UserID     uint            `gorm:"not null;uniqueIndex:idx_note_shares_note_user"`
+	Permission SharePermission `gorm:"not null;default:'viewer'"`
+	Note       Note            `gorm:"foreignKey:NoteID;constraint:OnDelete:CASCADE"`
+	User       User            `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type NoteShareRequest struct {
+	NoteID     uint   `json:"note_id" params:"id"`
+	UserID     uint   `json:"user_id" params:"user_id" validate:"required"`
+	Permission string `json:"permission" validate:"required,oneof=viewer editor"`
+}
+
+type NoteShareResponse struct {
+	ID         uint       `json:"id"`
+	NoteID     uint       `json:"note_id"`
+	User       NoteAuthor `json:"user"`
+	Permission string     `json:"permission"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
@@ -0,0 +1,62 @@
+package domain
+
+import "time"
+
+type UploadStatus string
+
+const (
+	UploadPending UploadStatus = "pending"
+	UploadReady   UploadStatus = "ready"
+	UploadFailed  UploadStatus = "failed"
+)
+
+type Upload struct {
+	ID          uint         `gorm:"primarykey"`
+	UserID      uint         `gorm:"not null;index"`
+	Key         string       `gorm:"not null;uniqueIndex"`
+	ContentType string       `gorm:"not null"`
+	Size        int64        `gorm:"not null"`
+	Status      UploadStatus `gorm:"not null;default:'pending';index"`
+	Width       int
+	Height      int
+	User        User            `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	Variants    []UploadVariant `gorm:"constraint:OnDelete:CASCADE"`
+	CreatedAt   time.Time
+}
+
+// UploadVariant is a resized copy of an uploaded image, stored next to the
+// original under a key derived from it.
+type UploadVariant struct {
+	ID          uint   `gorm:"primarykey"`
+	UploadID    uint   `gorm:"not null;index"`
+	Name        string `gorm:"not null"`
+	Key         string `gorm:"not null;uniqueIndex"`
+	ContentType string `gorm:"not null"`
+	Size        int64  `gorm:"not null"`
+	Width       int    `gorm:"not null"`
+	Height      int    `gorm:"not null"`
+}
+
+type UploadRequest struct {
+	ID uint `json:"id" params:"id"`
+}
+
+type UploadResponse struct {
+	ID          uint      `json:"id"`
+	URL         string    `json:"url"`
+	Image       Image     `json:"image"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	Status      string    `json:"status"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Image lists the urls of an image in every size. Images that were not
+// uploaded here only have the original.
+type Image struct {
+	Original  string `json:"original"`
+	Large     string `json:"large,omitempty"`
+	Medium    string `json:"medium,omitempty"`
+	Small     string `json:"small,omitempty"`
+	Thumbnail string `json:"thumbnail,omitempty"`
+}
@@ -6,6 +6,13 @@ import (
 	"gorm.io/gorm"
 )
 
+type Role string
+
+const (
+	RoleUser  Role = "user"
+	RoleAdmin Role = "admin"
+)
+
 type User struct {
 	gorm.Model
 	Name         string `gorm:"not null;uniqueIndex"`
@@ -13,6 +20,7 @@ type User struct {
 	Bio          string
 	AvatarURL    string
 	Password     string `gorm:"not null"`
+	Role         Role   `gorm:"type:user_role;not null;default:'user'"`
 	RefreshToken RefreshToken
 	Notes        []Note
 }
@@ -30,21 +38,31 @@ type UserRequest struct {
 	Bio       string `json:"bio" validate:"omitempty,max=50"`
 	AvatarURL string `json:"avatar_url" validate:"omitempty,url,image"`
 	Password  string `json:"password" validate:"omitempty,min=8,max=100"`
+	// Role can only be changed from the command line
+	Role string `json:"-" validate:"omitempty,oneof=user admin"`
 }
 
 type UserQuery struct {
-	Name    string `query:"name"`
-	Details bool   `query:"details"`
+	Name     string   `query:"name"`
+	Filters  []Filter `query:"-" gorm:"-"`
+	Fieldset Fieldset `query:"-" gorm:"-"`
 }
 
 type UserResponse struct {
 	ID        uint       `json:"id"`
 	Name      string     `json:"name"`
 	Bio       string     `json:"bio,omitempty"`
-	AvatarURL string     `json:"avatar_url,omitempty"`
+	Avatar    *Image     `json:"avatar,omitempty"`
 	CreatedAt time.Time  `json:"created_at"`
 	UpdatedAt time.Time  `json:"updated_at"`
 	UserToken *UserToken `json:"tokens,omitempty"`
+	// Fieldset limits the keys that are rendered, all are when it is nil
+	Fieldset *Fieldset `json:"-" swaggerignore:"true"`
+}
+
+func (r UserResponse) MarshalJSON() ([]byte, error) {
+	type response UserResponse
+	return sparse(response(r), r.Fieldset)
 }
 
 type UserPaginationResponse struct {
@@ -0,0 +1,33 @@
+package port
+
+import (
+	"context"
+	"io"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type AttachmentRepository interface {
+	Create(ctx context.Context, attachment *domain.Attachment, quota int64) error
+	GetAll(ctx context.Context, noteID uint) ([]domain.Attachment, error)
+	GetByID(ctx context.Context, id uint) (*domain.Attachment, error)
+	GetOrphaned(ctx context.Context, limit int) ([]domain.Attachment, error)
+	Delete(ctx context.Context, attachment *domain.Attachment) error
+}
+
+type AttachmentService interface {
+	Create(ctx context.Context, noteID uint, name string, contentType string, file io.Reader, size int64, claims domain.Claims) (*domain.Attachment, error)
+	GetAll(ctx context.Context, noteID uint, claims *domain.Claims) ([]domain.Attachment, error)
+	Open(ctx context.Context, noteID uint, id uint, claims *domain.Claims) (*domain.Attachment, io.ReadCloser, error)
+	Delete(ctx context.Context, noteID uint, id uint, claims domain.Claims) error
+	Purge(ctx context.Context) (int64, error)
+}
+
+type AttachmentHandler interface {
+	Create(ctx *fiber.Ctx) error
+	GetAll(ctx *fiber.Ctx) error
+	Download(ctx *fiber.Ctx) error
+	Delete(ctx *fiber.Ctx) error
+}
@@ -1,23 +1,28 @@
 package port
 
 import (
+	"context"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/shironxn/blanknotes/internal/core/domain"
 )
 
 type AuthRepository interface {
-	Register(req domain.AuthRegisterRequest) (*domain.User, error)
-	GetByEmail(email string) (*domain.User, error)
-	GetRefreshToken(userID uint) (*domain.RefreshToken, error)
-	StoreRefreshToken(userID uint, token string) error
-	DeleteRefreshToken(entity domain.RefreshToken) error
+	Register(ctx context.Context, req domain.AuthRegisterRequest) (*domain.User, error)
+	GetByEmail(ctx context.Context, email string) (*domain.User, error)
+	GetDeletedByEmail(ctx context.Context, email string) (*domain.User, error)
+	Restore(ctx context.Context, user *domain.User) (*domain.User, error)
+	GetRefreshToken(ctx context.Context, userID uint) (*domain.RefreshToken, error)
+	StoreRefreshToken(ctx context.Context, userID uint, token string) error
+	DeleteRefreshToken(ctx context.Context, entity domain.RefreshToken) error
 }
 
 type AuthService interface {
-	Register(req domain.AuthRegisterRequest) (*domain.User, error)
-	Login(req domain.AuthLoginRequest) (*domain.User, *domain.UserToken, error)
-	Logout(userID uint) error
-	Refresh(token string) (*string, *domain.Claims, error)
+	Register(ctx context.Context, req domain.AuthRegisterRequest) (*domain.User, error)
+	Login(ctx context.Context, req domain.AuthLoginRequest) (*domain.User, *domain.UserToken, error)
+	Logout(ctx context.Context, userID uint) error
+	Refresh(ctx context.Context, token string) (*string, *domain.Claims, error)
+	Restore(ctx context.Context, req domain.AuthLoginRequest) (*domain.User, error)
 }
 
 type AuthHandler interface {
@@ -25,4 +30,5 @@ type AuthHandler interface {
 	Login(ctx *fiber.Ctx) error
 	Logout(ctx *fiber.Ctx) error
 	Refresh(ctx *fiber.Ctx) error
+	Restore(ctx *fiber.Ctx) error
 }
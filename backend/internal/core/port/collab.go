@@ -0,0 +1,21 @@
+package port
+
+import (
+	"context"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CollabService interface {
+	Worker
+	Join(ctx context.Context, note *domain.Note, userID uint, editable bool) (*domain.CollabClient, error)
+	Receive(client *domain.CollabClient, msg domain.CollabMessage)
+	Leave(client *domain.CollabClient)
+}
+
+type CollabHandler interface {
+	Upgrade(ctx *fiber.Ctx) error
+	Connect() fiber.Handler
+}
@@ -0,0 +1,17 @@
+package port
+
+import (
+	"github.com/shironxn/blanknotes/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type EventService interface {
+	Publish(eventType domain.NoteEventType, note domain.Note, sharedWith []uint)
+	Subscribe(userID uint, lastEventID uint64) (*domain.NoteSubscription, []domain.NoteEvent)
+	Unsubscribe(subscription *domain.NoteSubscription)
+}
+
+type EventHandler interface {
+	Stream(ctx *fiber.Ctx) error
+}
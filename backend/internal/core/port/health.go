@@ -0,0 +1,19 @@
+package port
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// HealthCheck tells whether a dependency is ready to serve requests. Check
+// returns nil when it is and should give up when ctx is done.
+type HealthCheck interface {
+	Check(ctx context.Context) error
+}
+
+type HealthHandler interface {
+	Live(ctx *fiber.Ctx) error
+	Ready(ctx *fiber.Ctx) error
+	Version(ctx *fiber.Ctx) error
+}
@@ -0,0 +1,15 @@
+package port
+
+import (
+	"io"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+)
+
+// Importer reads the notes of an export made by another app. Notes are handed
+// to yield one at a time so large exports never have to be held in memory.
+type Importer interface {
+	Format() string
+	Detect(filename string, file io.ReaderAt, size int64) bool
+	Import(filename string, file io.ReaderAt, size int64, yield func(note domain.ImportedNote) error) error
+}
@@ -0,0 +1,31 @@
+package port
+
+import (
+	"context"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ShareLinkRepository interface {
+	Create(ctx context.Context, req domain.ShareLinkRequest) (*domain.ShareLink, error)
+	GetAll(ctx context.Context, noteID uint) ([]domain.ShareLink, error)
+	GetByID(ctx context.Context, id uint) (*domain.ShareLink, error)
+	GetByToken(ctx context.Context, token string) (*domain.ShareLink, error)
+	Delete(ctx context.Context, link *domain.ShareLink) error
+}
+
+type ShareLinkService interface {
+	Create(ctx context.Context, req domain.ShareLinkRequest, claims domain.Claims) (*domain.ShareLink, error)
+	GetAll(ctx context.Context, noteID uint, claims domain.Claims) ([]domain.ShareLink, error)
+	Delete(ctx context.Context, noteID uint, id uint, claims domain.Claims) error
+	Open(ctx context.Context, token string, password string) (*domain.Note, error)
+}
+
+type ShareLinkHandler interface {
+	Create(ctx *fiber.Ctx) error
+	GetAll(ctx *fiber.Ctx) error
+	Delete(ctx *fiber.Ctx) error
+	Open(ctx *fiber.Ctx) error
+}
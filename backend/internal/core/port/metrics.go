@@ -0,0 +1,8 @@
+package port
+
+// Metrics counts what the services do. Requests are counted by the HTTP
+// middleware of the metrics adapter.
+type Metrics interface {
+	Login(succeeded bool)
+	NoteCreated()
+}
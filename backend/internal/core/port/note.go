@@ -1,25 +1,43 @@
 package port
 
 import (
+	"context"
+	"time"
+
 	"github.com/shironxn/blanknotes/internal/core/domain"
 
 	"github.com/gofiber/fiber/v2"
 )
 
 type NoteRepository interface {
-	Create(req domain.NoteRequest) (*domain.Note, error)
-	GetAll(req domain.NoteQuery, metdata *domain.Metadata) ([]domain.Note, error)
-	GetByID(id uint) (*domain.Note, error)
-	Update(req domain.NoteUpdateRequest, note *domain.Note) (*domain.Note, error)
-	Delete(note *domain.Note) error
+	Create(ctx context.Context, req domain.NoteRequest) (*domain.Note, error)
+	GetAll(ctx context.Context, req domain.NoteQuery, metdata *domain.Metadata) ([]domain.Note, error)
+	GetByID(ctx context.Context, id uint) (*domain.Note, error)
+	GetByTitle(ctx context.Context, userID uint, title string) (*domain.Note, error)
+	Update(ctx context.Context, req domain.NoteUpdateRequest, note *domain.Note) (*domain.Note, error)
+	Delete(ctx context.Context, note *domain.Note) error
+	GetTrash(ctx context.Context, req domain.NoteQuery, metadata *domain.Metadata) ([]domain.Note, error)
+	GetTrashByID(ctx context.Context, id uint) (*domain.Note, error)
+	Restore(ctx context.Context, note *domain.Note) (*domain.Note, error)
+	ForceDelete(ctx context.Context, note *domain.Note) error
+	Purge(ctx context.Context, before time.Time) (int64, []string, error)
+	Export(ctx context.Context, userID uint, batch func(notes []domain.Note) error) error
 }
 
 type NoteService interface {
-	Create(req domain.NoteRequest) (*domain.Note, error)
-	GetAll(req domain.NoteQuery, metadata *domain.Metadata) ([]domain.Note, error)
-	GetByID(id uint, claims *domain.Claims) (*domain.Note, error)
-	Update(req domain.NoteUpdateRequest, claims domain.Claims) (*domain.Note, error)
-	Delete(id uint, claims domain.Claims) error
+	Create(ctx context.Context, req domain.NoteRequest) (*domain.Note, error)
+	GetAll(ctx context.Context, req domain.NoteQuery, metadata *domain.Metadata) ([]domain.Note, error)
+	GetByID(ctx context.Context, id uint, claims *domain.Claims) (*domain.Note, error)
+	GetEditable(ctx context.Context, id uint, claims domain.Claims) (*domain.Note, error)
+	Render(note *domain.Note) (string, error)
+	Update(ctx context.Context, req domain.NoteUpdateRequest, claims domain.Claims) (*domain.Note, error)
+	Delete(ctx context.Context, id uint, claims domain.Claims) error
+	GetTrash(ctx context.Context, metadata *domain.Metadata, claims domain.Claims) ([]domain.Note, error)
+	Restore(ctx context.Context, id uint, claims domain.Claims) (*domain.Note, error)
+	ForceDelete(ctx context.Context, id uint, claims domain.Claims) error
+	Purge(ctx context.Context, retention time.Duration) (int64, error)
+	Export(ctx context.Context, claims domain.Claims, batch func(notes []domain.Note) error) error
+	Import(ctx context.Context, req domain.NoteRequest, conflict domain.ImportConflict) (*domain.Note, string, error)
 }
 
 type NoteHandler interface {
@@ -28,4 +46,9 @@ type NoteHandler interface {
 	GetByID(ctx *fiber.Ctx) error
 	Update(ctx *fiber.Ctx) error
 	Delete(ctx *fiber.Ctx) error
+	GetTrash(ctx *fiber.Ctx) error
+	Restore(ctx *fiber.Ctx) error
+	ForceDelete(ctx *fiber.Ctx) error
+	Export(ctx *fiber.Ctx) error
+	Import(ctx *fiber.Ctx) error
 }
@@ -0,0 +1,31 @@
+package port
+
+import (
+	"context"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type NoteShareRepository interface {
+	GetAll(ctx context.Context, noteID uint) ([]domain.NoteShare, error)
+	Get(ctx context.Context, noteID uint, userID uint) (*domain.NoteShare, error)
+	Save(ctx context.Context, req domain.NoteShareRequest) (*domain.NoteShare, error)
+	Delete(ctx context.Context, share *domain.NoteShare) error
+	GetShared(ctx context.Context, userID uint, metadata *domain.Metadata) ([]domain.Note, error)
+}
+
+type NoteShareService interface {
+	GetAll(ctx context.Context, noteID uint, claims domain.Claims) ([]domain.NoteShare, error)
+	Save(ctx context.Context, req domain.NoteShareRequest, claims domain.Claims) (*domain.NoteShare, error)
+	Delete(ctx context.Context, noteID uint, userID uint, claims domain.Claims) error
+	GetShared(ctx context.Context, metadata *domain.Metadata, claims domain.Claims) ([]domain.Note, error)
+}
+
+type NoteShareHandler interface {
+	GetAll(ctx *fiber.Ctx) error
+	Save(ctx *fiber.Ctx) error
+	Delete(ctx *fiber.Ctx) error
+	GetShared(ctx *fiber.Ctx) error
+}
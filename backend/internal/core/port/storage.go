@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+	"io"
+)
+
+// Storage keeps uploaded files under slash separated keys.
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,37 @@
+package port
+
+import (
+	"context"
+	"io"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type UploadRepository interface {
+	Create(ctx context.Context, upload *domain.Upload) error
+	GetByID(ctx context.Context, id uint) (*domain.Upload, error)
+	GetByKey(ctx context.Context, key string) (*domain.Upload, error)
+	GetByKeys(ctx context.Context, keys []string) ([]domain.Upload, error)
+	GetVariantByKey(ctx context.Context, key string) (*domain.UploadVariant, error)
+	GetPending(ctx context.Context, limit int) ([]domain.Upload, error)
+	Update(ctx context.Context, upload *domain.Upload) error
+	Delete(ctx context.Context, upload *domain.Upload) error
+}
+
+type UploadService interface {
+	Create(ctx context.Context, file io.Reader, size int64, claims domain.Claims) (*domain.Upload, error)
+	Open(ctx context.Context, key string) (*domain.Upload, io.ReadCloser, error)
+	Delete(ctx context.Context, id uint, claims domain.Claims) error
+	URL(upload *domain.Upload) string
+	Process(ctx context.Context, id uint) error
+	Pending(ctx context.Context) ([]uint, error)
+	Queue() <-chan uint
+}
+
+type UploadHandler interface {
+	Create(ctx *fiber.Ctx) error
+	Serve(ctx *fiber.Ctx) error
+	Delete(ctx *fiber.Ctx) error
+}
@@ -1,23 +1,28 @@
 package port
 
 import (
+	"context"
+	"time"
+
 	"github.com/shironxn/blanknotes/internal/core/domain"
 
 	"github.com/gofiber/fiber/v2"
 )
 
 type UserRepository interface {
-	GetAll(req domain.UserQuery, metdata *domain.Metadata) ([]domain.User, error)
-	GetByID(id uint) (*domain.User, error)
-	Update(req domain.UserRequest, user *domain.User) (*domain.User, error)
-	Delete(user *domain.User) error
+	GetAll(ctx context.Context, req domain.UserQuery, metdata *domain.Metadata) ([]domain.User, error)
+	GetByID(ctx context.Context, id uint) (*domain.User, error)
+	Update(ctx context.Context, req domain.UserRequest, user *domain.User) (*domain.User, error)
+	Delete(ctx context.Context, user *domain.User) error
+	Purge(ctx context.Context, before time.Time) (int64, []string, error)
 }
 
 type UserService interface {
-	GetAll(req domain.UserQuery, metdata *domain.Metadata) ([]domain.User, error)
-	GetByID(id uint) (*domain.User, error)
-	Update(req domain.UserRequest, claims domain.Claims) (*domain.User, error)
-	Delete(req domain.UserRequest, claims domain.Claims) error
+	GetAll(ctx context.Context, req domain.UserQuery, metdata *domain.Metadata) ([]domain.User, error)
+	GetByID(ctx context.Context, id uint) (*domain.User, error)
+	Update(ctx context.Context, req domain.UserRequest, claims domain.Claims) (*domain.User, error)
+	Delete(ctx context.Context, req domain.UserRequest, claims domain.Claims) error
+	Purge(ctx context.Context, gracePeriod time.Duration) (int64, error)
 }
 
 type UserHandler interface {
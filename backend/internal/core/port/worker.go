@@ -0,0 +1,6 @@
+package port
+
+type Worker interface {
+	Start()
+	Stop()
+}
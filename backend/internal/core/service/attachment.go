@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"path"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const maxAttachmentName = 255
+
+type AttachmentService struct {
+	repository  port.AttachmentRepository
+	noteService port.NoteService
+	storage     port.Storage
+	cfg         *config.Config
+}
+
+func NewAttachmentService(repository port.AttachmentRepository, noteService port.NoteService, storage port.Storage, cfg *config.Config) port.AttachmentService {
+	return &AttachmentService{
+		repository:  repository,
+		noteService: noteService,
+		storage:     storage,
+		cfg:         cfg,
+	}
+}
+
+// Create attaches a file to a note the user can edit. The size counts towards
+// the quota of the user who uploads it, not the owner of the note.
+func (s *AttachmentService) Create(ctx context.Context, noteID uint, name string, contentType string, file io.Reader, size int64, claims domain.Claims) (*domain.Attachment, error) {
+	if _, err := s.noteService.GetEditable(ctx, noteID, claims); err != nil {
+		return nil, err
+	}
+
+	if size > s.cfg.Attachments.MaxSize {
+		return nil, fiber.NewError(fiber.StatusRequestEntityTooLarge, fmt.Sprintf("file must not be larger than %d bytes", s.cfg.Attachments.MaxSize))
+	}
+
+	token, err := util.GenerateToken(16)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := &domain.Attachment{
+		NoteID:      noteID,
+		UserID:      claims.UserID,
+		Key:         fmt.Sprintf("attachments/%d/%s", noteID, token),
+		Name:        attachmentName(name),
+		ContentType: attachmentType(contentType),
+		Size:        size,
+	}
+
+	if err := s.storage.Put(ctx, attachment.Key, file, size, attachment.ContentType); err != nil {
+		return nil, err
+	}
+
+	// the quota is checked when the row is saved, so concurrent uploads cannot
+	// both fit in what is left of it
+	if err := s.repository.Create(ctx, attachment, s.cfg.Attachments.Quota); err != nil {
+		// the file goes even when the query failed because ctx is done
+		if err := s.storage.Delete(context.WithoutCancel(ctx), attachment.Key); err != nil {
+			util.Logger(ctx).Warn("failed to delete an unsaved file", "key", attachment.Key, "err", err)
+		}
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+// GetAll lists the attachments of a note, anyone who can read the note can
+// read its attachments.
+func (s *AttachmentService) GetAll(ctx context.Context, noteID uint, claims *domain.Claims) ([]domain.Attachment, error) {
+	if _, err := s.noteService.GetByID(ctx, noteID, claims); err != nil {
+		return nil, err
+	}
+
+	return s.repository.GetAll(ctx, noteID)
+}
+
+func (s *AttachmentService) Open(ctx context.Context, noteID uint, id uint, claims *domain.Claims) (*domain.Attachment, io.ReadCloser, error) {
+	if _, err := s.noteService.GetByID(ctx, noteID, claims); err != nil {
+		return nil, nil, err
+	}
+
+	attachment, err := s.get(ctx, noteID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file, err := s.storage.Get(ctx, attachment.Key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attachment, file, nil
+}
+
+func (s *AttachmentService) Delete(ctx context.Context, noteID uint, id uint, claims domain.Claims) error {
+	if _, err := s.noteService.GetEditable(ctx, noteID, claims); err != nil {
+		return err
+	}
+
+	attachment, err := s.get(ctx, noteID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repository.Delete(ctx, attachment); err != nil {
+		return err
+	}
+
+	return s.storage.Delete(ctx, attachment.Key)
+}
+
+// Purge removes the files and rows of attachments whose note is gone.
+func (s *AttachmentService) Purge(ctx context.Context) (int64, error) {
+	attachments, err := s.repository.GetOrphaned(ctx, 100)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	for i := range attachments {
+		if err := s.storage.Delete(ctx, attachments[i].Key); err != nil {
+			return count, err
+		}
+		if err := s.repository.Delete(ctx, &attachments[i]); err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+func (s *AttachmentService) get(ctx context.Context, noteID uint, id uint) (*domain.Attachment, error) {
+	attachment, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if attachment.NoteID != noteID {
+		return nil, fiber.NewError(fiber.StatusNotFound, "attachment not found")
+	}
+
+	return attachment, nil
+}
+
+// attachmentName keeps only the base name of an uploaded file without control
+// characters, it ends up in the Content-Disposition header of downloads.
+func attachmentName(name string) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.TrimSpace(strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || r == utf8.RuneError {
+			return -1
+		}
+		return r
+	}, name))
+
+	for len(name) > maxAttachmentName {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+
+	if name == "" || name == "." || name == "/" {
+		return "attachment"
+	}
+
+	return name
+}
+
+// attachmentType keeps the media type sent by the client. Downloads are never
+// displayed inline, so it does not need to be trusted.
+func attachmentType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil || mediaType == "" {
+		return "application/octet-stream"
+	}
+
+	return mediaType
+}
@@ -0,0 +1,323 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/mocks"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var attachmentEntity = &domain.Attachment{
+	ID:          1,
+	NoteID:      1,
+	UserID:      1,
+	Key:         "attachments/1/c2hpcm9u",
+	Name:        "report.pdf",
+	ContentType: "application/pdf",
+	Size:        4,
+}
+
+func TestAttachmentService_Create(t *testing.T) {
+	type fields struct {
+		repository  port.AttachmentRepository
+		noteService port.NoteService
+		storage     port.Storage
+	}
+
+	type args struct {
+		name        string
+		contentType string
+		size        int64
+		claims      domain.Claims
+	}
+
+	mockAttachmentRepository := mocks.NewAttachmentRepository(t)
+	mockNoteService := mocks.NewNoteService(t)
+	mockStorage := mocks.NewStorage(t)
+
+	cfg := &config.Config{}
+	cfg.Attachments.MaxSize = 1 << 10
+	cfg.Attachments.Quota = 1 << 12
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				repository: func() port.AttachmentRepository {
+					mockAttachmentRepository.EXPECT().Create(mock.Anything, mock.AnythingOfType("*domain.Attachment"), int64(1<<12)).Return(nil).Once()
+					return mockAttachmentRepository
+				}(),
+				noteService: func() port.NoteService {
+					mockNoteService.EXPECT().GetEditable(mock.Anything, noteEntity.ID, mock.AnythingOfType("domain.Claims")).Return(noteEntity, nil).Once()
+					return mockNoteService
+				}(),
+				storage: func() port.Storage {
+					mockStorage.EXPECT().Put(mock.Anything, mock.MatchedBy(func(key string) bool {
+						return strings.HasPrefix(key, "attachments/1/")
+					}), mock.Anything, int64(4), "application/pdf").Return(nil).Once()
+					return mockStorage
+				}(),
+			},
+			args: args{
+				name:        "../../reports/report.pdf",
+				contentType: "application/pdf; name=report.pdf",
+				size:        4,
+				claims: domain.Claims{
+					UserID: 1,
+				},
+			},
+			want:    "report.pdf",
+			wantErr: false,
+		},
+		{
+			name: "quota exceeded",
+			fields: fields{
+				repository: func() port.AttachmentRepository {
+					mockAttachmentRepository.EXPECT().Create(mock.Anything, mock.AnythingOfType("*domain.Attachment"), int64(1<<12)).Return(fiber.NewError(fiber.StatusRequestEntityTooLarge, "storage quota exceeded, 4000 of 4096 bytes used")).Once()
+					return mockAttachmentRepository
+				}(),
+				noteService: func() port.NoteService {
+					mockNoteService.EXPECT().GetEditable(mock.Anything, noteEntity.ID, mock.AnythingOfType("domain.Claims")).Return(noteEntity, nil).Once()
+					return mockNoteService
+				}(),
+				storage: func() port.Storage {
+					mockStorage.EXPECT().Put(mock.Anything, mock.Anything, mock.Anything, int64(1000), mock.Anything).Return(nil).Once()
+					mockStorage.EXPECT().Delete(mock.Anything, mock.MatchedBy(func(key string) bool {
+						return strings.HasPrefix(key, "attachments/1/")
+					})).Return(nil).Once()
+					return mockStorage
+				}(),
+			},
+			args: args{
+				name: "report.pdf",
+				size: 1000,
+				claims: domain.Claims{
+					UserID: 1,
+				},
+			},
+			want:    errors.New("storage quota exceeded, 4000 of 4096 bytes used"),
+			wantErr: true,
+		},
+		{
+			name: "file too large",
+			fields: fields{
+				repository: mockAttachmentRepository,
+				noteService: func() port.NoteService {
+					mockNoteService.EXPECT().GetEditable(mock.Anything, noteEntity.ID, mock.AnythingOfType("domain.Claims")).Return(noteEntity, nil).Once()
+					return mockNoteService
+				}(),
+				storage: mockStorage,
+			},
+			args: args{
+				name: "report.pdf",
+				size: 1 << 11,
+			},
+			want:    errors.New("file must not be larger than 1024 bytes"),
+			wantErr: true,
+		},
+		{
+			name: "note not editable",
+			fields: fields{
+				repository: mockAttachmentRepository,
+				noteService: func() port.NoteService {
+					mockNoteService.EXPECT().GetEditable(mock.Anything, noteEntity.ID, mock.AnythingOfType("domain.Claims")).Return(nil, fiber.NewError(fiber.StatusForbidden, "user does not have permission to perform this action")).Once()
+					return mockNoteService
+				}(),
+				storage: mockStorage,
+			},
+			args: args{
+				name: "report.pdf",
+				size: 4,
+				claims: domain.Claims{
+					UserID: 2,
+				},
+			},
+			want:    errors.New("user does not have permission to perform this action"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &AttachmentService{
+				repository:  tt.fields.repository,
+				noteService: tt.fields.noteService,
+				storage:     tt.fields.storage,
+				cfg:         cfg,
+			}
+
+			got, err := s.Create(context.Background(), noteEntity.ID, tt.args.name, tt.args.contentType, strings.NewReader("%PDF"), tt.args.size, tt.args.claims)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.want.(error).Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got.Name)
+				assert.Equal(t, "application/pdf", got.ContentType)
+			}
+		})
+	}
+}
+
+func TestAttachmentService_Open(t *testing.T) {
+	type fields struct {
+		repository  port.AttachmentRepository
+		noteService port.NoteService
+		storage     port.Storage
+	}
+
+	type args struct {
+		noteID uint
+		id     uint
+	}
+
+	mockAttachmentRepository := mocks.NewAttachmentRepository(t)
+	mockNoteService := mocks.NewNoteService(t)
+	mockStorage := mocks.NewStorage(t)
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				repository: func() port.AttachmentRepository {
+					mockAttachmentRepository.EXPECT().GetByID(mock.Anything, attachmentEntity.ID).Return(attachmentEntity, nil).Once()
+					return mockAttachmentRepository
+				}(),
+				noteService: func() port.NoteService {
+					mockNoteService.EXPECT().GetByID(mock.Anything, noteEntity.ID, (*domain.Claims)(nil)).Return(noteEntity, nil).Once()
+					return mockNoteService
+				}(),
+				storage: func() port.Storage {
+					mockStorage.EXPECT().Get(mock.Anything, attachmentEntity.Key).Return(io.NopCloser(bytes.NewReader([]byte("%PDF"))), nil).Once()
+					return mockStorage
+				}(),
+			},
+			args: args{
+				noteID: noteEntity.ID,
+				id:     attachmentEntity.ID,
+			},
+			want:    attachmentEntity,
+			wantErr: false,
+		},
+		{
+			name: "private note",
+			fields: fields{
+				repository: mockAttachmentRepository,
+				noteService: func() port.NoteService {
+					mockNoteService.EXPECT().GetByID(mock.Anything, uint(2), (*domain.Claims)(nil)).Return(nil, fiber.NewError(fiber.StatusUnauthorized, "you are not authorized to access this private note")).Once()
+					return mockNoteService
+				}(),
+				storage: mockStorage,
+			},
+			args: args{
+				noteID: 2,
+				id:     attachmentEntity.ID,
+			},
+			want:    errors.New("you are not authorized to access this private note"),
+			wantErr: true,
+		},
+		{
+			name: "attachment of another note",
+			fields: fields{
+				repository: func() port.AttachmentRepository {
+					mockAttachmentRepository.EXPECT().GetByID(mock.Anything, attachmentEntity.ID).Return(attachmentEntity, nil).Once()
+					return mockAttachmentRepository
+				}(),
+				noteService: func() port.NoteService {
+					mockNoteService.EXPECT().GetByID(mock.Anything, uint(3), (*domain.Claims)(nil)).Return(noteEntity, nil).Once()
+					return mockNoteService
+				}(),
+				storage: mockStorage,
+			},
+			args: args{
+				noteID: 3,
+				id:     attachmentEntity.ID,
+			},
+			want:    errors.New("attachment not found"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &AttachmentService{
+				repository:  tt.fields.repository,
+				noteService: tt.fields.noteService,
+				storage:     tt.fields.storage,
+			}
+
+			got, file, err := s.Open(context.Background(), tt.args.noteID, tt.args.id, nil)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.want.(error).Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				file.Close()
+			}
+		})
+	}
+}
+
+func TestAttachmentService_Purge(t *testing.T) {
+	mockAttachmentRepository := mocks.NewAttachmentRepository(t)
+	mockStorage := mocks.NewStorage(t)
+
+	mockAttachmentRepository.EXPECT().GetOrphaned(mock.Anything, 100).Return([]domain.Attachment{*attachmentEntity}, nil).Once()
+	mockStorage.EXPECT().Delete(mock.Anything, attachmentEntity.Key).Return(nil).Once()
+	mockAttachmentRepository.EXPECT().Delete(mock.Anything, mock.AnythingOfType("*domain.Attachment")).Return(nil).Once()
+
+	s := &AttachmentService{
+		repository: mockAttachmentRepository,
+		storage:    mockStorage,
+	}
+
+	count, err := s.Purge(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestAttachmentName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "report.pdf", want: "report.pdf"},
+		{name: `C:\Users\shiro\report.pdf`, want: "report.pdf"},
+		{name: "../../etc/passwd", want: "passwd"},
+		{name: "  laporan\r\n bulanan.xlsx ", want: "laporan bulanan.xlsx"},
+		{name: "", want: "attachment"},
+		{name: strings.Repeat("é", 200), want: strings.Repeat("é", 127)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, attachmentName(tt.name))
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package service
 
 import (
+	"context"
+	"time"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/shironxn/blanknotes/internal/config"
 	"github.com/shironxn/blanknotes/internal/core/domain"
@@ -12,19 +15,21 @@ type AuthService struct {
 	repository port.AuthRepository
 	bcrypt     util.Bcrypt
 	jwt        util.JWT
+	metrics    port.Metrics
 	cfg        *config.Config
 }
 
-func NewAuthService(repository port.AuthRepository, bcrypt util.Bcrypt, jwt util.JWT, cfg *config.Config) port.AuthService {
+func NewAuthService(repository port.AuthRepository, bcrypt util.Bcrypt, jwt util.JWT, metrics port.Metrics, cfg *config.Config) port.AuthService {
 	return &AuthService{
 		repository: repository,
 		bcrypt:     bcrypt,
 		jwt:        jwt,
+		metrics:    metrics,
 		cfg:        cfg,
 	}
 }
 
-func (s *AuthService) Register(req domain.AuthRegisterRequest) (*domain.User, error) {
+func (s *AuthService) Register(ctx context.Context, req domain.AuthRegisterRequest) (*domain.User, error) {
 	hashedPassword, err := s.bcrypt.HashPassword(req.Password)
 	if err != nil {
 		return nil, err
@@ -32,16 +37,18 @@ func (s *AuthService) Register(req domain.AuthRegisterRequest) (*domain.User, er
 
 	req.Password = string(hashedPassword)
 
-	return s.repository.Register(req)
+	return s.repository.Register(ctx, req)
 }
 
-func (s *AuthService) Login(req domain.AuthLoginRequest) (*domain.User, *domain.UserToken, error) {
-	user, err := s.repository.GetByEmail(req.Email)
+func (s *AuthService) Login(ctx context.Context, req domain.AuthLoginRequest) (*domain.User, *domain.UserToken, error) {
+	user, err := s.repository.GetByEmail(ctx, req.Email)
 	if err != nil {
+		s.metrics.Login(false)
 		return nil, nil, err
 	}
 
 	if err := s.bcrypt.ComparePassword(req.Password, []byte(user.Password)); err != nil {
+		s.metrics.Login(false)
 		return nil, nil, fiber.NewError(fiber.StatusUnauthorized, "invalid password")
 	}
 
@@ -55,10 +62,12 @@ func (s *AuthService) Login(req domain.AuthLoginRequest) (*domain.User, *domain.
 		return nil, nil, err
 	}
 
-	if err := s.repository.StoreRefreshToken(user.ID, refreshToken); err != nil {
+	if err := s.repository.StoreRefreshToken(ctx, user.ID, refreshToken); err != nil {
 		return nil, nil, err
 	}
 
+	s.metrics.Login(true)
+
 	return user, &domain.UserToken{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
@@ -66,22 +75,22 @@ func (s *AuthService) Login(req domain.AuthLoginRequest) (*domain.User, *domain.
 	}, nil
 }
 
-func (s *AuthService) Logout(userID uint) error {
-	refresh, err := s.repository.GetRefreshToken(userID)
+func (s *AuthService) Logout(ctx context.Context, userID uint) error {
+	refresh, err := s.repository.GetRefreshToken(ctx, userID)
 	if err != nil {
 		return err
 	}
 
-	return s.repository.DeleteRefreshToken(*refresh)
+	return s.repository.DeleteRefreshToken(ctx, *refresh)
 }
 
-func (s *AuthService) Refresh(token string) (*string, *domain.Claims, error) {
+func (s *AuthService) Refresh(ctx context.Context, token string) (*string, *domain.Claims, error) {
 	claims, err := s.jwt.ValidateToken(token, s.cfg.JWT.Refresh)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	refresh, err := s.repository.GetRefreshToken(claims.UserID)
+	refresh, err := s.repository.GetRefreshToken(ctx, claims.UserID)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -97,3 +106,20 @@ func (s *AuthService) Refresh(token string) (*string, *domain.Claims, error) {
 
 	return &accessToken, claims, nil
 }
+
+func (s *AuthService) Restore(ctx context.Context, req domain.AuthLoginRequest) (*domain.User, error) {
+	user, err := s.repository.GetDeletedByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.bcrypt.ComparePassword(req.Password, []byte(user.Password)); err != nil {
+		return nil, fiber.NewError(fiber.StatusUnauthorized, "invalid password")
+	}
+
+	if time.Since(user.DeletedAt.Time) > s.cfg.Trash.UserGracePeriod {
+		return nil, fiber.NewError(fiber.StatusGone, "user grace period has expired")
+	}
+
+	return s.repository.Restore(ctx, user)
+}
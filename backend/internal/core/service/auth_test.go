@@ -1,8 +1,10 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/shironxn/blanknotes/internal/config"
 	"github.com/shironxn/blanknotes/internal/core/domain"
@@ -52,7 +54,7 @@ func TestUserService_Register(t *testing.T) {
 			name: "success",
 			fields: fields{
 				repository: func() port.AuthRepository {
-					mockAuthRepository.EXPECT().Register(mock.AnythingOfType("domain.AuthRegisterRequest")).Return(authEntity, nil).Once()
+					mockAuthRepository.EXPECT().Register(mock.Anything, mock.AnythingOfType("domain.AuthRegisterRequest")).Return(authEntity, nil).Once()
 					return mockAuthRepository
 				}(),
 				bcrypt: bcrypt,
@@ -72,7 +74,7 @@ func TestUserService_Register(t *testing.T) {
 				bcrypt:     tt.fields.bcrypt,
 			}
 
-			got, err := h.Register(tt.args.req)
+			got, err := h.Register(context.Background(), tt.args.req)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -90,6 +92,7 @@ func TestUserService_Login(t *testing.T) {
 		repository port.AuthRepository
 		bcrypt     util.Bcrypt
 		jwt        util.JWT
+		metrics    port.Metrics
 	}
 
 	type args struct {
@@ -97,6 +100,7 @@ func TestUserService_Login(t *testing.T) {
 	}
 
 	mockAuthRepository := mocks.NewAuthRepository(t)
+	mockMetrics := mocks.NewMetrics(t)
 	bcrypt := util.NewBcrypt()
 	jwt := util.NewJWT(&config.Config{})
 
@@ -111,12 +115,16 @@ func TestUserService_Login(t *testing.T) {
 			name: "success",
 			fields: fields{
 				repository: func() port.AuthRepository {
-					mockAuthRepository.EXPECT().GetByEmail(mock.AnythingOfType("string")).Return(authEntity, nil).Once()
-					mockAuthRepository.EXPECT().StoreRefreshToken(mock.AnythingOfType("uint"), mock.AnythingOfType("string")).Return(nil).Once()
+					mockAuthRepository.EXPECT().GetByEmail(mock.Anything, mock.AnythingOfType("string")).Return(authEntity, nil).Once()
+					mockAuthRepository.EXPECT().StoreRefreshToken(mock.Anything, mock.AnythingOfType("uint"), mock.AnythingOfType("string")).Return(nil).Once()
 					return mockAuthRepository
 				}(),
 				bcrypt: bcrypt,
 				jwt:    jwt,
+				metrics: func() port.Metrics {
+					mockMetrics.EXPECT().Login(true).Once()
+					return mockMetrics
+				}(),
 			},
 			args: args{
 				req: domain.AuthLoginRequest{
@@ -131,10 +139,14 @@ func TestUserService_Login(t *testing.T) {
 			name: "invalid password",
 			fields: fields{
 				repository: func() port.AuthRepository {
-					mockAuthRepository.EXPECT().GetByEmail(mock.AnythingOfType("string")).Return(authEntity, nil).Once()
+					mockAuthRepository.EXPECT().GetByEmail(mock.Anything, mock.AnythingOfType("string")).Return(authEntity, nil).Once()
 					return mockAuthRepository
 				}(),
 				bcrypt: bcrypt,
+				metrics: func() port.Metrics {
+					mockMetrics.EXPECT().Login(false).Once()
+					return mockMetrics
+				}(),
 			},
 			args: args{
 				req: domain.AuthLoginRequest{
@@ -153,9 +165,10 @@ func TestUserService_Login(t *testing.T) {
 				repository: tt.fields.repository,
 				bcrypt:     tt.fields.bcrypt,
 				jwt:        tt.fields.jwt,
+				metrics:    tt.fields.metrics,
 			}
 
-			got, tokens, err := h.Login(tt.args.req)
+			got, tokens, err := h.Login(context.Background(), tt.args.req)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -195,8 +208,8 @@ func TestUserService_Logout(t *testing.T) {
 			name: "success",
 			fields: fields{
 				repository: func() port.AuthRepository {
-					mockAuthRepository.EXPECT().GetRefreshToken(mock.AnythingOfType("uint")).Return(&domain.RefreshToken{}, nil).Once()
-					mockAuthRepository.EXPECT().DeleteRefreshToken(mock.AnythingOfType("domain.RefreshToken")).Return(nil).Once()
+					mockAuthRepository.EXPECT().GetRefreshToken(mock.Anything, mock.AnythingOfType("uint")).Return(&domain.RefreshToken{}, nil).Once()
+					mockAuthRepository.EXPECT().DeleteRefreshToken(mock.Anything, mock.AnythingOfType("domain.RefreshToken")).Return(nil).Once()
 					return mockAuthRepository
 				}(),
 				bcrypt: bcrypt,
@@ -217,7 +230,7 @@ func TestUserService_Logout(t *testing.T) {
 				jwt:        tt.fields.jwt,
 			}
 
-			err := h.Logout(tt.args.req)
+			err := h.Logout(context.Background(), tt.args.req)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -253,7 +266,7 @@ func TestUserService_Logout(t *testing.T) {
 // 			name: "success",
 // 			fields: fields{
 // 				repository: func() port.AuthRepository {
-// 					mockAuthRepository.EXPECT().GetRefreshToken(mock.AnythingOfType("uint")).Return(&domain.RefreshToken{}, nil).Maybe()
+// 					mockAuthRepository.EXPECT().GetRefreshToken(mock.Anything, mock.AnythingOfType("uint")).Return(&domain.RefreshToken{}, nil).Maybe()
 // 					return mockAuthRepository
 // 				}(),
 // 				jwt: jwt,
@@ -273,7 +286,7 @@ func TestUserService_Logout(t *testing.T) {
 // 				jwt:        tt.fields.jwt,
 // 			}
 
-// 			got, claims, err := h.Refresh(tt.args.req)
+// 			got, claims, err := h.Refresh(context.Background(), tt.args.req)
 
 // 			if tt.wantErr {
 // 				assert.Error(t, err)
@@ -286,3 +299,94 @@ func TestUserService_Logout(t *testing.T) {
 // 		})
 // 	}
 // }
+
+func TestAuthService_Restore(t *testing.T) {
+	type fields struct {
+		repository port.AuthRepository
+		bcrypt     util.Bcrypt
+		cfg        *config.Config
+	}
+
+	type args struct {
+		req domain.AuthLoginRequest
+	}
+
+	mockAuthRepository := mocks.NewAuthRepository(t)
+	bcrypt := util.NewBcrypt()
+	cfg := &config.Config{}
+	cfg.Trash.UserGracePeriod = time.Hour
+
+	deletedEntity := *authEntity
+	deletedEntity.DeletedAt = gorm.DeletedAt{Time: time.Now().Add(-time.Minute), Valid: true}
+
+	expiredEntity := *authEntity
+	expiredEntity.DeletedAt = gorm.DeletedAt{Time: time.Now().Add(-2 * time.Hour), Valid: true}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				repository: func() port.AuthRepository {
+					mockAuthRepository.EXPECT().GetDeletedByEmail(mock.Anything, authEntity.Email).Return(&deletedEntity, nil).Once()
+					mockAuthRepository.EXPECT().Restore(mock.Anything, mock.AnythingOfType("*domain.User")).Return(authEntity, nil).Once()
+					return mockAuthRepository
+				}(),
+				bcrypt: bcrypt,
+				cfg:    cfg,
+			},
+			args: args{
+				req: domain.AuthLoginRequest{
+					Email:    authEntity.Email,
+					Password: "password123",
+				},
+			},
+			want:    authEntity,
+			wantErr: false,
+		},
+		{
+			name: "grace period expired",
+			fields: fields{
+				repository: func() port.AuthRepository {
+					mockAuthRepository.EXPECT().GetDeletedByEmail(mock.Anything, authEntity.Email).Return(&expiredEntity, nil).Once()
+					return mockAuthRepository
+				}(),
+				bcrypt: bcrypt,
+				cfg:    cfg,
+			},
+			args: args{
+				req: domain.AuthLoginRequest{
+					Email:    authEntity.Email,
+					Password: "password123",
+				},
+			},
+			want:    errors.New("user grace period has expired"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &AuthService{
+				repository: tt.fields.repository,
+				bcrypt:     tt.fields.bcrypt,
+				cfg:        tt.fields.cfg,
+			}
+
+			got, err := h.Restore(context.Background(), tt.args.req)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.want.(error).Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,390 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// collabHistorySize bounds the number of operations kept per document for
+// transforming late operations; clients further behind have to resync.
+const collabHistorySize = 500
+
+type collabDocument struct {
+	mu       sync.Mutex
+	note     *domain.Note
+	content  string
+	revision int
+	history  []*util.Operation
+	clients  map[*domain.CollabClient]struct{}
+	dirty    bool
+	// closed is set once the last client left and the document is saved, a
+	// client joining it has to start a new one.
+	closed bool
+	// saving keeps the saves of a document in the order of their snapshots.
+	saving sync.Mutex
+}
+
+// CollabService keeps the documents being edited in memory. Its mu only guards
+// the map of documents and is never held while waiting on a document, so a
+// slow save of one note does not hold up the others.
+type CollabService struct {
+	noteService    port.NoteService
+	userRepository port.UserRepository
+	cfg            *config.Config
+	mu             sync.Mutex
+	documents      map[uint]*collabDocument
+	stop           chan struct{}
+	done           chan struct{}
+}
+
+func NewCollabService(noteService port.NoteService, userRepository port.UserRepository, cfg *config.Config) port.CollabService {
+	return &CollabService{
+		noteService:    noteService,
+		userRepository: userRepository,
+		cfg:            cfg,
+		documents:      make(map[uint]*collabDocument),
+		stop:           make(chan struct{}),
+		done:           make(chan struct{}),
+	}
+}
+
+func (s *CollabService) Start() {
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(s.cfg.Collab.PersistInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				s.persistAll()
+			case <-s.stop:
+				s.persistAll()
+				return
+			}
+		}
+	}()
+}
+
+func (s *CollabService) Stop() {
+	close(s.stop)
+	<-s.done
+}
+
+func (s *CollabService) Join(ctx context.Context, note *domain.Note, userID uint, editable bool) (*domain.CollabClient, error) {
+	user, err := s.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &domain.CollabClient{
+		NoteID:   note.ID,
+		UserID:   user.ID,
+		Name:     user.Name,
+		Editable: editable,
+		Send:     make(chan domain.CollabMessage, 256),
+	}
+
+	doc := s.open(note)
+	defer doc.mu.Unlock()
+
+	doc.clients[client] = struct{}{}
+
+	sendCollab(client, domain.CollabMessage{
+		Type:     domain.CollabInit,
+		Revision: doc.revision,
+		Content:  doc.content,
+		Users:    doc.users(),
+	})
+	doc.broadcast(client, domain.CollabMessage{
+		Type:  domain.CollabPresence,
+		Users: doc.users(),
+	})
+
+	return client, nil
+}
+
+func (s *CollabService) Receive(client *domain.CollabClient, msg domain.CollabMessage) {
+	s.mu.Lock()
+	doc, ok := s.documents[client.NoteID]
+	s.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	doc.mu.Lock()
+	defer doc.mu.Unlock()
+
+	// dropped meanwhile, its clients are closed
+	if doc.closed {
+		return
+	}
+
+	switch msg.Type {
+	case domain.CollabOperation:
+		if !client.Editable {
+			sendCollab(client, domain.CollabMessage{Type: domain.CollabError, Error: "user does not have permission to edit this note"})
+			return
+		}
+
+		var op util.Operation
+		if err := json.Unmarshal(msg.Operation, &op); err != nil {
+			sendCollab(client, domain.CollabMessage{Type: domain.CollabError, Error: "invalid operation"})
+			return
+		}
+
+		revision, err := doc.apply(&op, msg.Revision)
+		if err != nil {
+			sendCollab(client, domain.CollabMessage{Type: domain.CollabError, Revision: doc.revision, Error: err.Error()})
+			return
+		}
+
+		encoded, err := json.Marshal(op)
+		if err != nil {
+			sendCollab(client, domain.CollabMessage{Type: domain.CollabError, Error: err.Error()})
+			return
+		}
+
+		sendCollab(client, domain.CollabMessage{Type: domain.CollabAck, Revision: revision})
+		doc.broadcast(client, domain.CollabMessage{
+			Type:      domain.CollabOperation,
+			Revision:  revision,
+			Operation: encoded,
+			UserID:    client.UserID,
+		})
+	case domain.CollabCursor:
+		client.Position = max(0, min(msg.Position, utf8.RuneCountInString(doc.content)))
+		doc.broadcast(client, domain.CollabMessage{
+			Type:     domain.CollabCursor,
+			Revision: doc.revision,
+			Position: client.Position,
+			UserID:   client.UserID,
+		})
+	default:
+		sendCollab(client, domain.CollabMessage{Type: domain.CollabError, Error: "unknown message type"})
+	}
+}
+
+func (s *CollabService) Leave(client *domain.CollabClient) {
+	s.mu.Lock()
+	doc, ok := s.documents[client.NoteID]
+	s.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	doc.mu.Lock()
+	if _, ok := doc.clients[client]; !ok {
+		doc.mu.Unlock()
+		return
+	}
+	delete(doc.clients, client)
+	close(client.Send)
+
+	if len(doc.clients) > 0 {
+		doc.broadcast(nil, domain.CollabMessage{
+			Type:  domain.CollabPresence,
+			Users: doc.users(),
+		})
+		doc.mu.Unlock()
+		return
+	}
+	doc.mu.Unlock()
+
+	// the document stays open while it is saved, a client joining meanwhile
+	// gets its content rather than the note as it was before the save
+	s.persist(doc)
+	s.close(doc)
+}
+
+// open returns the document of note with its mu held, starting one if the note
+// is not being edited yet.
+func (s *CollabService) open(note *domain.Note) *collabDocument {
+	for {
+		s.mu.Lock()
+		doc, ok := s.documents[note.ID]
+		if !ok {
+			doc = &collabDocument{
+				note:    note,
+				content: note.Content,
+				clients: make(map[*domain.CollabClient]struct{}),
+			}
+			s.documents[note.ID] = doc
+		}
+		s.mu.Unlock()
+
+		doc.mu.Lock()
+		if !doc.closed {
+			return doc
+		}
+		// closed between the lookup and the lock, it is gone from the map now
+		doc.mu.Unlock()
+	}
+}
+
+func (s *CollabService) persistAll() {
+	s.mu.Lock()
+	documents := make([]*collabDocument, 0, len(s.documents))
+	for _, doc := range s.documents {
+		documents = append(documents, doc)
+	}
+	s.mu.Unlock()
+
+	for _, doc := range documents {
+		s.persist(doc)
+		// left while its save failed, the retry above may have saved it
+		s.close(doc)
+	}
+}
+
+// close drops doc once nobody edits it and its content is saved.
+func (s *CollabService) close(doc *collabDocument) {
+	doc.mu.Lock()
+	defer doc.mu.Unlock()
+
+	if doc.closed || len(doc.clients) > 0 || doc.dirty {
+		return
+	}
+	doc.closed = true
+
+	s.mu.Lock()
+	delete(s.documents, doc.note.ID)
+	s.mu.Unlock()
+}
+
+// drop closes the clients of doc and forgets it along with its unsaved
+// content, the note can no longer be saved.
+func (s *CollabService) drop(doc *collabDocument, err error) {
+	doc.mu.Lock()
+	defer doc.mu.Unlock()
+
+	if doc.closed {
+		return
+	}
+	for client := range doc.clients {
+		sendCollab(client, domain.CollabMessage{Type: domain.CollabError, Error: err.Error()})
+		close(client.Send)
+	}
+	doc.clients = make(map[*domain.CollabClient]struct{})
+	doc.dirty = false
+	doc.closed = true
+
+	s.mu.Lock()
+	delete(s.documents, doc.note.ID)
+	s.mu.Unlock()
+}
+
+// persist saves the content of doc through the note service, as its owner, so
+// the update is published like any other. The content is copied under doc.mu
+// and saved without it, clients keep editing during the write. A note that is
+// gone or no longer editable by its owner is dropped, retrying cannot save it.
+func (s *CollabService) persist(doc *collabDocument) {
+	doc.saving.Lock()
+	defer doc.saving.Unlock()
+
+	doc.mu.Lock()
+	if !doc.dirty {
+		doc.mu.Unlock()
+		return
+	}
+	req := domain.NoteUpdateRequest{
+		ID:      doc.note.ID,
+		Content: doc.content,
+	}
+	claims := domain.Claims{UserID: doc.note.UserID}
+	doc.dirty = false
+	doc.mu.Unlock()
+
+	if _, err := s.noteService.Update(context.Background(), req, claims); err != nil {
+		var e *fiber.Error
+		if errors.As(err, &e) && (e.Code == fiber.StatusNotFound || e.Code == fiber.StatusForbidden) {
+			slog.Warn("dropped collaborative note that cannot be saved", "note_id", req.ID, "err", err)
+			s.drop(doc, err)
+			return
+		}
+		slog.Error("failed to persist collaborative note", "note_id", req.ID, "err", err)
+
+		doc.mu.Lock()
+		doc.dirty = true
+		doc.mu.Unlock()
+	}
+}
+
+// apply transforms op against every operation the client had not seen yet,
+// applies it to the document and returns the new revision.
+func (d *collabDocument) apply(op *util.Operation, revision int) (int, error) {
+	base := d.revision - len(d.history)
+	if revision < base || revision > d.revision {
+		return 0, fiber.NewError(fiber.StatusConflict, "operation revision is out of range, please resync")
+	}
+
+	for _, concurrent := range d.history[revision-base:] {
+		transformed, _, err := util.Transform(op, concurrent)
+		if err != nil {
+			return 0, err
+		}
+		*op = *transformed
+	}
+
+	content, err := op.Apply(d.content)
+	if err != nil {
+		return 0, err
+	}
+
+	d.content = content
+	d.revision++
+	d.dirty = true
+	d.history = append(d.history, op)
+	if len(d.history) > collabHistorySize {
+		d.history = d.history[len(d.history)-collabHistorySize:]
+	}
+
+	for client := range d.clients {
+		client.Position = op.TransformIndex(client.Position)
+	}
+
+	return d.revision, nil
+}
+
+func (d *collabDocument) users() []domain.CollabUser {
+	users := make([]domain.CollabUser, 0, len(d.clients))
+	for client := range d.clients {
+		users = append(users, domain.CollabUser{
+			ID:       client.UserID,
+			Name:     client.Name,
+			Editable: client.Editable,
+			Position: client.Position,
+		})
+	}
+	return users
+}
+
+func (d *collabDocument) broadcast(sender *domain.CollabClient, msg domain.CollabMessage) {
+	for client := range d.clients {
+		if client != sender {
+			sendCollab(client, msg)
+		}
+	}
+}
+
+// sendCollab never blocks; a client that falls this far behind notices the gap in
+// revisions and has to reconnect to resync.
+func sendCollab(client *domain.CollabClient, msg domain.CollabMessage) {
+	select {
+	case client.Send <- msg:
+	default:
+	}
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/mocks"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func receiveCollab(t *testing.T, client *domain.CollabClient, kind string) domain.CollabMessage {
+	t.Helper()
+	for {
+		select {
+		case msg := <-client.Send:
+			if msg.Type == kind {
+				return msg
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("no %s message received", kind)
+		}
+	}
+}
+
+func TestCollabService(t *testing.T) {
+	mockNoteService := mocks.NewNoteService(t)
+	mockUserRepository := mocks.NewUserRepository(t)
+
+	note := *noteEntity
+	note.Content = "go"
+	editor := *userEntity
+	editor.ID = 2
+
+	mockUserRepository.EXPECT().GetByID(mock.Anything, note.UserID).Return(userEntity, nil).Once()
+	mockUserRepository.EXPECT().GetByID(mock.Anything, editor.ID).Return(&editor, nil).Once()
+	mockNoteService.EXPECT().Update(mock.Anything, mock.MatchedBy(func(req domain.NoteUpdateRequest) bool {
+		return req.ID == note.ID && req.Content == "lets go!"
+	}), domain.Claims{UserID: note.UserID}).Return(&note, nil).Once()
+
+	s := NewCollabService(mockNoteService, mockUserRepository, &config.Config{}).(*CollabService)
+
+	owner, err := s.Join(context.Background(), &note, note.UserID, true)
+	require.NoError(t, err)
+	assert.Equal(t, "go", receiveCollab(t, owner, domain.CollabInit).Content)
+
+	other, err := s.Join(context.Background(), &note, editor.ID, true)
+	require.NoError(t, err)
+	assert.Len(t, receiveCollab(t, other, domain.CollabInit).Users, 2)
+	assert.Len(t, receiveCollab(t, owner, domain.CollabPresence).Users, 2)
+
+	// both clients edit revision 0 concurrently
+	s.Receive(owner, domain.CollabMessage{Type: domain.CollabOperation, Revision: 0, Operation: json.RawMessage(`["lets ", 2]`)})
+	assert.Equal(t, 1, receiveCollab(t, owner, domain.CollabAck).Revision)
+	assert.JSONEq(t, `["lets ", 2]`, string(receiveCollab(t, other, domain.CollabOperation).Operation))
+
+	s.Receive(other, domain.CollabMessage{Type: domain.CollabOperation, Revision: 0, Operation: json.RawMessage(`[2, "!"]`)})
+	assert.Equal(t, 2, receiveCollab(t, other, domain.CollabAck).Revision)
+	assert.JSONEq(t, `[7, "!"]`, string(receiveCollab(t, owner, domain.CollabOperation).Operation))
+
+	s.Receive(other, domain.CollabMessage{Type: domain.CollabOperation, Revision: 5, Operation: json.RawMessage(`[8]`)})
+	assert.NotEmpty(t, receiveCollab(t, other, domain.CollabError).Error)
+
+	s.Receive(other, domain.CollabMessage{Type: domain.CollabCursor, Position: 3})
+	assert.Equal(t, 3, receiveCollab(t, owner, domain.CollabCursor).Position)
+
+	s.Leave(other)
+	s.Leave(owner)
+
+	assert.Empty(t, s.documents)
+}
+
+func TestCollabService_ReadOnly(t *testing.T) {
+	mockNoteService := mocks.NewNoteService(t)
+	mockUserRepository := mocks.NewUserRepository(t)
+	mockUserRepository.EXPECT().GetByID(mock.Anything, userEntity.ID).Return(userEntity, nil).Once()
+
+	s := NewCollabService(mockNoteService, mockUserRepository, &config.Config{})
+
+	viewer, err := s.Join(context.Background(), noteEntity, userEntity.ID, false)
+	require.NoError(t, err)
+
+	s.Receive(viewer, domain.CollabMessage{Type: domain.CollabOperation, Operation: json.RawMessage(`["x"]`)})
+	assert.Equal(t, "user does not have permission to edit this note", receiveCollab(t, viewer, domain.CollabError).Error)
+
+	s.Leave(viewer)
+}
+
+func TestCollabService_SlowSave(t *testing.T) {
+	mockNoteService := mocks.NewNoteService(t)
+	mockUserRepository := mocks.NewUserRepository(t)
+	mockUserRepository.EXPECT().GetByID(mock.Anything, userEntity.ID).Return(userEntity, nil).Twice()
+
+	note := *noteEntity
+	other := *noteEntity
+	other.ID = note.ID + 1
+
+	saving, release := make(chan struct{}), make(chan struct{})
+	mockNoteService.EXPECT().Update(mock.Anything, mock.AnythingOfType("domain.NoteUpdateRequest"), mock.AnythingOfType("domain.Claims")).
+		RunAndReturn(func(context.Context, domain.NoteUpdateRequest, domain.Claims) (*domain.Note, error) {
+			close(saving)
+			<-release
+			return &note, nil
+		}).Once()
+
+	s := NewCollabService(mockNoteService, mockUserRepository, &config.Config{}).(*CollabService)
+
+	client, err := s.Join(context.Background(), &note, userEntity.ID, true)
+	require.NoError(t, err)
+	s.Receive(client, domain.CollabMessage{Type: domain.CollabOperation, Operation: json.RawMessage(`["x", 11]`)})
+
+	left := make(chan struct{})
+	go func() {
+		s.Leave(client)
+		close(left)
+	}()
+	<-saving
+
+	// another note can be joined while the first one is being saved
+	joined := make(chan struct{})
+	go func() {
+		_, err := s.Join(context.Background(), &other, userEntity.ID, true)
+		assert.NoError(t, err)
+		close(joined)
+	}()
+	select {
+	case <-joined:
+	case <-time.After(time.Second):
+		t.Fatal("join blocked by the save of another note")
+	}
+
+	close(release)
+	<-left
+	s.mu.Lock()
+	assert.NotContains(t, s.documents, note.ID)
+	s.mu.Unlock()
+}
+
+func TestCollabService_NoteDeleted(t *testing.T) {
+	mockNoteService := mocks.NewNoteService(t)
+	mockUserRepository := mocks.NewUserRepository(t)
+	mockUserRepository.EXPECT().GetByID(mock.Anything, userEntity.ID).Return(userEntity, nil).Once()
+	mockNoteService.EXPECT().Update(mock.Anything, mock.AnythingOfType("domain.NoteUpdateRequest"), mock.AnythingOfType("domain.Claims")).Return(nil, fiber.NewError(fiber.StatusNotFound, "note not found")).Once()
+
+	s := NewCollabService(mockNoteService, mockUserRepository, &config.Config{}).(*CollabService)
+
+	client, err := s.Join(context.Background(), noteEntity, userEntity.ID, true)
+	require.NoError(t, err)
+	s.Receive(client, domain.CollabMessage{Type: domain.CollabOperation, Operation: json.RawMessage(`["x", 11]`)})
+
+	// the note is deleted while it is edited, the next save drops it
+	s.persistAll()
+	assert.Equal(t, "note not found", receiveCollab(t, client, domain.CollabError).Error)
+	for range client.Send {
+	}
+	s.mu.Lock()
+	assert.Empty(t, s.documents)
+	s.mu.Unlock()
+
+	// nothing is saved again and the late leave of the client is ignored
+	s.persistAll()
+	s.Leave(client)
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+)
+
+// EventService is an in-memory event bus for note changes. The most recent
+// events are kept in a log so that reconnecting clients can resume from the
+// last event they received.
+type EventService struct {
+	mu            sync.Mutex
+	lastID        uint64
+	log           []domain.NoteEvent
+	logSize       int
+	subscriptions map[*domain.NoteSubscription]struct{}
+}
+
+func NewEventService(cfg *config.Config) port.EventService {
+	return &EventService{
+		logSize:       cfg.Events.LogSize,
+		subscriptions: make(map[*domain.NoteSubscription]struct{}),
+	}
+}
+
+func (s *EventService) Publish(eventType domain.NoteEventType, note domain.Note, sharedWith []uint) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastID++
+	event := domain.NoteEvent{
+		ID:         s.lastID,
+		Type:       eventType,
+		Note:       note,
+		SharedWith: sharedWith,
+		CreatedAt:  time.Now(),
+	}
+
+	s.log = append(s.log, event)
+	if len(s.log) > s.logSize {
+		s.log = s.log[len(s.log)-s.logSize:]
+	}
+
+	for subscription := range s.subscriptions {
+		if !event.VisibleTo(subscription.UserID) {
+			continue
+		}
+
+		select {
+		case subscription.Events <- event:
+		default:
+			// the subscriber is too slow to keep up, closing the stream makes the
+			// client reconnect and resume from the log with Last-Event-ID
+			delete(s.subscriptions, subscription)
+			close(subscription.Events)
+		}
+	}
+}
+
+// Subscribe registers a subscription and returns the logged events after
+// lastEventID that the user is allowed to see. Both happen under the same lock
+// so no event is missed or delivered twice in between.
+func (s *EventService) Subscribe(userID uint, lastEventID uint64) (*domain.NoteSubscription, []domain.NoteEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var backlog []domain.NoteEvent
+	if lastEventID > 0 && lastEventID <= s.lastID {
+		for _, event := range s.log {
+			if event.ID > lastEventID && event.VisibleTo(userID) {
+				backlog = append(backlog, event)
+			}
+		}
+	}
+
+	subscription := &domain.NoteSubscription{
+		UserID: userID,
+		Events: make(chan domain.NoteEvent, 64),
+	}
+	s.subscriptions[subscription] = struct{}{}
+
+	return subscription, backlog
+}
+
+func (s *EventService) Unsubscribe(subscription *domain.NoteSubscription) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.subscriptions[subscription]; !ok {
+		return
+	}
+	delete(s.subscriptions, subscription)
+	close(subscription.Events)
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestEventService_Publish(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Events.LogSize = 10
+
+	s := NewEventService(cfg)
+
+	owner, _ := s.Subscribe(1, 0)
+	other, _ := s.Subscribe(2, 0)
+	defer s.Unsubscribe(owner)
+	defer s.Unsubscribe(other)
+
+	private := domain.Note{Model: gorm.Model{ID: 1}, UserID: 1, Visibility: domain.Private}
+	s.Publish(domain.NoteCreated, private, nil)
+	s.Publish(domain.NoteUpdated, private, []uint{2})
+
+	assert.Equal(t, domain.NoteCreated, (<-owner.Events).Type)
+	assert.Equal(t, domain.NoteUpdated, (<-owner.Events).Type)
+
+	event := <-other.Events
+	assert.Equal(t, domain.NoteUpdated, event.Type)
+	assert.Equal(t, uint64(2), event.ID)
+	assert.Empty(t, other.Events)
+}
+
+func TestEventService_Subscribe(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Events.LogSize = 2
+
+	s := NewEventService(cfg)
+
+	for id := uint(1); id <= 3; id++ {
+		s.Publish(domain.NoteCreated, domain.Note{Model: gorm.Model{ID: id}, UserID: 1, Visibility: domain.Public}, nil)
+	}
+
+	tests := []struct {
+		name        string
+		lastEventID uint64
+		want        []uint64
+	}{
+		{
+			name:        "fresh connection",
+			lastEventID: 0,
+			want:        nil,
+		},
+		{
+			name:        "resume",
+			lastEventID: 2,
+			want:        []uint64{3},
+		},
+		{
+			name:        "resume beyond log",
+			lastEventID: 1,
+			want:        []uint64{2, 3},
+		},
+		{
+			name:        "unknown event",
+			lastEventID: 42,
+			want:        nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscription, backlog := s.Subscribe(2, tt.lastEventID)
+			defer s.Unsubscribe(subscription)
+
+			var got []uint64
+			for _, event := range backlog {
+				got = append(got, event.ID)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEventService_SlowSubscriber(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Events.LogSize = 100
+
+	s := NewEventService(cfg)
+	subscription, _ := s.Subscribe(1, 0)
+
+	note := domain.Note{Model: gorm.Model{ID: 1}, UserID: 1}
+	for i := 0; i <= cap(subscription.Events); i++ {
+		s.Publish(domain.NoteUpdated, note, nil)
+	}
+
+	received := 0
+	for range subscription.Events {
+		received++
+	}
+
+	assert.Equal(t, cap(subscription.Events), received)
+
+	// unsubscribing an already dropped subscription must not panic
+	s.Unsubscribe(subscription)
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ShareLinkService struct {
+	repository     port.ShareLinkRepository
+	noteRepository port.NoteRepository
+	bcrypt         util.Bcrypt
+}
+
+func NewShareLinkService(repository port.ShareLinkRepository, noteRepository port.NoteRepository, bcrypt util.Bcrypt) port.ShareLinkService {
+	return &ShareLinkService{
+		repository:     repository,
+		noteRepository: noteRepository,
+		bcrypt:         bcrypt,
+	}
+}
+
+func (h *ShareLinkService) Create(ctx context.Context, req domain.ShareLinkRequest, claims domain.Claims) (*domain.ShareLink, error) {
+	if err := h.authorize(ctx, req.NoteID, claims); err != nil {
+		return nil, err
+	}
+
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "share link expiration must be in the future")
+	}
+
+	if req.Password != "" {
+		hashedPassword, err := h.bcrypt.HashPassword(req.Password)
+		if err != nil {
+			return nil, err
+		}
+		req.Password = string(hashedPassword)
+	}
+
+	token, err := util.GenerateToken(32)
+	if err != nil {
+		return nil, err
+	}
+	req.Token = token
+
+	return h.repository.Create(ctx, req)
+}
+
+func (h *ShareLinkService) GetAll(ctx context.Context, noteID uint, claims domain.Claims) ([]domain.ShareLink, error) {
+	if err := h.authorize(ctx, noteID, claims); err != nil {
+		return nil, err
+	}
+
+	return h.repository.GetAll(ctx, noteID)
+}
+
+func (h *ShareLinkService) Delete(ctx context.Context, noteID uint, id uint, claims domain.Claims) error {
+	if err := h.authorize(ctx, noteID, claims); err != nil {
+		return err
+	}
+
+	link, err := h.repository.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if link.NoteID != noteID {
+		return fiber.NewError(fiber.StatusNotFound, "share link not found")
+	}
+
+	return h.repository.Delete(ctx, link)
+}
+
+func (h *ShareLinkService) Open(ctx context.Context, token string, password string) (*domain.Note, error) {
+	link, err := h.repository.GetByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if link.ExpiresAt != nil && link.ExpiresAt.Before(time.Now()) {
+		return nil, fiber.NewError(fiber.StatusGone, "share link has expired")
+	}
+
+	if link.Password != "" {
+		if password == "" {
+			return nil, fiber.NewError(fiber.StatusUnauthorized, "share link requires a password")
+		}
+		if err := h.bcrypt.ComparePassword(password, []byte(link.Password)); err != nil {
+			return nil, fiber.NewError(fiber.StatusUnauthorized, "invalid share link password")
+		}
+	}
+
+	return h.noteRepository.GetByID(ctx, link.NoteID)
+}
+
+func (h *ShareLinkService) authorize(ctx context.Context, noteID uint, claims domain.Claims) error {
+	note, err := h.noteRepository.GetByID(ctx, noteID)
+	if err != nil {
+		return err
+	}
+
+	if note.UserID != claims.UserID {
+		return fiber.NewError(fiber.StatusForbidden, "user does not have permission to perform this action")
+	}
+
+	return nil
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/mocks"
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var shareLinkEntity = &domain.ShareLink{
+	ID:     1,
+	NoteID: 1,
+	Token:  "c2hpcm9u",
+}
+
+func TestShareLinkService_Create(t *testing.T) {
+	type fields struct {
+		repository     port.ShareLinkRepository
+		noteRepository port.NoteRepository
+	}
+
+	type args struct {
+		req    domain.ShareLinkRequest
+		claims domain.Claims
+	}
+
+	mockShareLinkRepository := mocks.NewShareLinkRepository(t)
+	mockNoteRepository := mocks.NewNoteRepository(t)
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				repository: func() port.ShareLinkRepository {
+					mockShareLinkRepository.EXPECT().Create(mock.Anything, mock.MatchedBy(func(req domain.ShareLinkRequest) bool {
+						return req.Token != "" && req.Password != "password123"
+					})).Return(shareLinkEntity, nil).Once()
+					return mockShareLinkRepository
+				}(),
+				noteRepository: func() port.NoteRepository {
+					mockNoteRepository.EXPECT().GetByID(mock.Anything, noteEntity.ID).Return(noteEntity, nil).Once()
+					return mockNoteRepository
+				}(),
+			},
+			args: args{
+				req: domain.ShareLinkRequest{
+					NoteID:   noteEntity.ID,
+					Password: "password123",
+				},
+				claims: domain.Claims{
+					UserID: noteEntity.UserID,
+				},
+			},
+			want:    shareLinkEntity,
+			wantErr: false,
+		},
+		{
+			name: "expiration in the past",
+			fields: fields{
+				repository: mockShareLinkRepository,
+				noteRepository: func() port.NoteRepository {
+					mockNoteRepository.EXPECT().GetByID(mock.Anything, noteEntity.ID).Return(noteEntity, nil).Once()
+					return mockNoteRepository
+				}(),
+			},
+			args: args{
+				req: domain.ShareLinkRequest{
+					NoteID:    noteEntity.ID,
+					ExpiresAt: &past,
+				},
+				claims: domain.Claims{
+					UserID: noteEntity.UserID,
+				},
+			},
+			want:    errors.New("share link expiration must be in the future"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &ShareLinkService{
+				repository:     tt.fields.repository,
+				noteRepository: tt.fields.noteRepository,
+				bcrypt:         util.NewBcrypt(),
+			}
+
+			got, err := h.Create(context.Background(), tt.args.req, tt.args.claims)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.want.(error).Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestShareLinkService_Open(t *testing.T) {
+	type fields struct {
+		repository     port.ShareLinkRepository
+		noteRepository port.NoteRepository
+	}
+
+	type args struct {
+		token    string
+		password string
+	}
+
+	mockShareLinkRepository := mocks.NewShareLinkRepository(t)
+	mockNoteRepository := mocks.NewNoteRepository(t)
+	past := time.Now().Add(-time.Hour)
+
+	expiredLink := *shareLinkEntity
+	expiredLink.ExpiresAt = &past
+
+	protectedLink := *shareLinkEntity
+	protectedLink.Password = authEntity.Password
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				repository: func() port.ShareLinkRepository {
+					mockShareLinkRepository.EXPECT().GetByToken(mock.Anything, shareLinkEntity.Token).Return(shareLinkEntity, nil).Once()
+					return mockShareLinkRepository
+				}(),
+				noteRepository: func() port.NoteRepository {
+					mockNoteRepository.EXPECT().GetByID(mock.Anything, shareLinkEntity.NoteID).Return(noteEntity, nil).Once()
+					return mockNoteRepository
+				}(),
+			},
+			args: args{
+				token: shareLinkEntity.Token,
+			},
+			want:    noteEntity,
+			wantErr: false,
+		},
+		{
+			name: "expired",
+			fields: fields{
+				repository: func() port.ShareLinkRepository {
+					mockShareLinkRepository.EXPECT().GetByToken(mock.Anything, shareLinkEntity.Token).Return(&expiredLink, nil).Once()
+					return mockShareLinkRepository
+				}(),
+				noteRepository: mockNoteRepository,
+			},
+			args: args{
+				token: shareLinkEntity.Token,
+			},
+			want:    errors.New("share link has expired"),
+			wantErr: true,
+		},
+		{
+			name: "password required",
+			fields: fields{
+				repository: func() port.ShareLinkRepository {
+					mockShareLinkRepository.EXPECT().GetByToken(mock.Anything, shareLinkEntity.Token).Return(&protectedLink, nil).Once()
+					return mockShareLinkRepository
+				}(),
+				noteRepository: mockNoteRepository,
+			},
+			args: args{
+				token: shareLinkEntity.Token,
+			},
+			want:    errors.New("share link requires a password"),
+			wantErr: true,
+		},
+		{
+			name: "invalid password",
+			fields: fields{
+				repository: func() port.ShareLinkRepository {
+					mockShareLinkRepository.EXPECT().GetByToken(mock.Anything, shareLinkEntity.Token).Return(&protectedLink, nil).Once()
+					return mockShareLinkRepository
+				}(),
+				noteRepository: mockNoteRepository,
+			},
+			args: args{
+				token:    shareLinkEntity.Token,
+				password: "invalid",
+			},
+			want:    errors.New("invalid share link password"),
+			wantErr: true,
+		},
+		{
+			name: "valid password",
+			fields: fields{
+				repository: func() port.ShareLinkRepository {
+					mockShareLinkRepository.EXPECT().GetByToken(mock.Anything, shareLinkEntity.Token).Return(&protectedLink, nil).Once()
+					return mockShareLinkRepository
+				}(),
+				noteRepository: func() port.NoteRepository {
+					mockNoteRepository.EXPECT().GetByID(mock.Anything, shareLinkEntity.NoteID).Return(noteEntity, nil).Once()
+					return mockNoteRepository
+				}(),
+			},
+			args: args{
+				token:    shareLinkEntity.Token,
+				password: "password123",
+			},
+			want:    noteEntity,
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &ShareLinkService{
+				repository:     tt.fields.repository,
+				noteRepository: tt.fields.noteRepository,
+				bcrypt:         util.NewBcrypt(),
+			}
+
+			got, err := h.Open(context.Background(), tt.args.token, tt.args.password)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.want.(error).Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -1,46 +1,170 @@
 package service
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
 	"github.com/shironxn/blanknotes/internal/core/domain"
 	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/util"
 
 	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
 )
 
+var tracer = otel.Tracer("github.com/shironxn/blanknotes/internal/core/service")
+
 type NoteService struct {
-	repository port.NoteRepository
+	repository      port.NoteRepository
+	shareRepository port.NoteShareRepository
+	storage         port.Storage
+	eventService    port.EventService
+	metrics         port.Metrics
+	markdown        *util.Markdown
 }
 
-func NewNoteService(repository port.NoteRepository) port.NoteService {
+func NewNoteService(repository port.NoteRepository, shareRepository port.NoteShareRepository, storage port.Storage, eventService port.EventService, metrics port.Metrics, markdown *util.Markdown) port.NoteService {
 	return &NoteService{
-		repository: repository,
+		repository:      repository,
+		shareRepository: shareRepository,
+		storage:         storage,
+		eventService:    eventService,
+		metrics:         metrics,
+		markdown:        markdown,
 	}
 }
 
-func (h *NoteService) Create(req domain.NoteRequest) (*domain.Note, error) {
-	return h.repository.Create(req)
+func (h *NoteService) Create(ctx context.Context, req domain.NoteRequest) (*domain.Note, error) {
+	ctx, span := tracer.Start(ctx, "NoteService.Create")
+	defer span.End()
+
+	note, err := h.repository.Create(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	h.eventService.Publish(domain.NoteCreated, *note, nil)
+	h.metrics.NoteCreated()
+
+	return note, nil
 }
 
-func (h *NoteService) GetAll(req domain.NoteQuery, metadata *domain.Metadata) ([]domain.Note, error) {
-	data, err := h.repository.GetAll(req, metadata)
+func (h *NoteService) GetAll(ctx context.Context, req domain.NoteQuery, metadata *domain.Metadata) ([]domain.Note, error) {
+	ctx, span := tracer.Start(ctx, "NoteService.GetAll")
+	defer span.End()
+
+	data, err := h.repository.GetAll(ctx, req, metadata)
 	return data, err
 }
 
-func (h *NoteService) GetByID(id uint, claims *domain.Claims) (*domain.Note, error) {
-	data, err := h.repository.GetByID(id)
+func (h *NoteService) GetByID(ctx context.Context, id uint, claims *domain.Claims) (*domain.Note, error) {
+	ctx, span := tracer.Start(ctx, "NoteService.GetByID")
+	defer span.End()
+
+	data, err := h.repository.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
 	if data.Visibility == "private" && (claims == nil || data.UserID != claims.UserID) {
-		return nil, fiber.NewError(fiber.StatusUnauthorized, "you are not authorized to access this private note")
+		if claims == nil {
+			return nil, fiber.NewError(fiber.StatusUnauthorized, "you are not authorized to access this private note")
+		}
+
+		if _, err := h.shareRepository.Get(ctx, data.ID, claims.UserID); err != nil {
+			return nil, fiber.NewError(fiber.StatusUnauthorized, "you are not authorized to access this private note")
+		}
+	}
+
+	return data, nil
+}
+
+func (h *NoteService) GetEditable(ctx context.Context, id uint, claims domain.Claims) (*domain.Note, error) {
+	ctx, span := tracer.Start(ctx, "NoteService.GetEditable")
+	defer span.End()
+
+	note, err := h.repository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if note.UserID != claims.UserID {
+		share, err := h.shareRepository.Get(ctx, note.ID, claims.UserID)
+		if err != nil || share.Permission != domain.Editor {
+			return nil, fiber.NewError(fiber.StatusForbidden, "user does not have permission to perform this action")
+		}
+	}
+
+	return note, nil
+}
+
+// Render returns the note content as sanitized HTML. The cache key includes
+// the update time so an edited note is never served stale.
+func (h *NoteService) Render(note *domain.Note) (string, error) {
+	return h.markdown.Render(fmt.Sprintf("%d:%d", note.ID, note.UpdatedAt.UnixNano()), note.Content)
+}
+
+func (h *NoteService) Update(ctx context.Context, req domain.NoteUpdateRequest, claims domain.Claims) (*domain.Note, error) {
+	ctx, span := tracer.Start(ctx, "NoteService.Update")
+	defer span.End()
+
+	note, err := h.GetEditable(ctx, req.ID, claims)
+	if err != nil {
+		return nil, err
 	}
 
+	// editors may change what a note says, only its owner who sees it
+	if note.UserID != claims.UserID && req.Visibility != "" && domain.Visibility(req.Visibility) != note.Visibility {
+		return nil, fiber.NewError(fiber.StatusForbidden, "only the owner can change the visibility of a note")
+	}
+	req.UserID = note.UserID
+
+	data, err := h.repository.Update(ctx, req, note)
+	if err != nil {
+		return nil, err
+	}
+
+	h.publish(ctx, domain.NoteUpdated, data)
+
 	return data, nil
 }
 
-func (h *NoteService) Update(req domain.NoteUpdateRequest, claims domain.Claims) (*domain.Note, error) {
-	note, err := h.repository.GetByID(req.ID)
+func (h *NoteService) Delete(ctx context.Context, id uint, claims domain.Claims) error {
+	ctx, span := tracer.Start(ctx, "NoteService.Delete")
+	defer span.End()
+
+	note, err := h.repository.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if note.UserID != claims.UserID {
+		return fiber.NewError(fiber.StatusForbidden, "user does not have permission to perform this action")
+	}
+
+	if err := h.repository.Delete(ctx, note); err != nil {
+		return err
+	}
+
+	h.publish(ctx, domain.NoteDeleted, note)
+
+	return nil
+}
+
+func (h *NoteService) GetTrash(ctx context.Context, metadata *domain.Metadata, claims domain.Claims) ([]domain.Note, error) {
+	ctx, span := tracer.Start(ctx, "NoteService.GetTrash")
+	defer span.End()
+
+	return h.repository.GetTrash(ctx, domain.NoteQuery{UserID: int(claims.UserID)}, metadata)
+}
+
+func (h *NoteService) Restore(ctx context.Context, id uint, claims domain.Claims) (*domain.Note, error) {
+	ctx, span := tracer.Start(ctx, "NoteService.Restore")
+	defer span.End()
+
+	note, err := h.repository.GetTrashByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -49,11 +173,21 @@ func (h *NoteService) Update(req domain.NoteUpdateRequest, claims domain.Claims)
 		return nil, fiber.NewError(fiber.StatusForbidden, "user does not have permission to perform this action")
 	}
 
-	return h.repository.Update(req, note)
+	data, err := h.repository.Restore(ctx, note)
+	if err != nil {
+		return nil, err
+	}
+
+	h.publish(ctx, domain.NoteCreated, data)
+
+	return data, nil
 }
 
-func (h *NoteService) Delete(id uint, claims domain.Claims) error {
-	note, err := h.repository.GetByID(id)
+func (h *NoteService) ForceDelete(ctx context.Context, id uint, claims domain.Claims) error {
+	ctx, span := tracer.Start(ctx, "NoteService.ForceDelete")
+	defer span.End()
+
+	note, err := h.repository.GetTrashByID(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -62,5 +196,133 @@ func (h *NoteService) Delete(id uint, claims domain.Claims) error {
 		return fiber.NewError(fiber.StatusForbidden, "user does not have permission to perform this action")
 	}
 
-	return h.repository.Delete(note)
+	return h.repository.ForceDelete(ctx, note)
+}
+
+// Purge deletes the notes trashed for longer than retention, then the files
+// of their attachments. A file that cannot be deleted is logged and left
+// behind, its row is gone.
+func (h *NoteService) Purge(ctx context.Context, retention time.Duration) (int64, error) {
+	ctx, span := tracer.Start(ctx, "NoteService.Purge")
+	defer span.End()
+
+	count, keys, err := h.repository.Purge(ctx, time.Now().Add(-retention))
+	if err != nil {
+		return 0, err
+	}
+
+	for _, key := range keys {
+		if err := h.storage.Delete(ctx, key); err != nil {
+			util.Logger(ctx).Warn("delete file of purged note", "key", key, "err", err)
+		}
+	}
+
+	return count, nil
+}
+
+func (h *NoteService) Export(ctx context.Context, claims domain.Claims, batch func(notes []domain.Note) error) error {
+	ctx, span := tracer.Start(ctx, "NoteService.Export")
+	defer span.End()
+
+	return h.repository.Export(ctx, claims.UserID, batch)
+}
+
+// Import creates a note from an imported file and resolves a title that is
+// already taken according to the conflict strategy. It returns the resulting
+// note along with what happened to it: created, renamed, overwritten or skipped.
+func (h *NoteService) Import(ctx context.Context, req domain.NoteRequest, conflict domain.ImportConflict) (*domain.Note, string, error) {
+	ctx, span := tracer.Start(ctx, "NoteService.Import")
+	defer span.End()
+
+	existing, err := h.repository.GetByTitle(ctx, req.UserID, req.Title)
+	if err != nil && !isNotFound(err) {
+		return nil, "", err
+	}
+
+	status := "created"
+	if existing != nil {
+		switch conflict {
+		case domain.ImportOverwrite:
+			note, err := h.repository.Update(ctx, domain.NoteUpdateRequest{
+				ID:          existing.ID,
+				Title:       req.Title,
+				Description: req.Description,
+				CoverURL:    req.CoverURL,
+				Content:     req.Content,
+				Visibility:  req.Visibility,
+				Notebook:    req.Notebook,
+				Tags:        req.Tags,
+				UserID:      req.UserID,
+			}, existing)
+			if err != nil {
+				return nil, "", err
+			}
+
+			h.publish(ctx, domain.NoteUpdated, note)
+
+			return note, "overwritten", nil
+		case domain.ImportRename:
+			title, err := h.freeTitle(ctx, req.UserID, req.Title)
+			if err != nil {
+				return nil, "", err
+			}
+			req.Title = title
+			status = "renamed"
+		default:
+			return existing, "skipped", nil
+		}
+	}
+
+	note, err := h.Create(ctx, req)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return note, status, nil
+}
+
+// freeTitle finds the first "title (n)" that is not taken yet, shortening the
+// title when needed so it still fits the title length limit.
+func (h *NoteService) freeTitle(ctx context.Context, userID uint, title string) (string, error) {
+	const maxLength = 25
+
+	for n := 2; n <= 100; n++ {
+		suffix := []rune(fmt.Sprintf(" (%d)", n))
+		base := []rune(title)
+		if len(base)+len(suffix) > maxLength {
+			base = base[:maxLength-len(suffix)]
+		}
+		candidate := string(base) + string(suffix)
+
+		if _, err := h.repository.GetByTitle(ctx, userID, candidate); err != nil {
+			if isNotFound(err) {
+				return candidate, nil
+			}
+			return "", err
+		}
+	}
+
+	return "", fiber.NewError(fiber.StatusConflict, "could not find a free title for the note")
+}
+
+// publish sends a note event to the bus along with the users the note is
+// shared with, so that subscribers only receive notes they are allowed to see.
+func (h *NoteService) publish(ctx context.Context, eventType domain.NoteEventType, note *domain.Note) {
+	var sharedWith []uint
+
+	if note.Visibility != domain.Public {
+		shares, err := h.shareRepository.GetAll(ctx, note.ID)
+		if err == nil {
+			for _, share := range shares {
+				sharedWith = append(sharedWith, share.UserID)
+			}
+		}
+	}
+
+	h.eventService.Publish(eventType, *note, sharedWith)
+}
+
+func isNotFound(err error) bool {
+	var e *fiber.Error
+	return errors.As(err, &e) && e.Code == fiber.StatusNotFound
 }
@@ -1,13 +1,16 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/shironxn/blanknotes/internal/core/domain"
 	"github.com/shironxn/blanknotes/internal/core/port"
 	"github.com/shironxn/blanknotes/internal/mocks"
 
+	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"gorm.io/gorm"
@@ -27,7 +30,9 @@ var noteEntity = &domain.Note{
 
 func TestNoteService_Create(t *testing.T) {
 	type fields struct {
-		repository port.NoteRepository
+		repository   port.NoteRepository
+		eventService port.EventService
+		metrics      port.Metrics
 	}
 
 	type args struct {
@@ -35,6 +40,8 @@ func TestNoteService_Create(t *testing.T) {
 	}
 
 	mockNoteRepository := mocks.NewNoteRepository(t)
+	mockEventService := mocks.NewEventService(t)
+	mockMetrics := mocks.NewMetrics(t)
 
 	tests := []struct {
 		name    string
@@ -47,9 +54,17 @@ func TestNoteService_Create(t *testing.T) {
 			name: "success",
 			fields: fields{
 				repository: func() port.NoteRepository {
-					mockNoteRepository.EXPECT().Create(mock.AnythingOfType("domain.NoteRequest")).Return(noteEntity, nil).Once()
+					mockNoteRepository.EXPECT().Create(mock.Anything, mock.AnythingOfType("domain.NoteRequest")).Return(noteEntity, nil).Once()
 					return mockNoteRepository
 				}(),
+				eventService: func() port.EventService {
+					mockEventService.EXPECT().Publish(domain.NoteCreated, mock.AnythingOfType("domain.Note"), mock.Anything).Once()
+					return mockEventService
+				}(),
+				metrics: func() port.Metrics {
+					mockMetrics.EXPECT().NoteCreated().Once()
+					return mockMetrics
+				}(),
 			},
 			args: args{
 				req: domain.NoteRequest{},
@@ -62,10 +77,12 @@ func TestNoteService_Create(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			h := &NoteService{
-				repository: tt.fields.repository,
+				repository:   tt.fields.repository,
+				eventService: tt.fields.eventService,
+				metrics:      tt.fields.metrics,
 			}
 
-			got, err := h.Create(tt.args.req)
+			got, err := h.Create(context.Background(), tt.args.req)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -105,7 +122,7 @@ func TestNoteService_GetAll(t *testing.T) {
 			name: "success",
 			fields: fields{
 				repository: func() port.NoteRepository {
-					mockNoteRepository.EXPECT().GetAll(mock.AnythingOfType("domain.NoteQuery"), mock.AnythingOfType("*domain.Metadata")).Return(noteEntity, nil).Once()
+					mockNoteRepository.EXPECT().GetAll(mock.Anything, mock.AnythingOfType("domain.NoteQuery"), mock.AnythingOfType("*domain.Metadata")).Return(noteEntity, nil).Once()
 					return mockNoteRepository
 				}(),
 			},
@@ -124,7 +141,7 @@ func TestNoteService_GetAll(t *testing.T) {
 				repository: tt.fields.repository,
 			}
 
-			got, err := h.GetAll(tt.args.req, &tt.args.metadata)
+			got, err := h.GetAll(context.Background(), tt.args.req, &tt.args.metadata)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -139,7 +156,8 @@ func TestNoteService_GetAll(t *testing.T) {
 
 func TestNoteService_GetByID(t *testing.T) {
 	type fields struct {
-		repository port.NoteRepository
+		repository      port.NoteRepository
+		shareRepository port.NoteShareRepository
 	}
 
 	type args struct {
@@ -148,6 +166,10 @@ func TestNoteService_GetByID(t *testing.T) {
 	}
 
 	mockNoteRepository := mocks.NewNoteRepository(t)
+	mockNoteShareRepository := mocks.NewNoteShareRepository(t)
+
+	privateEntity := *noteEntity
+	privateEntity.Visibility = domain.Private
 
 	tests := []struct {
 		name    string
@@ -160,7 +182,7 @@ func TestNoteService_GetByID(t *testing.T) {
 			name: "success",
 			fields: fields{
 				repository: func() port.NoteRepository {
-					mockNoteRepository.EXPECT().GetByID(mock.AnythingOfType("uint")).Return(noteEntity, nil).Once()
+					mockNoteRepository.EXPECT().GetByID(mock.Anything, mock.AnythingOfType("uint")).Return(noteEntity, nil).Once()
 					return mockNoteRepository
 				}(),
 			},
@@ -172,15 +194,66 @@ func TestNoteService_GetByID(t *testing.T) {
 			want:    noteEntity,
 			wantErr: false,
 		},
+		{
+			name: "private shared",
+			fields: fields{
+				repository: func() port.NoteRepository {
+					mockNoteRepository.EXPECT().GetByID(mock.Anything, privateEntity.ID).Return(&privateEntity, nil).Once()
+					return mockNoteRepository
+				}(),
+				shareRepository: func() port.NoteShareRepository {
+					mockNoteShareRepository.EXPECT().Get(mock.Anything, privateEntity.ID, privateEntity.UserID+1).Return(&domain.NoteShare{
+						NoteID:     privateEntity.ID,
+						UserID:     privateEntity.UserID + 1,
+						Permission: domain.Viewer,
+					}, nil).Once()
+					return mockNoteShareRepository
+				}(),
+			},
+			args: args{
+				req: domain.NoteRequest{
+					ID: privateEntity.ID,
+				},
+				claims: &domain.Claims{
+					UserID: privateEntity.UserID + 1,
+				},
+			},
+			want:    &privateEntity,
+			wantErr: false,
+		},
+		{
+			name: "private not shared",
+			fields: fields{
+				repository: func() port.NoteRepository {
+					mockNoteRepository.EXPECT().GetByID(mock.Anything, privateEntity.ID).Return(&privateEntity, nil).Once()
+					return mockNoteRepository
+				}(),
+				shareRepository: func() port.NoteShareRepository {
+					mockNoteShareRepository.EXPECT().Get(mock.Anything, privateEntity.ID, privateEntity.UserID+1).Return(nil, fiber.NewError(fiber.StatusNotFound, "note share not found")).Once()
+					return mockNoteShareRepository
+				}(),
+			},
+			args: args{
+				req: domain.NoteRequest{
+					ID: privateEntity.ID,
+				},
+				claims: &domain.Claims{
+					UserID: privateEntity.UserID + 1,
+				},
+			},
+			want:    errors.New("you are not authorized to access this private note"),
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			h := &NoteService{
-				repository: tt.fields.repository,
+				repository:      tt.fields.repository,
+				shareRepository: tt.fields.shareRepository,
 			}
 
-			got, err := h.GetByID(tt.args.req.ID, tt.args.claims)
+			got, err := h.GetByID(context.Background(), tt.args.req.ID, tt.args.claims)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -196,7 +269,9 @@ func TestNoteService_GetByID(t *testing.T) {
 
 func TestNoteService_Update(t *testing.T) {
 	type fields struct {
-		repository port.NoteRepository
+		repository      port.NoteRepository
+		shareRepository port.NoteShareRepository
+		eventService    port.EventService
 	}
 
 	type args struct {
@@ -205,6 +280,8 @@ func TestNoteService_Update(t *testing.T) {
 	}
 
 	mockNoteRepository := mocks.NewNoteRepository(t)
+	mockNoteShareRepository := mocks.NewNoteShareRepository(t)
+	mockEventService := mocks.NewEventService(t)
 
 	tests := []struct {
 		name    string
@@ -217,10 +294,14 @@ func TestNoteService_Update(t *testing.T) {
 			name: "success",
 			fields: fields{
 				repository: func() port.NoteRepository {
-					mockNoteRepository.EXPECT().GetByID(mock.AnythingOfType("uint")).Return(noteEntity, nil).Once()
-					mockNoteRepository.EXPECT().Update(mock.AnythingOfType("domain.NoteUpdateRequest"), mock.AnythingOfType("*domain.Note")).Return(noteEntity, nil).Once()
+					mockNoteRepository.EXPECT().GetByID(mock.Anything, mock.AnythingOfType("uint")).Return(noteEntity, nil).Once()
+					mockNoteRepository.EXPECT().Update(mock.Anything, mock.AnythingOfType("domain.NoteUpdateRequest"), mock.AnythingOfType("*domain.Note")).Return(noteEntity, nil).Once()
 					return mockNoteRepository
 				}(),
+				eventService: func() port.EventService {
+					mockEventService.EXPECT().Publish(domain.NoteUpdated, mock.AnythingOfType("domain.Note"), mock.Anything).Once()
+					return mockEventService
+				}(),
 			},
 			args: args{
 				req: domain.NoteUpdateRequest{},
@@ -231,13 +312,107 @@ func TestNoteService_Update(t *testing.T) {
 			want:    noteEntity,
 			wantErr: false,
 		},
+		{
+			name: "shared editor",
+			fields: fields{
+				repository: func() port.NoteRepository {
+					mockNoteRepository.EXPECT().GetByID(mock.Anything, noteEntity.ID).Return(noteEntity, nil).Once()
+					mockNoteRepository.EXPECT().Update(mock.Anything, mock.MatchedBy(func(req domain.NoteUpdateRequest) bool {
+						return req.UserID == noteEntity.UserID
+					}), mock.AnythingOfType("*domain.Note")).Return(noteEntity, nil).Once()
+					return mockNoteRepository
+				}(),
+				eventService: func() port.EventService {
+					mockEventService.EXPECT().Publish(domain.NoteUpdated, mock.AnythingOfType("domain.Note"), mock.Anything).Once()
+					return mockEventService
+				}(),
+				shareRepository: func() port.NoteShareRepository {
+					mockNoteShareRepository.EXPECT().Get(mock.Anything, noteEntity.ID, noteEntity.UserID+1).Return(&domain.NoteShare{
+						NoteID:     noteEntity.ID,
+						UserID:     noteEntity.UserID + 1,
+						Permission: domain.Editor,
+					}, nil).Once()
+					return mockNoteShareRepository
+				}(),
+			},
+			args: args{
+				req: domain.NoteUpdateRequest{
+					ID:     noteEntity.ID,
+					UserID: noteEntity.UserID + 1,
+				},
+				claims: domain.Claims{
+					UserID: noteEntity.UserID + 1,
+				},
+			},
+			want:    noteEntity,
+			wantErr: false,
+		},
+		{
+			name: "shared editor changing visibility",
+			fields: fields{
+				repository: func() port.NoteRepository {
+					mockNoteRepository.EXPECT().GetByID(mock.Anything, noteEntity.ID).Return(noteEntity, nil).Once()
+					return mockNoteRepository
+				}(),
+				shareRepository: func() port.NoteShareRepository {
+					mockNoteShareRepository.EXPECT().Get(mock.Anything, noteEntity.ID, noteEntity.UserID+1).Return(&domain.NoteShare{
+						NoteID:     noteEntity.ID,
+						UserID:     noteEntity.UserID + 1,
+						Permission: domain.Editor,
+					}, nil).Once()
+					return mockNoteShareRepository
+				}(),
+			},
+			args: args{
+				req: domain.NoteUpdateRequest{
+					ID:         noteEntity.ID,
+					Visibility: string(domain.Private),
+				},
+				claims: domain.Claims{
+					UserID: noteEntity.UserID + 1,
+				},
+			},
+			want:    errors.New("only the owner can change the visibility of a note"),
+			wantErr: true,
+		},
+		{
+			name: "shared viewer",
+			fields: fields{
+				repository: func() port.NoteRepository {
+					mockNoteRepository.EXPECT().GetByID(mock.Anything, noteEntity.ID).Return(noteEntity, nil).Once()
+					return mockNoteRepository
+				}(),
+				shareRepository: func() port.NoteShareRepository {
+					mockNoteShareRepository.EXPECT().Get(mock.Anything, noteEntity.ID, noteEntity.UserID+1).Return(&domain.NoteShare{
+						NoteID:     noteEntity.ID,
+						UserID:     noteEntity.UserID + 1,
+						Permission: domain.Viewer,
+					}, nil).Once()
+					return mockNoteShareRepository
+				}(),
+			},
+			args: args{
+				req: domain.NoteUpdateRequest{
+					ID: noteEntity.ID,
+				},
+				claims: domain.Claims{
+					UserID: noteEntity.UserID + 1,
+				},
+			},
+			want:    errors.New("user does not have permission to perform this action"),
+			wantErr: true,
+		},
 		{
 			name: "permission denied",
 			fields: fields{
 				repository: func() port.NoteRepository {
-					mockNoteRepository.EXPECT().GetByID(noteEntity.UserID).Return(noteEntity, nil).Once()
+					mockNoteRepository.EXPECT().GetByID(mock.Anything, noteEntity.UserID).Return(noteEntity, nil).Once()
 					return mockNoteRepository
 				}(),
+				shareRepository: func() port.NoteShareRepository {
+					mockNoteShareRepository.EXPECT().Get(mock.Anything, noteEntity.ID, noteEntity.UserID+1).Return(nil, fiber.NewError(fiber.StatusNotFound, "note share not found")).Once()
+					return mockNoteShareRepository
+				}(),
 			},
 			args: args{
 				req: domain.NoteUpdateRequest{
@@ -255,10 +430,12 @@ func TestNoteService_Update(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			h := &NoteService{
-				repository: tt.fields.repository,
+				repository:      tt.fields.repository,
+				shareRepository: tt.fields.shareRepository,
+				eventService:    tt.fields.eventService,
 			}
 
-			got, err := h.Update(tt.args.req, tt.args.claims)
+			got, err := h.Update(context.Background(), tt.args.req, tt.args.claims)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -273,7 +450,8 @@ func TestNoteService_Update(t *testing.T) {
 
 func TestNoteService_Delete(t *testing.T) {
 	type fields struct {
-		repository port.NoteRepository
+		repository   port.NoteRepository
+		eventService port.EventService
 	}
 
 	type args struct {
@@ -282,6 +460,7 @@ func TestNoteService_Delete(t *testing.T) {
 	}
 
 	mockNoteRepository := mocks.NewNoteRepository(t)
+	mockEventService := mocks.NewEventService(t)
 
 	tests := []struct {
 		name    string
@@ -294,10 +473,14 @@ func TestNoteService_Delete(t *testing.T) {
 			name: "success",
 			fields: fields{
 				repository: func() port.NoteRepository {
-					mockNoteRepository.EXPECT().GetByID(mock.AnythingOfType("uint")).Return(noteEntity, nil).Once()
-					mockNoteRepository.EXPECT().Delete(mock.AnythingOfType("*domain.Note")).Return(nil).Once()
+					mockNoteRepository.EXPECT().GetByID(mock.Anything, mock.AnythingOfType("uint")).Return(noteEntity, nil).Once()
+					mockNoteRepository.EXPECT().Delete(mock.Anything, mock.AnythingOfType("*domain.Note")).Return(nil).Once()
 					return mockNoteRepository
 				}(),
+				eventService: func() port.EventService {
+					mockEventService.EXPECT().Publish(domain.NoteDeleted, mock.AnythingOfType("domain.Note"), mock.Anything).Once()
+					return mockEventService
+				}(),
 			},
 			args: args{
 				req: domain.NoteRequest{
@@ -314,7 +497,7 @@ func TestNoteService_Delete(t *testing.T) {
 			name: "permission denied",
 			fields: fields{
 				repository: func() port.NoteRepository {
-					mockNoteRepository.EXPECT().GetByID(noteEntity.ID).Return(noteEntity, nil).Once()
+					mockNoteRepository.EXPECT().GetByID(mock.Anything, noteEntity.ID).Return(noteEntity, nil).Once()
 					return mockNoteRepository
 				}(),
 			},
@@ -331,13 +514,170 @@ func TestNoteService_Delete(t *testing.T) {
 		},
 	}
 
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &NoteService{
+				repository:   tt.fields.repository,
+				eventService: tt.fields.eventService,
+			}
+
+			err := h.Delete(context.Background(), tt.args.req.ID, tt.args.claims)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.want.(error).Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNoteService_Restore(t *testing.T) {
+	type fields struct {
+		repository   port.NoteRepository
+		eventService port.EventService
+	}
+
+	type args struct {
+		id     uint
+		claims domain.Claims
+	}
+
+	mockNoteRepository := mocks.NewNoteRepository(t)
+	mockEventService := mocks.NewEventService(t)
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				repository: func() port.NoteRepository {
+					mockNoteRepository.EXPECT().GetTrashByID(mock.Anything, noteEntity.ID).Return(noteEntity, nil).Once()
+					mockNoteRepository.EXPECT().Restore(mock.Anything, mock.AnythingOfType("*domain.Note")).Return(noteEntity, nil).Once()
+					return mockNoteRepository
+				}(),
+				eventService: func() port.EventService {
+					mockEventService.EXPECT().Publish(domain.NoteCreated, mock.AnythingOfType("domain.Note"), mock.Anything).Once()
+					return mockEventService
+				}(),
+			},
+			args: args{
+				id: noteEntity.ID,
+				claims: domain.Claims{
+					UserID: noteEntity.UserID,
+				},
+			},
+			want:    noteEntity,
+			wantErr: false,
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				repository: func() port.NoteRepository {
+					mockNoteRepository.EXPECT().GetTrashByID(mock.Anything, noteEntity.ID).Return(noteEntity, nil).Once()
+					return mockNoteRepository
+				}(),
+			},
+			args: args{
+				id: noteEntity.ID,
+				claims: domain.Claims{
+					UserID: noteEntity.UserID + 1,
+				},
+			},
+			want:    errors.New("user does not have permission to perform this action"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &NoteService{
+				repository:   tt.fields.repository,
+				eventService: tt.fields.eventService,
+			}
+
+			got, err := h.Restore(context.Background(), tt.args.id, tt.args.claims)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.want.(error).Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNoteService_ForceDelete(t *testing.T) {
+	type fields struct {
+		repository port.NoteRepository
+	}
+
+	type args struct {
+		id     uint
+		claims domain.Claims
+	}
+
+	mockNoteRepository := mocks.NewNoteRepository(t)
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				repository: func() port.NoteRepository {
+					mockNoteRepository.EXPECT().GetTrashByID(mock.Anything, noteEntity.ID).Return(noteEntity, nil).Once()
+					mockNoteRepository.EXPECT().ForceDelete(mock.Anything, mock.AnythingOfType("*domain.Note")).Return(nil).Once()
+					return mockNoteRepository
+				}(),
+			},
+			args: args{
+				id: noteEntity.ID,
+				claims: domain.Claims{
+					UserID: noteEntity.UserID,
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				repository: func() port.NoteRepository {
+					mockNoteRepository.EXPECT().GetTrashByID(mock.Anything, noteEntity.ID).Return(noteEntity, nil).Once()
+					return mockNoteRepository
+				}(),
+			},
+			args: args{
+				id: noteEntity.ID,
+				claims: domain.Claims{
+					UserID: noteEntity.UserID + 1,
+				},
+			},
+			want:    errors.New("user does not have permission to perform this action"),
+			wantErr: true,
+		},
+	}
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			h := &NoteService{
 				repository: tt.fields.repository,
 			}
 
-			err := h.Delete(tt.args.req.ID, tt.args.claims)
+			err := h.ForceDelete(context.Background(), tt.args.id, tt.args.claims)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -348,3 +688,182 @@ func TestNoteService_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestNoteService_Purge(t *testing.T) {
+	mockNoteRepository := mocks.NewNoteRepository(t)
+	mockStorage := mocks.NewStorage(t)
+	mockNoteRepository.EXPECT().Purge(mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+		return time.Since(before) >= time.Hour
+	})).Return(2, []string{"attachments/1/a", "attachments/2/b"}, nil).Once()
+	mockStorage.EXPECT().Delete(mock.Anything, "attachments/1/a").Return(nil).Once()
+	// a file that cannot be deleted does not fail the purge
+	mockStorage.EXPECT().Delete(mock.Anything, "attachments/2/b").Return(errors.New("connection refused")).Once()
+
+	h := &NoteService{
+		repository: mockNoteRepository,
+		storage:    mockStorage,
+	}
+
+	got, err := h.Purge(context.Background(), time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), got)
+}
+
+func TestNoteService_Import(t *testing.T) {
+	type fields struct {
+		repository   port.NoteRepository
+		eventService port.EventService
+		metrics      port.Metrics
+	}
+
+	type args struct {
+		req      domain.NoteRequest
+		conflict domain.ImportConflict
+	}
+
+	mockNoteRepository := mocks.NewNoteRepository(t)
+	mockEventService := mocks.NewEventService(t)
+	mockMetrics := mocks.NewMetrics(t)
+	notFound := fiber.NewError(fiber.StatusNotFound, "note not found")
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		status  string
+		wantErr bool
+	}{
+		{
+			name: "created",
+			fields: fields{
+				repository: func() port.NoteRepository {
+					mockNoteRepository.EXPECT().GetByTitle(mock.Anything, noteEntity.UserID, "rust").Return(nil, notFound).Once()
+					mockNoteRepository.EXPECT().Create(mock.Anything, mock.AnythingOfType("domain.NoteRequest")).Return(noteEntity, nil).Once()
+					return mockNoteRepository
+				}(),
+				eventService: func() port.EventService {
+					mockEventService.EXPECT().Publish(domain.NoteCreated, mock.AnythingOfType("domain.Note"), mock.Anything).Once()
+					return mockEventService
+				}(),
+				metrics: func() port.Metrics {
+					mockMetrics.EXPECT().NoteCreated().Once()
+					return mockMetrics
+				}(),
+			},
+			args: args{
+				req:      domain.NoteRequest{Title: "rust", UserID: noteEntity.UserID},
+				conflict: domain.ImportSkip,
+			},
+			status: "created",
+		},
+		{
+			name: "skipped",
+			fields: fields{
+				repository: func() port.NoteRepository {
+					mockNoteRepository.EXPECT().GetByTitle(mock.Anything, noteEntity.UserID, noteEntity.Title).Return(noteEntity, nil).Once()
+					return mockNoteRepository
+				}(),
+				eventService: mockEventService,
+			},
+			args: args{
+				req:      domain.NoteRequest{Title: noteEntity.Title, UserID: noteEntity.UserID},
+				conflict: domain.ImportSkip,
+			},
+			status: "skipped",
+		},
+		{
+			name: "renamed",
+			fields: fields{
+				repository: func() port.NoteRepository {
+					mockNoteRepository.EXPECT().GetByTitle(mock.Anything, noteEntity.UserID, noteEntity.Title).Return(noteEntity, nil).Once()
+					mockNoteRepository.EXPECT().GetByTitle(mock.Anything, noteEntity.UserID, "golang (2)").Return(noteEntity, nil).Once()
+					mockNoteRepository.EXPECT().GetByTitle(mock.Anything, noteEntity.UserID, "golang (3)").Return(nil, notFound).Once()
+					mockNoteRepository.EXPECT().Create(mock.Anything, mock.MatchedBy(func(req domain.NoteRequest) bool {
+						return req.Title == "golang (3)"
+					})).Return(noteEntity, nil).Once()
+					return mockNoteRepository
+				}(),
+				eventService: func() port.EventService {
+					mockEventService.EXPECT().Publish(domain.NoteCreated, mock.AnythingOfType("domain.Note"), mock.Anything).Once()
+					return mockEventService
+				}(),
+				metrics: func() port.Metrics {
+					mockMetrics.EXPECT().NoteCreated().Once()
+					return mockMetrics
+				}(),
+			},
+			args: args{
+				req:      domain.NoteRequest{Title: noteEntity.Title, UserID: noteEntity.UserID},
+				conflict: domain.ImportRename,
+			},
+			status: "renamed",
+		},
+		{
+			name: "renamed long title",
+			fields: fields{
+				repository: func() port.NoteRepository {
+					mockNoteRepository.EXPECT().GetByTitle(mock.Anything, noteEntity.UserID, "abcdefghijklmnopqrstuvwxy").Return(noteEntity, nil).Once()
+					mockNoteRepository.EXPECT().GetByTitle(mock.Anything, noteEntity.UserID, "abcdefghijklmnopqrstu (2)").Return(nil, notFound).Once()
+					mockNoteRepository.EXPECT().Create(mock.Anything, mock.MatchedBy(func(req domain.NoteRequest) bool {
+						return req.Title == "abcdefghijklmnopqrstu (2)"
+					})).Return(noteEntity, nil).Once()
+					return mockNoteRepository
+				}(),
+				eventService: func() port.EventService {
+					mockEventService.EXPECT().Publish(domain.NoteCreated, mock.AnythingOfType("domain.Note"), mock.Anything).Once()
+					return mockEventService
+				}(),
+				metrics: func() port.Metrics {
+					mockMetrics.EXPECT().NoteCreated().Once()
+					return mockMetrics
+				}(),
+			},
+			args: args{
+				req:      domain.NoteRequest{Title: "abcdefghijklmnopqrstuvwxy", UserID: noteEntity.UserID},
+				conflict: domain.ImportRename,
+			},
+			status: "renamed",
+		},
+		{
+			name: "overwritten",
+			fields: fields{
+				repository: func() port.NoteRepository {
+					mockNoteRepository.EXPECT().GetByTitle(mock.Anything, noteEntity.UserID, noteEntity.Title).Return(noteEntity, nil).Once()
+					mockNoteRepository.EXPECT().Update(mock.Anything, mock.MatchedBy(func(req domain.NoteUpdateRequest) bool {
+						return req.ID == noteEntity.ID && req.Content == "new content"
+					}), noteEntity).Return(noteEntity, nil).Once()
+					return mockNoteRepository
+				}(),
+				eventService: func() port.EventService {
+					mockEventService.EXPECT().Publish(domain.NoteUpdated, mock.AnythingOfType("domain.Note"), mock.Anything).Once()
+					return mockEventService
+				}(),
+			},
+			args: args{
+				req:      domain.NoteRequest{Title: noteEntity.Title, Content: "new content", UserID: noteEntity.UserID},
+				conflict: domain.ImportOverwrite,
+			},
+			status: "overwritten",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &NoteService{
+				repository:   tt.fields.repository,
+				eventService: tt.fields.eventService,
+				metrics:      tt.fields.metrics,
+			}
+
+			got, status, err := h.Import(context.Background(), tt.args.req, tt.args.conflict)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, noteEntity, got)
+				assert.Equal(t, tt.status, status)
+			}
+		})
+	}
+}
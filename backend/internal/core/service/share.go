@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type NoteShareService struct {
+	repository     port.NoteShareRepository
+	noteRepository port.NoteRepository
+	userRepository port.UserRepository
+}
+
+func NewNoteShareService(repository port.NoteShareRepository, noteRepository port.NoteRepository, userRepository port.UserRepository) port.NoteShareService {
+	return &NoteShareService{
+		repository:     repository,
+		noteRepository: noteRepository,
+		userRepository: userRepository,
+	}
+}
+
+func (h *NoteShareService) GetAll(ctx context.Context, noteID uint, claims domain.Claims) ([]domain.NoteShare, error) {
+	if err := h.authorize(ctx, noteID, claims); err != nil {
+		return nil, err
+	}
+
+	return h.repository.GetAll(ctx, noteID)
+}
+
+func (h *NoteShareService) Save(ctx context.Context, req domain.NoteShareRequest, claims domain.Claims) (*domain.NoteShare, error) {
+	if err := h.authorize(ctx, req.NoteID, claims); err != nil {
+		return nil, err
+	}
+
+	if req.UserID == claims.UserID {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "cannot share a note with its owner")
+	}
+
+	if _, err := h.userRepository.GetByID(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+
+	return h.repository.Save(ctx, req)
+}
+
+func (h *NoteShareService) Delete(ctx context.Context, noteID uint, userID uint, claims domain.Claims) error {
+	if err := h.authorize(ctx, noteID, claims); err != nil {
+		return err
+	}
+
+	share, err := h.repository.Get(ctx, noteID, userID)
+	if err != nil {
+		return err
+	}
+
+	return h.repository.Delete(ctx, share)
+}
+
+func (h *NoteShareService) GetShared(ctx context.Context, metadata *domain.Metadata, claims domain.Claims) ([]domain.Note, error) {
+	return h.repository.GetShared(ctx, claims.UserID, metadata)
+}
+
+func (h *NoteShareService) authorize(ctx context.Context, noteID uint, claims domain.Claims) error {
+	note, err := h.noteRepository.GetByID(ctx, noteID)
+	if err != nil {
+		return err
+	}
+
+	if note.UserID != claims.UserID {
+		return fiber.NewError(fiber.StatusForbidden, "user does not have permission to perform this action")
+	}
+
+	return nil
+}
@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var noteShareEntity = &domain.NoteShare{
+	ID:         1,
+	NoteID:     1,
+	UserID:     2,
+	Permission: domain.Viewer,
+}
+
+func TestNoteShareService_GetAll(t *testing.T) {
+	type fields struct {
+		repository     port.NoteShareRepository
+		noteRepository port.NoteRepository
+	}
+
+	type args struct {
+		noteID uint
+		claims domain.Claims
+	}
+
+	mockNoteShareRepository := mocks.NewNoteShareRepository(t)
+	mockNoteRepository := mocks.NewNoteRepository(t)
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				repository: func() port.NoteShareRepository {
+					mockNoteShareRepository.EXPECT().GetAll(mock.Anything, noteEntity.ID).Return([]domain.NoteShare{*noteShareEntity}, nil).Once()
+					return mockNoteShareRepository
+				}(),
+				noteRepository: func() port.NoteRepository {
+					mockNoteRepository.EXPECT().GetByID(mock.Anything, noteEntity.ID).Return(noteEntity, nil).Once()
+					return mockNoteRepository
+				}(),
+			},
+			args: args{
+				noteID: noteEntity.ID,
+				claims: domain.Claims{
+					UserID: noteEntity.UserID,
+				},
+			},
+			want:    []domain.NoteShare{*noteShareEntity},
+			wantErr: false,
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				repository: mockNoteShareRepository,
+				noteRepository: func() port.NoteRepository {
+					mockNoteRepository.EXPECT().GetByID(mock.Anything, noteEntity.ID).Return(noteEntity, nil).Once()
+					return mockNoteRepository
+				}(),
+			},
+			args: args{
+				noteID: noteEntity.ID,
+				claims: domain.Claims{
+					UserID: noteEntity.UserID + 1,
+				},
+			},
+			want:    errors.New("user does not have permission to perform this action"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &NoteShareService{
+				repository:     tt.fields.repository,
+				noteRepository: tt.fields.noteRepository,
+			}
+
+			got, err := h.GetAll(context.Background(), tt.args.noteID, tt.args.claims)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.want.(error).Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNoteShareService_Save(t *testing.T) {
+	type fields struct {
+		repository     port.NoteShareRepository
+		noteRepository port.NoteRepository
+		userRepository port.UserRepository
+	}
+
+	type args struct {
+		req    domain.NoteShareRequest
+		claims domain.Claims
+	}
+
+	mockNoteShareRepository := mocks.NewNoteShareRepository(t)
+	mockNoteRepository := mocks.NewNoteRepository(t)
+	mockUserRepository := mocks.NewUserRepository(t)
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				repository: func() port.NoteShareRepository {
+					mockNoteShareRepository.EXPECT().Save(mock.Anything, mock.AnythingOfType("domain.NoteShareRequest")).Return(noteShareEntity, nil).Once()
+					return mockNoteShareRepository
+				}(),
+				noteRepository: func() port.NoteRepository {
+					mockNoteRepository.EXPECT().GetByID(mock.Anything, noteEntity.ID).Return(noteEntity, nil).Once()
+					return mockNoteRepository
+				}(),
+				userRepository: func() port.UserRepository {
+					mockUserRepository.EXPECT().GetByID(mock.Anything, noteShareEntity.UserID).Return(userEntity, nil).Once()
+					return mockUserRepository
+				}(),
+			},
+			args: args{
+				req: domain.NoteShareRequest{
+					NoteID:     noteEntity.ID,
+					UserID:     noteShareEntity.UserID,
+					Permission: string(domain.Viewer),
+				},
+				claims: domain.Claims{
+					UserID: noteEntity.UserID,
+				},
+			},
+			want:    noteShareEntity,
+			wantErr: false,
+		},
+		{
+			name: "share with owner",
+			fields: fields{
+				repository: mockNoteShareRepository,
+				noteRepository: func() port.NoteRepository {
+					mockNoteRepository.EXPECT().GetByID(mock.Anything, noteEntity.ID).Return(noteEntity, nil).Once()
+					return mockNoteRepository
+				}(),
+				userRepository: mockUserRepository,
+			},
+			args: args{
+				req: domain.NoteShareRequest{
+					NoteID:     noteEntity.ID,
+					UserID:     noteEntity.UserID,
+					Permission: string(domain.Editor),
+				},
+				claims: domain.Claims{
+					UserID: noteEntity.UserID,
+				},
+			},
+			want:    errors.New("cannot share a note with its owner"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &NoteShareService{
+				repository:     tt.fields.repository,
+				noteRepository: tt.fields.noteRepository,
+				userRepository: tt.fields.userRepository,
+			}
+
+			got, err := h.Save(context.Background(), tt.args.req, tt.args.claims)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.want.(error).Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNoteShareService_Delete(t *testing.T) {
+	type fields struct {
+		repository     port.NoteShareRepository
+		noteRepository port.NoteRepository
+	}
+
+	type args struct {
+		noteID uint
+		userID uint
+		claims domain.Claims
+	}
+
+	mockNoteShareRepository := mocks.NewNoteShareRepository(t)
+	mockNoteRepository := mocks.NewNoteRepository(t)
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				repository: func() port.NoteShareRepository {
+					mockNoteShareRepository.EXPECT().Get(mock.Anything, noteEntity.ID, noteShareEntity.UserID).Return(noteShareEntity, nil).Once()
+					mockNoteShareRepository.EXPECT().Delete(mock.Anything, noteShareEntity).Return(nil).Once()
+					return mockNoteShareRepository
+				}(),
+				noteRepository: func() port.NoteRepository {
+					mockNoteRepository.EXPECT().GetByID(mock.Anything, noteEntity.ID).Return(noteEntity, nil).Once()
+					return mockNoteRepository
+				}(),
+			},
+			args: args{
+				noteID: noteEntity.ID,
+				userID: noteShareEntity.UserID,
+				claims: domain.Claims{
+					UserID: noteEntity.UserID,
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &NoteShareService{
+				repository:     tt.fields.repository,
+				noteRepository: tt.fields.noteRepository,
+			}
+
+			err := h.Delete(context.Background(), tt.args.noteID, tt.args.userID, tt.args.claims)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.want.(error).Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
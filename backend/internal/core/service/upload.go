@@ -0,0 +1,287 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image/png"
+	"io"
+	"net/http"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// uploadTypes are the image types accepted for covers and avatars along with
+// the extension their keys get, which is what util.Validator checks for.
+var uploadTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+	"image/gif":  ".gif",
+}
+
+// uploadQueueSize bounds the uploads waiting for the image worker, uploads
+// that do not fit are picked up by its next scan for pending uploads.
+const uploadQueueSize = 100
+
+type UploadService struct {
+	repository port.UploadRepository
+	storage    port.Storage
+	cfg        *config.Config
+	queue      chan uint
+}
+
+func NewUploadService(repository port.UploadRepository, storage port.Storage, cfg *config.Config) port.UploadService {
+	return &UploadService{
+		repository: repository,
+		storage:    storage,
+		cfg:        cfg,
+		queue:      make(chan uint, uploadQueueSize),
+	}
+}
+
+// Create stores an uploaded image. The content type is sniffed from the data
+// itself instead of trusting the name or the header sent by the client. The
+// image is kept under util.PendingKey until it is processed in the background,
+// it is only served once it is ready.
+func (s *UploadService) Create(ctx context.Context, file io.Reader, size int64, claims domain.Claims) (*domain.Upload, error) {
+	if size > s.cfg.Storage.MaxSize {
+		return nil, fiber.NewError(fiber.StatusRequestEntityTooLarge, fmt.Sprintf("file must not be larger than %d bytes", s.cfg.Storage.MaxSize))
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "failed to read file")
+	}
+	head = head[:n]
+
+	contentType := http.DetectContentType(head)
+	ext, ok := uploadTypes[contentType]
+	if !ok {
+		return nil, fiber.NewError(fiber.StatusUnsupportedMediaType, "file must be a jpeg, png, webp or gif image")
+	}
+
+	token, err := util.GenerateToken(16)
+	if err != nil {
+		return nil, err
+	}
+
+	upload := &domain.Upload{
+		UserID:      claims.UserID,
+		Key:         fmt.Sprintf("%d/%s%s", claims.UserID, token, ext),
+		ContentType: contentType,
+		Size:        size,
+		Status:      domain.UploadPending,
+	}
+
+	pending := util.PendingKey(upload.Key)
+	if err := s.storage.Put(ctx, pending, io.MultiReader(bytes.NewReader(head), file), size, contentType); err != nil {
+		return nil, err
+	}
+
+	if err := s.repository.Create(ctx, upload); err != nil {
+		// the file goes even when the query failed because ctx is done
+		if err := s.storage.Delete(context.WithoutCancel(ctx), pending); err != nil {
+			util.Logger(ctx).Warn("failed to delete an unsaved file", "key", pending, "err", err)
+		}
+		return nil, err
+	}
+
+	select {
+	case s.queue <- upload.ID:
+	default:
+	}
+
+	return upload, nil
+}
+
+// Open returns an uploaded image or one of its variants. Images that are not
+// processed yet still carry their metadata, so they are not served.
+func (s *UploadService) Open(ctx context.Context, key string) (*domain.Upload, io.ReadCloser, error) {
+	upload, err := s.repository.GetByKey(ctx, key)
+	if isNotFound(err) {
+		variant, err := s.repository.GetVariantByKey(ctx, key)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		upload = &domain.Upload{
+			ID:          variant.UploadID,
+			Key:         variant.Key,
+			ContentType: variant.ContentType,
+			Size:        variant.Size,
+			Status:      domain.UploadReady,
+		}
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	if upload.Status != domain.UploadReady {
+		return nil, nil, fiber.NewError(fiber.StatusNotFound, "file not found")
+	}
+
+	file, err := s.storage.Get(ctx, upload.Key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return upload, file, nil
+}
+
+func (s *UploadService) Delete(ctx context.Context, id uint, claims domain.Claims) error {
+	upload, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if upload.UserID != claims.UserID {
+		return fiber.NewError(fiber.StatusForbidden, "user does not have permission to perform this action")
+	}
+
+	if err := s.repository.Delete(ctx, upload); err != nil {
+		return err
+	}
+
+	for _, variant := range upload.Variants {
+		if err := s.storage.Delete(ctx, variant.Key); err != nil {
+			return err
+		}
+	}
+
+	if err := s.storage.Delete(ctx, util.PendingKey(upload.Key)); err != nil {
+		return err
+	}
+	return s.storage.Delete(ctx, upload.Key)
+}
+
+func (s *UploadService) URL(upload *domain.Upload) string {
+	return s.cfg.Storage.PublicURL + "/" + upload.Key
+}
+
+// Process normalizes a pending upload and generates its variants. An image
+// that cannot be decoded is marked as failed. Either way the original it was
+// made from is deleted once the upload is saved.
+func (s *UploadService) Process(ctx context.Context, id uint) error {
+	upload, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if upload.Status != domain.UploadPending {
+		return nil
+	}
+
+	processErr := s.process(ctx, upload)
+	if processErr != nil {
+		upload.Status = domain.UploadFailed
+		upload.Variants = nil
+	} else {
+		upload.Status = domain.UploadReady
+	}
+	if err := s.repository.Update(ctx, upload); err != nil {
+		return err
+	}
+
+	if err := s.storage.Delete(ctx, util.PendingKey(upload.Key)); err != nil {
+		util.Logger(ctx).Warn("failed to delete a processed original", "key", util.PendingKey(upload.Key), "err", err)
+	}
+
+	return processErr
+}
+
+func (s *UploadService) Pending(ctx context.Context) ([]uint, error) {
+	uploads, err := s.repository.GetPending(ctx, uploadQueueSize)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint, 0, len(uploads))
+	for _, upload := range uploads {
+		ids = append(ids, upload.ID)
+	}
+
+	return ids, nil
+}
+
+func (s *UploadService) Queue() <-chan uint {
+	return s.queue
+}
+
+func (s *UploadService) process(ctx context.Context, upload *domain.Upload) error {
+	file, err := s.storage.Get(ctx, util.PendingKey(upload.Key))
+	if err != nil {
+		return err
+	}
+	data, err := io.ReadAll(io.LimitReader(file, s.cfg.Storage.MaxSize+1))
+	file.Close()
+	if err != nil {
+		return err
+	}
+
+	img, format, err := util.DecodeImage(data, s.cfg.Images.MaxSize)
+	if err != nil {
+		return err
+	}
+
+	// re-encoding the original drops its EXIF data along with any other
+	// metadata. GIFs have none worth stripping and would lose their animation,
+	// so they are published as they are
+	contentType := upload.ContentType
+	if format != "gif" {
+		var buf bytes.Buffer
+		contentType = "image/jpeg"
+
+		if format == "png" || (format == "webp" && !util.Opaque(img)) {
+			contentType = "image/png"
+			err = png.Encode(&buf, img)
+		} else {
+			err = util.EncodeJPEG(&buf, img, s.cfg.Images.Quality)
+		}
+		if err != nil {
+			return err
+		}
+		data = buf.Bytes()
+	}
+
+	if err := s.storage.Put(ctx, upload.Key, bytes.NewReader(data), int64(len(data)), contentType); err != nil {
+		return err
+	}
+	upload.ContentType = contentType
+	upload.Size = int64(len(data))
+
+	bounds := img.Bounds()
+	upload.Width = bounds.Dx()
+	upload.Height = bounds.Dy()
+
+	for _, variant := range util.ImageVariants {
+		resized := util.ResizeImage(img, variant.Size, variant.Crop)
+
+		var buf bytes.Buffer
+		if err := util.EncodeJPEG(&buf, resized, s.cfg.Images.Quality); err != nil {
+			return err
+		}
+
+		key := util.VariantKey(upload.Key, variant.Name)
+		if err := s.storage.Put(ctx, key, bytes.NewReader(buf.Bytes()), int64(buf.Len()), "image/jpeg"); err != nil {
+			return err
+		}
+
+		upload.Variants = append(upload.Variants, domain.UploadVariant{
+			UploadID:    upload.ID,
+			Name:        variant.Name,
+			Key:         key,
+			ContentType: "image/jpeg",
+			Size:        int64(buf.Len()),
+			Width:       resized.Bounds().Dx(),
+			Height:      resized.Bounds().Dy(),
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,410 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+	"github.com/shironxn/blanknotes/internal/core/port"
+	"github.com/shironxn/blanknotes/internal/mocks"
+	"github.com/shironxn/blanknotes/internal/util"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+var uploadEntity = &domain.Upload{
+	ID:          1,
+	UserID:      1,
+	Key:         "1/c2hpcm9u.png",
+	ContentType: "image/png",
+	Size:        int64(len(pngHeader)),
+	Status:      domain.UploadReady,
+}
+
+func TestUploadService_Create(t *testing.T) {
+	type fields struct {
+		repository port.UploadRepository
+		storage    port.Storage
+	}
+
+	type args struct {
+		file   []byte
+		size   int64
+		claims domain.Claims
+	}
+
+	mockUploadRepository := mocks.NewUploadRepository(t)
+	mockStorage := mocks.NewStorage(t)
+
+	cfg := &config.Config{}
+	cfg.Storage.MaxSize = 1 << 10
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				repository: func() port.UploadRepository {
+					mockUploadRepository.EXPECT().Create(mock.Anything, mock.AnythingOfType("*domain.Upload")).Return(nil).Once()
+					return mockUploadRepository
+				}(),
+				storage: func() port.Storage {
+					mockStorage.EXPECT().Put(mock.Anything, mock.MatchedBy(func(key string) bool {
+						return strings.HasPrefix(key, "pending/1/") && strings.HasSuffix(key, ".png")
+					}), mock.Anything, int64(len(pngHeader)), "image/png").Return(nil).Once()
+					return mockStorage
+				}(),
+			},
+			args: args{
+				file: pngHeader,
+				size: int64(len(pngHeader)),
+				claims: domain.Claims{
+					UserID: 1,
+				},
+			},
+			want:    "image/png",
+			wantErr: false,
+		},
+		{
+			name: "file too large",
+			fields: fields{
+				repository: mockUploadRepository,
+				storage:    mockStorage,
+			},
+			args: args{
+				file: pngHeader,
+				size: 1 << 11,
+			},
+			want:    errors.New("file must not be larger than 1024 bytes"),
+			wantErr: true,
+		},
+		{
+			name: "unsupported type",
+			fields: fields{
+				repository: mockUploadRepository,
+				storage:    mockStorage,
+			},
+			args: args{
+				file: []byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"),
+				size: 46,
+			},
+			want:    errors.New("file must be a jpeg, png, webp or gif image"),
+			wantErr: true,
+		},
+		{
+			name: "repository error removes stored file",
+			fields: fields{
+				repository: func() port.UploadRepository {
+					mockUploadRepository.EXPECT().Create(mock.Anything, mock.AnythingOfType("*domain.Upload")).Return(errors.New("failed to create upload")).Once()
+					return mockUploadRepository
+				}(),
+				storage: func() port.Storage {
+					mockStorage.EXPECT().Put(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+					mockStorage.EXPECT().Delete(mock.Anything, mock.MatchedBy(func(key string) bool {
+						return strings.HasPrefix(key, "pending/")
+					})).Return(nil).Once()
+					return mockStorage
+				}(),
+			},
+			args: args{
+				file: pngHeader,
+				size: int64(len(pngHeader)),
+			},
+			want:    errors.New("failed to create upload"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &UploadService{
+				repository: tt.fields.repository,
+				storage:    tt.fields.storage,
+				cfg:        cfg,
+			}
+
+			got, err := s.Create(context.Background(), bytes.NewReader(tt.args.file), tt.args.size, tt.args.claims)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.want.(error).Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got.ContentType)
+				assert.Equal(t, tt.args.claims.UserID, got.UserID)
+			}
+		})
+	}
+}
+
+func TestUploadService_Delete(t *testing.T) {
+	type fields struct {
+		repository port.UploadRepository
+		storage    port.Storage
+	}
+
+	type args struct {
+		id     uint
+		claims domain.Claims
+	}
+
+	mockUploadRepository := mocks.NewUploadRepository(t)
+	mockStorage := mocks.NewStorage(t)
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				repository: func() port.UploadRepository {
+					mockUploadRepository.EXPECT().GetByID(mock.Anything, uploadEntity.ID).Return(uploadEntity, nil).Once()
+					mockUploadRepository.EXPECT().Delete(mock.Anything, uploadEntity).Return(nil).Once()
+					return mockUploadRepository
+				}(),
+				storage: func() port.Storage {
+					mockStorage.EXPECT().Delete(mock.Anything, util.PendingKey(uploadEntity.Key)).Return(nil).Once()
+					mockStorage.EXPECT().Delete(mock.Anything, uploadEntity.Key).Return(nil).Once()
+					return mockStorage
+				}(),
+			},
+			args: args{
+				id: uploadEntity.ID,
+				claims: domain.Claims{
+					UserID: uploadEntity.UserID,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "not the owner",
+			fields: fields{
+				repository: func() port.UploadRepository {
+					mockUploadRepository.EXPECT().GetByID(mock.Anything, uploadEntity.ID).Return(uploadEntity, nil).Once()
+					return mockUploadRepository
+				}(),
+				storage: mockStorage,
+			},
+			args: args{
+				id: uploadEntity.ID,
+				claims: domain.Claims{
+					UserID: 2,
+				},
+			},
+			want:    errors.New("user does not have permission to perform this action"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &UploadService{
+				repository: tt.fields.repository,
+				storage:    tt.fields.storage,
+			}
+
+			err := s.Delete(context.Background(), tt.args.id, tt.args.claims)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.want.(error).Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUploadService_Open(t *testing.T) {
+	type fields struct {
+		repository port.UploadRepository
+		storage    port.Storage
+	}
+
+	mockUploadRepository := mocks.NewUploadRepository(t)
+	mockStorage := mocks.NewStorage(t)
+	variantKey := util.VariantKey(uploadEntity.Key, "small")
+
+	tests := []struct {
+		name    string
+		fields  fields
+		key     string
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				repository: func() port.UploadRepository {
+					mockUploadRepository.EXPECT().GetByKey(mock.Anything, uploadEntity.Key).Return(uploadEntity, nil).Once()
+					return mockUploadRepository
+				}(),
+				storage: func() port.Storage {
+					mockStorage.EXPECT().Get(mock.Anything, uploadEntity.Key).Return(io.NopCloser(bytes.NewReader(pngHeader)), nil).Once()
+					return mockStorage
+				}(),
+			},
+			key:     uploadEntity.Key,
+			want:    "image/png",
+			wantErr: false,
+		},
+		{
+			name: "variant",
+			fields: fields{
+				repository: func() port.UploadRepository {
+					mockUploadRepository.EXPECT().GetByKey(mock.Anything, variantKey).Return(nil, fiber.NewError(fiber.StatusNotFound, "upload not found")).Once()
+					mockUploadRepository.EXPECT().GetVariantByKey(mock.Anything, variantKey).Return(&domain.UploadVariant{
+						UploadID:    uploadEntity.ID,
+						Key:         variantKey,
+						ContentType: "image/jpeg",
+					}, nil).Once()
+					return mockUploadRepository
+				}(),
+				storage: func() port.Storage {
+					mockStorage.EXPECT().Get(mock.Anything, variantKey).Return(io.NopCloser(bytes.NewReader(pngHeader)), nil).Once()
+					return mockStorage
+				}(),
+			},
+			key:     variantKey,
+			want:    "image/jpeg",
+			wantErr: false,
+		},
+		{
+			name: "still processing",
+			fields: fields{
+				repository: func() port.UploadRepository {
+					mockUploadRepository.EXPECT().GetByKey(mock.Anything, uploadEntity.Key).Return(&domain.Upload{
+						Key:    uploadEntity.Key,
+						Status: domain.UploadPending,
+					}, nil).Once()
+					return mockUploadRepository
+				}(),
+				storage: mockStorage,
+			},
+			key:     uploadEntity.Key,
+			want:    errors.New("file not found"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &UploadService{
+				repository: tt.fields.repository,
+				storage:    tt.fields.storage,
+			}
+
+			upload, file, err := s.Open(context.Background(), tt.key)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.want.(error).Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, upload.ContentType)
+				file.Close()
+			}
+		})
+	}
+}
+
+func TestUploadService_Process(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 1000, 500))
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, img))
+
+	cfg := &config.Config{}
+	cfg.Storage.MaxSize = 1 << 20
+	cfg.Images.MaxSize = 2000
+	cfg.Images.Quality = 80
+
+	mockUploadRepository := mocks.NewUploadRepository(t)
+	mockStorage := mocks.NewStorage(t)
+
+	pending := &domain.Upload{
+		ID:          1,
+		UserID:      1,
+		Key:         "1/c2hpcm9u.png",
+		ContentType: "image/png",
+		Status:      domain.UploadPending,
+	}
+
+	mockUploadRepository.EXPECT().GetByID(mock.Anything, pending.ID).Return(pending, nil).Once()
+	mockStorage.EXPECT().Get(mock.Anything, util.PendingKey(pending.Key)).Return(io.NopCloser(bytes.NewReader(buf.Bytes())), nil).Once()
+	mockStorage.EXPECT().Put(mock.Anything, pending.Key, mock.Anything, mock.Anything, "image/png").Return(nil).Once()
+	for _, variant := range util.ImageVariants {
+		mockStorage.EXPECT().Put(mock.Anything, util.VariantKey(pending.Key, variant.Name), mock.Anything, mock.Anything, "image/jpeg").Return(nil).Once()
+	}
+	mockUploadRepository.EXPECT().Update(mock.Anything, mock.MatchedBy(func(upload *domain.Upload) bool {
+		return upload.Status == domain.UploadReady && upload.Width == 1000 && upload.Height == 500 && len(upload.Variants) == len(util.ImageVariants)
+	})).Return(nil).Once()
+	mockStorage.EXPECT().Delete(mock.Anything, util.PendingKey(pending.Key)).Return(nil).Once()
+
+	s := &UploadService{
+		repository: mockUploadRepository,
+		storage:    mockStorage,
+		cfg:        cfg,
+	}
+
+	assert.NoError(t, s.Process(context.Background(), pending.ID))
+
+	for _, variant := range pending.Variants {
+		switch variant.Name {
+		case "thumbnail":
+			assert.Equal(t, 160, variant.Width)
+			assert.Equal(t, 160, variant.Height)
+		case "small":
+			assert.Equal(t, 400, variant.Width)
+			assert.Equal(t, 200, variant.Height)
+		}
+	}
+}
+
+func TestUploadService_ProcessInvalid(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Storage.MaxSize = 1 << 20
+
+	mockUploadRepository := mocks.NewUploadRepository(t)
+	mockStorage := mocks.NewStorage(t)
+
+	pending := &domain.Upload{
+		ID:     1,
+		Key:    "1/c2hpcm9u.png",
+		Status: domain.UploadPending,
+	}
+
+	mockUploadRepository.EXPECT().GetByID(mock.Anything, pending.ID).Return(pending, nil).Once()
+	mockStorage.EXPECT().Get(mock.Anything, util.PendingKey(pending.Key)).Return(io.NopCloser(bytes.NewReader(pngHeader)), nil).Once()
+	mockUploadRepository.EXPECT().Update(mock.Anything, mock.MatchedBy(func(upload *domain.Upload) bool {
+		return upload.Status == domain.UploadFailed
+	})).Return(nil).Once()
+	mockStorage.EXPECT().Delete(mock.Anything, util.PendingKey(pending.Key)).Return(nil).Once()
+
+	s := &UploadService{
+		repository: mockUploadRepository,
+		storage:    mockStorage,
+		cfg:        cfg,
+	}
+
+	assert.Error(t, s.Process(context.Background(), pending.ID))
+}
@@ -1,6 +1,10 @@
 package service
 
 import (
+	"context"
+	"errors"
+	"time"
+
 	"github.com/shironxn/blanknotes/internal/core/domain"
 	"github.com/shironxn/blanknotes/internal/core/port"
 	"github.com/shironxn/blanknotes/internal/util"
@@ -10,32 +14,34 @@ import (
 
 type UserService struct {
 	repository port.UserRepository
+	storage    port.Storage
 	bcrypt     util.Bcrypt
 }
 
-func NewUserService(repository port.UserRepository, bcrypt util.Bcrypt) port.UserService {
+func NewUserService(repository port.UserRepository, storage port.Storage, bcrypt util.Bcrypt) port.UserService {
 	return &UserService{
 		repository: repository,
+		storage:    storage,
 		bcrypt:     bcrypt,
 	}
 }
 
-func (h *UserService) GetAll(req domain.UserQuery, metdata *domain.Metadata) ([]domain.User, error) {
-	return h.repository.GetAll(req, metdata)
+func (h *UserService) GetAll(ctx context.Context, req domain.UserQuery, metdata *domain.Metadata) ([]domain.User, error) {
+	return h.repository.GetAll(ctx, req, metdata)
 }
 
-func (h *UserService) GetByID(id uint) (*domain.User, error) {
-	return h.repository.GetByID(id)
+func (h *UserService) GetByID(ctx context.Context, id uint) (*domain.User, error) {
+	return h.repository.GetByID(ctx, id)
 }
 
-func (h *UserService) Update(req domain.UserRequest, claims domain.Claims) (*domain.User, error) {
-	user, err := h.repository.GetByID(req.ID)
+func (h *UserService) Update(ctx context.Context, req domain.UserRequest, claims domain.Claims) (*domain.User, error) {
+	user, err := h.repository.GetByID(ctx, req.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	if user.ID != claims.UserID {
-		return nil, fiber.NewError(fiber.StatusForbidden, "user does not have permission to perform this action")
+	if err := h.authorize(ctx, user, claims); err != nil {
+		return nil, err
 	}
 
 	if req.Password != "" {
@@ -46,18 +52,58 @@ func (h *UserService) Update(req domain.UserRequest, claims domain.Claims) (*dom
 		req.Password = string(hashedPassword)
 	}
 
-	return h.repository.Update(req, user)
+	return h.repository.Update(ctx, req, user)
+}
+
+func (h *UserService) Delete(ctx context.Context, req domain.UserRequest, claims domain.Claims) error {
+	user, err := h.repository.GetByID(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := h.authorize(ctx, user, claims); err != nil {
+		return err
+	}
+
+	return h.repository.Delete(ctx, user)
 }
 
-func (h *UserService) Delete(req domain.UserRequest, claims domain.Claims) error {
-	user, err := h.repository.GetByID(req.ID)
+// authorize lets users change their own account and admins change any
+// account, so they can deal with abuse without touching the database.
+func (h *UserService) authorize(ctx context.Context, user *domain.User, claims domain.Claims) error {
+	if user.ID == claims.UserID {
+		return nil
+	}
+
+	actor, err := h.repository.GetByID(ctx, claims.UserID)
 	if err != nil {
+		var fiberErr *fiber.Error
+		if errors.As(err, &fiberErr) && fiberErr.Code == fiber.StatusNotFound {
+			return fiber.NewError(fiber.StatusForbidden, "user does not have permission to perform this action")
+		}
 		return err
 	}
 
-	if user.ID != claims.UserID {
+	if actor.Role != domain.RoleAdmin {
 		return fiber.NewError(fiber.StatusForbidden, "user does not have permission to perform this action")
 	}
 
-	return h.repository.Delete(user)
+	return nil
+}
+
+// Purge deletes the users past their grace period, then the files they left.
+// A file that cannot be deleted is logged and left behind, its row is gone.
+func (h *UserService) Purge(ctx context.Context, gracePeriod time.Duration) (int64, error) {
+	count, keys, err := h.repository.Purge(ctx, time.Now().Add(-gracePeriod))
+	if err != nil {
+		return 0, err
+	}
+
+	for _, key := range keys {
+		if err := h.storage.Delete(ctx, key); err != nil {
+			util.Logger(ctx).Warn("delete file of purged user", "key", key, "err", err)
+		}
+	}
+
+	return count, nil
 }
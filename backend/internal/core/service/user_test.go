@@ -1,14 +1,17 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/shironxn/blanknotes/internal/core/domain"
 	"github.com/shironxn/blanknotes/internal/core/port"
 	"github.com/shironxn/blanknotes/internal/mocks"
 	"github.com/shironxn/blanknotes/internal/util"
 
+	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"gorm.io/gorm"
@@ -51,7 +54,7 @@ func TestUserService_GetAll(t *testing.T) {
 			name: "success",
 			fields: fields{
 				repository: func() port.UserRepository {
-					mockUserRepository.EXPECT().GetAll(mock.AnythingOfType("domain.UserQuery"), mock.AnythingOfType("*domain.Metadata")).Return(userEntity, nil).Once()
+					mockUserRepository.EXPECT().GetAll(mock.Anything, mock.AnythingOfType("domain.UserQuery"), mock.AnythingOfType("*domain.Metadata")).Return(userEntity, nil).Once()
 					return mockUserRepository
 				}(),
 			},
@@ -70,7 +73,7 @@ func TestUserService_GetAll(t *testing.T) {
 				repository: tt.fields.repository,
 			}
 
-			got, err := h.GetAll(tt.args.req, &tt.args.metadata)
+			got, err := h.GetAll(context.Background(), tt.args.req, &tt.args.metadata)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -105,7 +108,7 @@ func TestUserService_GetByID(t *testing.T) {
 			name: "success",
 			fields: fields{
 				repository: func() port.UserRepository {
-					mockUserRepository.EXPECT().GetByID(mock.AnythingOfType("uint")).Return(userEntity, nil).Times(2).Once()
+					mockUserRepository.EXPECT().GetByID(mock.Anything, mock.AnythingOfType("uint")).Return(userEntity, nil).Times(2).Once()
 					return mockUserRepository
 				}(),
 			},
@@ -125,7 +128,7 @@ func TestUserService_GetByID(t *testing.T) {
 				repository: tt.fields.repository,
 			}
 
-			got, err := h.GetByID(tt.args.req.ID)
+			got, err := h.GetByID(context.Background(), tt.args.req.ID)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -164,8 +167,8 @@ func TestUserService_Update(t *testing.T) {
 			name: "success",
 			fields: fields{
 				repository: func() port.UserRepository {
-					mockUserRepository.EXPECT().GetByID(mock.AnythingOfType("uint")).Return(userEntity, nil).Once()
-					mockUserRepository.EXPECT().Update(mock.AnythingOfType("domain.UserRequest"), mock.AnythingOfType("*domain.User")).Return(userEntity, nil).Once()
+					mockUserRepository.EXPECT().GetByID(mock.Anything, mock.AnythingOfType("uint")).Return(userEntity, nil).Once()
+					mockUserRepository.EXPECT().Update(mock.Anything, mock.AnythingOfType("domain.UserRequest"), mock.AnythingOfType("*domain.User")).Return(userEntity, nil).Once()
 					return mockUserRepository
 				}(),
 				bcrypt: bcrypt,
@@ -183,7 +186,8 @@ func TestUserService_Update(t *testing.T) {
 			name: "permission denied",
 			fields: fields{
 				repository: func() port.UserRepository {
-					mockUserRepository.EXPECT().GetByID(userEntity.ID).Return(userEntity, nil).Once()
+					mockUserRepository.EXPECT().GetByID(mock.Anything, userEntity.ID).Return(userEntity, nil).Once()
+					mockUserRepository.EXPECT().GetByID(mock.Anything, userEntity.ID+1).Return(&domain.User{Model: gorm.Model{ID: userEntity.ID + 1}, Role: domain.RoleUser}, nil).Once()
 					return mockUserRepository
 				}(),
 			},
@@ -198,6 +202,28 @@ func TestUserService_Update(t *testing.T) {
 			want:    errors.New("user does not have permission to perform this action"),
 			wantErr: true,
 		},
+		{
+			name: "admin",
+			fields: fields{
+				repository: func() port.UserRepository {
+					mockUserRepository.EXPECT().GetByID(mock.Anything, userEntity.ID).Return(userEntity, nil).Once()
+					mockUserRepository.EXPECT().GetByID(mock.Anything, userEntity.ID+1).Return(&domain.User{Model: gorm.Model{ID: userEntity.ID + 1}, Role: domain.RoleAdmin}, nil).Once()
+					mockUserRepository.EXPECT().Update(mock.Anything, mock.AnythingOfType("domain.UserRequest"), userEntity).Return(userEntity, nil).Once()
+					return mockUserRepository
+				}(),
+				bcrypt: bcrypt,
+			},
+			args: args{
+				req: domain.UserRequest{
+					ID: userEntity.ID,
+				},
+				claims: domain.Claims{
+					UserID: userEntity.ID + 1,
+				},
+			},
+			want:    userEntity,
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -207,7 +233,7 @@ func TestUserService_Update(t *testing.T) {
 				bcrypt:     tt.fields.bcrypt,
 			}
 
-			got, err := h.Update(tt.args.req, tt.args.claims)
+			got, err := h.Update(context.Background(), tt.args.req, tt.args.claims)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -243,8 +269,8 @@ func TestUserService_Delete(t *testing.T) {
 			name: "success",
 			fields: fields{
 				repository: func() port.UserRepository {
-					mockUserRepository.EXPECT().GetByID(mock.AnythingOfType("uint")).Return(userEntity, nil).Once()
-					mockUserRepository.EXPECT().Delete(mock.AnythingOfType("*domain.User")).Return(nil).Once()
+					mockUserRepository.EXPECT().GetByID(mock.Anything, mock.AnythingOfType("uint")).Return(userEntity, nil).Once()
+					mockUserRepository.EXPECT().Delete(mock.Anything, mock.AnythingOfType("*domain.User")).Return(nil).Once()
 					return mockUserRepository
 				}(),
 			},
@@ -263,7 +289,8 @@ func TestUserService_Delete(t *testing.T) {
 			name: "permission denied",
 			fields: fields{
 				repository: func() port.UserRepository {
-					mockUserRepository.EXPECT().GetByID(mock.AnythingOfType("uint")).Return(userEntity, nil).Once()
+					mockUserRepository.EXPECT().GetByID(mock.Anything, userEntity.ID).Return(userEntity, nil).Once()
+					mockUserRepository.EXPECT().GetByID(mock.Anything, userEntity.ID+1).Return(nil, fiber.NewError(fiber.StatusNotFound, "user not found")).Once()
 					return mockUserRepository
 				}(),
 			},
@@ -278,6 +305,27 @@ func TestUserService_Delete(t *testing.T) {
 			want:    errors.New("user does not have permission to perform this action"),
 			wantErr: true,
 		},
+		{
+			name: "admin",
+			fields: fields{
+				repository: func() port.UserRepository {
+					mockUserRepository.EXPECT().GetByID(mock.Anything, userEntity.ID).Return(userEntity, nil).Once()
+					mockUserRepository.EXPECT().GetByID(mock.Anything, userEntity.ID+1).Return(&domain.User{Model: gorm.Model{ID: userEntity.ID + 1}, Role: domain.RoleAdmin}, nil).Once()
+					mockUserRepository.EXPECT().Delete(mock.Anything, userEntity).Return(nil).Once()
+					return mockUserRepository
+				}(),
+			},
+			args: args{
+				req: domain.UserRequest{
+					ID: userEntity.ID,
+				},
+				claims: domain.Claims{
+					UserID: userEntity.ID + 1,
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -286,7 +334,7 @@ func TestUserService_Delete(t *testing.T) {
 				repository: tt.fields.repository,
 			}
 
-			err := h.Delete(tt.args.req, tt.args.claims)
+			err := h.Delete(context.Background(), tt.args.req, tt.args.claims)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -297,3 +345,23 @@ func TestUserService_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestUserService_Purge(t *testing.T) {
+	mockUserRepository := mocks.NewUserRepository(t)
+	mockStorage := mocks.NewStorage(t)
+
+	mockUserRepository.EXPECT().Purge(mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+		return time.Since(before) >= time.Hour
+	})).Return(1, []string{"uploads/1/a.png", "attachments/1/b.pdf"}, nil).Once()
+	mockStorage.EXPECT().Delete(mock.Anything, "uploads/1/a.png").Return(errors.New("unavailable")).Once()
+	mockStorage.EXPECT().Delete(mock.Anything, "attachments/1/b.pdf").Return(nil).Once()
+
+	h := &UserService{
+		repository: mockUserRepository,
+		storage:    mockStorage,
+	}
+
+	got, err := h.Purge(context.Background(), time.Hour)
+	assert.NoError(t, err, "a file left behind does not fail the purge")
+	assert.Equal(t, int64(1), got)
+}
@@ -0,0 +1,219 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AttachmentHandler is an autogenerated mock type for the AttachmentHandler type
+type AttachmentHandler struct {
+	mock.Mock
+}
+
+type AttachmentHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AttachmentHandler) EXPECT() *AttachmentHandler_Expecter {
+	return &AttachmentHandler_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx
+func (_m *AttachmentHandler) Create(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AttachmentHandler_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type AttachmentHandler_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *AttachmentHandler_Expecter) Create(ctx interface{}) *AttachmentHandler_Create_Call {
+	return &AttachmentHandler_Create_Call{Call: _e.mock.On("Create", ctx)}
+}
+
+func (_c *AttachmentHandler_Create_Call) Run(run func(ctx *fiber.Ctx)) *AttachmentHandler_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *AttachmentHandler_Create_Call) Return(_a0 error) *AttachmentHandler_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AttachmentHandler_Create_Call) RunAndReturn(run func(*fiber.Ctx) error) *AttachmentHandler_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx
+func (_m *AttachmentHandler) Delete(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AttachmentHandler_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type AttachmentHandler_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *AttachmentHandler_Expecter) Delete(ctx interface{}) *AttachmentHandler_Delete_Call {
+	return &AttachmentHandler_Delete_Call{Call: _e.mock.On("Delete", ctx)}
+}
+
+func (_c *AttachmentHandler_Delete_Call) Run(run func(ctx *fiber.Ctx)) *AttachmentHandler_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *AttachmentHandler_Delete_Call) Return(_a0 error) *AttachmentHandler_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AttachmentHandler_Delete_Call) RunAndReturn(run func(*fiber.Ctx) error) *AttachmentHandler_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Download provides a mock function with given fields: ctx
+func (_m *AttachmentHandler) Download(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Download")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AttachmentHandler_Download_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Download'
+type AttachmentHandler_Download_Call struct {
+	*mock.Call
+}
+
+// Download is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *AttachmentHandler_Expecter) Download(ctx interface{}) *AttachmentHandler_Download_Call {
+	return &AttachmentHandler_Download_Call{Call: _e.mock.On("Download", ctx)}
+}
+
+func (_c *AttachmentHandler_Download_Call) Run(run func(ctx *fiber.Ctx)) *AttachmentHandler_Download_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *AttachmentHandler_Download_Call) Return(_a0 error) *AttachmentHandler_Download_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AttachmentHandler_Download_Call) RunAndReturn(run func(*fiber.Ctx) error) *AttachmentHandler_Download_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *AttachmentHandler) GetAll(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AttachmentHandler_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type AttachmentHandler_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *AttachmentHandler_Expecter) GetAll(ctx interface{}) *AttachmentHandler_GetAll_Call {
+	return &AttachmentHandler_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *AttachmentHandler_GetAll_Call) Run(run func(ctx *fiber.Ctx)) *AttachmentHandler_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *AttachmentHandler_GetAll_Call) Return(_a0 error) *AttachmentHandler_GetAll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AttachmentHandler_GetAll_Call) RunAndReturn(run func(*fiber.Ctx) error) *AttachmentHandler_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAttachmentHandler creates a new instance of AttachmentHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAttachmentHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AttachmentHandler {
+	mock := &AttachmentHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,309 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/shironxn/blanknotes/internal/core/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AttachmentRepository is an autogenerated mock type for the AttachmentRepository type
+type AttachmentRepository struct {
+	mock.Mock
+}
+
+type AttachmentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AttachmentRepository) EXPECT() *AttachmentRepository_Expecter {
+	return &AttachmentRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, attachment, quota
+func (_m *AttachmentRepository) Create(ctx context.Context, attachment *domain.Attachment, quota int64) error {
+	ret := _m.Called(ctx, attachment, quota)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Attachment, int64) error); ok {
+		r0 = rf(ctx, attachment, quota)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AttachmentRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type AttachmentRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attachment *domain.Attachment
+//   - quota int64
+func (_e *AttachmentRepository_Expecter) Create(ctx interface{}, attachment interface{}, quota interface{}) *AttachmentRepository_Create_Call {
+	return &AttachmentRepository_Create_Call{Call: _e.mock.On("Create", ctx, attachment, quota)}
+}
+
+func (_c *AttachmentRepository_Create_Call) Run(run func(ctx context.Context, attachment *domain.Attachment, quota int64)) *AttachmentRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Attachment), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *AttachmentRepository_Create_Call) Return(_a0 error) *AttachmentRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AttachmentRepository_Create_Call) RunAndReturn(run func(context.Context, *domain.Attachment, int64) error) *AttachmentRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, attachment
+func (_m *AttachmentRepository) Delete(ctx context.Context, attachment *domain.Attachment) error {
+	ret := _m.Called(ctx, attachment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Attachment) error); ok {
+		r0 = rf(ctx, attachment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AttachmentRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type AttachmentRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attachment *domain.Attachment
+func (_e *AttachmentRepository_Expecter) Delete(ctx interface{}, attachment interface{}) *AttachmentRepository_Delete_Call {
+	return &AttachmentRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, attachment)}
+}
+
+func (_c *AttachmentRepository_Delete_Call) Run(run func(ctx context.Context, attachment *domain.Attachment)) *AttachmentRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Attachment))
+	})
+	return _c
+}
+
+func (_c *AttachmentRepository_Delete_Call) Return(_a0 error) *AttachmentRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AttachmentRepository_Delete_Call) RunAndReturn(run func(context.Context, *domain.Attachment) error) *AttachmentRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, noteID
+func (_m *AttachmentRepository) GetAll(ctx context.Context, noteID uint) ([]domain.Attachment, error) {
+	ret := _m.Called(ctx, noteID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []domain.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]domain.Attachment, error)); ok {
+		return rf(ctx, noteID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []domain.Attachment); ok {
+		r0 = rf(ctx, noteID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, noteID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AttachmentRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type AttachmentRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - noteID uint
+func (_e *AttachmentRepository_Expecter) GetAll(ctx interface{}, noteID interface{}) *AttachmentRepository_GetAll_Call {
+	return &AttachmentRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx, noteID)}
+}
+
+func (_c *AttachmentRepository_GetAll_Call) Run(run func(ctx context.Context, noteID uint)) *AttachmentRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *AttachmentRepository_GetAll_Call) Return(_a0 []domain.Attachment, _a1 error) *AttachmentRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AttachmentRepository_GetAll_Call) RunAndReturn(run func(context.Context, uint) ([]domain.Attachment, error)) *AttachmentRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *AttachmentRepository) GetByID(ctx context.Context, id uint) (*domain.Attachment, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*domain.Attachment, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *domain.Attachment); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AttachmentRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type AttachmentRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *AttachmentRepository_Expecter) GetByID(ctx interface{}, id interface{}) *AttachmentRepository_GetByID_Call {
+	return &AttachmentRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *AttachmentRepository_GetByID_Call) Run(run func(ctx context.Context, id uint)) *AttachmentRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *AttachmentRepository_GetByID_Call) Return(_a0 *domain.Attachment, _a1 error) *AttachmentRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AttachmentRepository_GetByID_Call) RunAndReturn(run func(context.Context, uint) (*domain.Attachment, error)) *AttachmentRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrphaned provides a mock function with given fields: ctx, limit
+func (_m *AttachmentRepository) GetOrphaned(ctx context.Context, limit int) ([]domain.Attachment, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrphaned")
+	}
+
+	var r0 []domain.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]domain.Attachment, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.Attachment); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AttachmentRepository_GetOrphaned_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrphaned'
+type AttachmentRepository_GetOrphaned_Call struct {
+	*mock.Call
+}
+
+// GetOrphaned is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *AttachmentRepository_Expecter) GetOrphaned(ctx interface{}, limit interface{}) *AttachmentRepository_GetOrphaned_Call {
+	return &AttachmentRepository_GetOrphaned_Call{Call: _e.mock.On("GetOrphaned", ctx, limit)}
+}
+
+func (_c *AttachmentRepository_GetOrphaned_Call) Run(run func(ctx context.Context, limit int)) *AttachmentRepository_GetOrphaned_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *AttachmentRepository_GetOrphaned_Call) Return(_a0 []domain.Attachment, _a1 error) *AttachmentRepository_GetOrphaned_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AttachmentRepository_GetOrphaned_Call) RunAndReturn(run func(context.Context, int) ([]domain.Attachment, error)) *AttachmentRepository_GetOrphaned_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAttachmentRepository creates a new instance of AttachmentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAttachmentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AttachmentRepository {
+	mock := &AttachmentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
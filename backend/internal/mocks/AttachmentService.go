@@ -0,0 +1,338 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	io "io"
+
+	domain "github.com/shironxn/blanknotes/internal/core/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AttachmentService is an autogenerated mock type for the AttachmentService type
+type AttachmentService struct {
+	mock.Mock
+}
+
+type AttachmentService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AttachmentService) EXPECT() *AttachmentService_Expecter {
+	return &AttachmentService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, noteID, name, contentType, file, size, claims
+func (_m *AttachmentService) Create(ctx context.Context, noteID uint, name string, contentType string, file io.Reader, size int64, claims domain.Claims) (*domain.Attachment, error) {
+	ret := _m.Called(ctx, noteID, name, contentType, file, size, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string, string, io.Reader, int64, domain.Claims) (*domain.Attachment, error)); ok {
+		return rf(ctx, noteID, name, contentType, file, size, claims)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string, string, io.Reader, int64, domain.Claims) *domain.Attachment); ok {
+		r0 = rf(ctx, noteID, name, contentType, file, size, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, string, string, io.Reader, int64, domain.Claims) error); ok {
+		r1 = rf(ctx, noteID, name, contentType, file, size, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AttachmentService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type AttachmentService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - noteID uint
+//   - name string
+//   - contentType string
+//   - file io.Reader
+//   - size int64
+//   - claims domain.Claims
+func (_e *AttachmentService_Expecter) Create(ctx interface{}, noteID interface{}, name interface{}, contentType interface{}, file interface{}, size interface{}, claims interface{}) *AttachmentService_Create_Call {
+	return &AttachmentService_Create_Call{Call: _e.mock.On("Create", ctx, noteID, name, contentType, file, size, claims)}
+}
+
+func (_c *AttachmentService_Create_Call) Run(run func(ctx context.Context, noteID uint, name string, contentType string, file io.Reader, size int64, claims domain.Claims)) *AttachmentService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(string), args[3].(string), args[4].(io.Reader), args[5].(int64), args[6].(domain.Claims))
+	})
+	return _c
+}
+
+func (_c *AttachmentService_Create_Call) Return(_a0 *domain.Attachment, _a1 error) *AttachmentService_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AttachmentService_Create_Call) RunAndReturn(run func(context.Context, uint, string, string, io.Reader, int64, domain.Claims) (*domain.Attachment, error)) *AttachmentService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, noteID, id, claims
+func (_m *AttachmentService) Delete(ctx context.Context, noteID uint, id uint, claims domain.Claims) error {
+	ret := _m.Called(ctx, noteID, id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, domain.Claims) error); ok {
+		r0 = rf(ctx, noteID, id, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AttachmentService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type AttachmentService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - noteID uint
+//   - id uint
+//   - claims domain.Claims
+func (_e *AttachmentService_Expecter) Delete(ctx interface{}, noteID interface{}, id interface{}, claims interface{}) *AttachmentService_Delete_Call {
+	return &AttachmentService_Delete_Call{Call: _e.mock.On("Delete", ctx, noteID, id, claims)}
+}
+
+func (_c *AttachmentService_Delete_Call) Run(run func(ctx context.Context, noteID uint, id uint, claims domain.Claims)) *AttachmentService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint), args[3].(domain.Claims))
+	})
+	return _c
+}
+
+func (_c *AttachmentService_Delete_Call) Return(_a0 error) *AttachmentService_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AttachmentService_Delete_Call) RunAndReturn(run func(context.Context, uint, uint, domain.Claims) error) *AttachmentService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, noteID, claims
+func (_m *AttachmentService) GetAll(ctx context.Context, noteID uint, claims *domain.Claims) ([]domain.Attachment, error) {
+	ret := _m.Called(ctx, noteID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []domain.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *domain.Claims) ([]domain.Attachment, error)); ok {
+		return rf(ctx, noteID, claims)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *domain.Claims) []domain.Attachment); ok {
+		r0 = rf(ctx, noteID, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, *domain.Claims) error); ok {
+		r1 = rf(ctx, noteID, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AttachmentService_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type AttachmentService_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - noteID uint
+//   - claims *domain.Claims
+func (_e *AttachmentService_Expecter) GetAll(ctx interface{}, noteID interface{}, claims interface{}) *AttachmentService_GetAll_Call {
+	return &AttachmentService_GetAll_Call{Call: _e.mock.On("GetAll", ctx, noteID, claims)}
+}
+
+func (_c *AttachmentService_GetAll_Call) Run(run func(ctx context.Context, noteID uint, claims *domain.Claims)) *AttachmentService_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(*domain.Claims))
+	})
+	return _c
+}
+
+func (_c *AttachmentService_GetAll_Call) Return(_a0 []domain.Attachment, _a1 error) *AttachmentService_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AttachmentService_GetAll_Call) RunAndReturn(run func(context.Context, uint, *domain.Claims) ([]domain.Attachment, error)) *AttachmentService_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Open provides a mock function with given fields: ctx, noteID, id, claims
+func (_m *AttachmentService) Open(ctx context.Context, noteID uint, id uint, claims *domain.Claims) (*domain.Attachment, io.ReadCloser, error) {
+	ret := _m.Called(ctx, noteID, id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Open")
+	}
+
+	var r0 *domain.Attachment
+	var r1 io.ReadCloser
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *domain.Claims) (*domain.Attachment, io.ReadCloser, error)); ok {
+		return rf(ctx, noteID, id, claims)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *domain.Claims) *domain.Attachment); ok {
+		r0 = rf(ctx, noteID, id, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint, *domain.Claims) io.ReadCloser); ok {
+		r1 = rf(ctx, noteID, id, claims)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint, uint, *domain.Claims) error); ok {
+		r2 = rf(ctx, noteID, id, claims)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// AttachmentService_Open_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Open'
+type AttachmentService_Open_Call struct {
+	*mock.Call
+}
+
+// Open is a helper method to define mock.On call
+//   - ctx context.Context
+//   - noteID uint
+//   - id uint
+//   - claims *domain.Claims
+func (_e *AttachmentService_Expecter) Open(ctx interface{}, noteID interface{}, id interface{}, claims interface{}) *AttachmentService_Open_Call {
+	return &AttachmentService_Open_Call{Call: _e.mock.On("Open", ctx, noteID, id, claims)}
+}
+
+func (_c *AttachmentService_Open_Call) Run(run func(ctx context.Context, noteID uint, id uint, claims *domain.Claims)) *AttachmentService_Open_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint), args[3].(*domain.Claims))
+	})
+	return _c
+}
+
+func (_c *AttachmentService_Open_Call) Return(_a0 *domain.Attachment, _a1 io.ReadCloser, _a2 error) *AttachmentService_Open_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *AttachmentService_Open_Call) RunAndReturn(run func(context.Context, uint, uint, *domain.Claims) (*domain.Attachment, io.ReadCloser, error)) *AttachmentService_Open_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function with given fields: ctx
+func (_m *AttachmentService) Purge(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AttachmentService_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type AttachmentService_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AttachmentService_Expecter) Purge(ctx interface{}) *AttachmentService_Purge_Call {
+	return &AttachmentService_Purge_Call{Call: _e.mock.On("Purge", ctx)}
+}
+
+func (_c *AttachmentService_Purge_Call) Run(run func(ctx context.Context)) *AttachmentService_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AttachmentService_Purge_Call) Return(_a0 int64, _a1 error) *AttachmentService_Purge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AttachmentService_Purge_Call) RunAndReturn(run func(context.Context) (int64, error)) *AttachmentService_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAttachmentService creates a new instance of AttachmentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAttachmentService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AttachmentService {
+	mock := &AttachmentService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
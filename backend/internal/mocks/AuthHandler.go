@@ -204,6 +204,52 @@ func (_c *AuthHandler_Register_Call) RunAndReturn(run func(*fiber.Ctx) error) *A
 	return _c
 }
 
+// Restore provides a mock function with given fields: ctx
+func (_m *AuthHandler) Restore(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthHandler_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type AuthHandler_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *AuthHandler_Expecter) Restore(ctx interface{}) *AuthHandler_Restore_Call {
+	return &AuthHandler_Restore_Call{Call: _e.mock.On("Restore", ctx)}
+}
+
+func (_c *AuthHandler_Restore_Call) Run(run func(ctx *fiber.Ctx)) *AuthHandler_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *AuthHandler_Restore_Call) Return(_a0 error) *AuthHandler_Restore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthHandler_Restore_Call) RunAndReturn(run func(*fiber.Ctx) error) *AuthHandler_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewAuthHandler creates a new instance of AuthHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAuthHandler(t interface {
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	domain "github.com/shironxn/blanknotes/internal/core/domain"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -20,17 +22,17 @@ func (_m *AuthRepository) EXPECT() *AuthRepository_Expecter {
 	return &AuthRepository_Expecter{mock: &_m.Mock}
 }
 
-// DeleteRefreshToken provides a mock function with given fields: entity
-func (_m *AuthRepository) DeleteRefreshToken(entity domain.RefreshToken) error {
-	ret := _m.Called(entity)
+// DeleteRefreshToken provides a mock function with given fields: ctx, entity
+func (_m *AuthRepository) DeleteRefreshToken(ctx context.Context, entity domain.RefreshToken) error {
+	ret := _m.Called(ctx, entity)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteRefreshToken")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(domain.RefreshToken) error); ok {
-		r0 = rf(entity)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.RefreshToken) error); ok {
+		r0 = rf(ctx, entity)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -44,14 +46,15 @@ type AuthRepository_DeleteRefreshToken_Call struct {
 }
 
 // DeleteRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
 //   - entity domain.RefreshToken
-func (_e *AuthRepository_Expecter) DeleteRefreshToken(entity interface{}) *AuthRepository_DeleteRefreshToken_Call {
-	return &AuthRepository_DeleteRefreshToken_Call{Call: _e.mock.On("DeleteRefreshToken", entity)}
+func (_e *AuthRepository_Expecter) DeleteRefreshToken(ctx interface{}, entity interface{}) *AuthRepository_DeleteRefreshToken_Call {
+	return &AuthRepository_DeleteRefreshToken_Call{Call: _e.mock.On("DeleteRefreshToken", ctx, entity)}
 }
 
-func (_c *AuthRepository_DeleteRefreshToken_Call) Run(run func(entity domain.RefreshToken)) *AuthRepository_DeleteRefreshToken_Call {
+func (_c *AuthRepository_DeleteRefreshToken_Call) Run(run func(ctx context.Context, entity domain.RefreshToken)) *AuthRepository_DeleteRefreshToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(domain.RefreshToken))
+		run(args[0].(context.Context), args[1].(domain.RefreshToken))
 	})
 	return _c
 }
@@ -61,14 +64,14 @@ func (_c *AuthRepository_DeleteRefreshToken_Call) Return(_a0 error) *AuthReposit
 	return _c
 }
 
-func (_c *AuthRepository_DeleteRefreshToken_Call) RunAndReturn(run func(domain.RefreshToken) error) *AuthRepository_DeleteRefreshToken_Call {
+func (_c *AuthRepository_DeleteRefreshToken_Call) RunAndReturn(run func(context.Context, domain.RefreshToken) error) *AuthRepository_DeleteRefreshToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByEmail provides a mock function with given fields: email
-func (_m *AuthRepository) GetByEmail(email string) (*domain.User, error) {
-	ret := _m.Called(email)
+// GetByEmail provides a mock function with given fields: ctx, email
+func (_m *AuthRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	ret := _m.Called(ctx, email)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByEmail")
@@ -76,19 +79,19 @@ func (_m *AuthRepository) GetByEmail(email string) (*domain.User, error) {
 
 	var r0 *domain.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*domain.User, error)); ok {
-		return rf(email)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
+		return rf(ctx, email)
 	}
-	if rf, ok := ret.Get(0).(func(string) *domain.User); ok {
-		r0 = rf(email)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+		r0 = rf(ctx, email)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(email)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -102,14 +105,15 @@ type AuthRepository_GetByEmail_Call struct {
 }
 
 // GetByEmail is a helper method to define mock.On call
+//   - ctx context.Context
 //   - email string
-func (_e *AuthRepository_Expecter) GetByEmail(email interface{}) *AuthRepository_GetByEmail_Call {
-	return &AuthRepository_GetByEmail_Call{Call: _e.mock.On("GetByEmail", email)}
+func (_e *AuthRepository_Expecter) GetByEmail(ctx interface{}, email interface{}) *AuthRepository_GetByEmail_Call {
+	return &AuthRepository_GetByEmail_Call{Call: _e.mock.On("GetByEmail", ctx, email)}
 }
 
-func (_c *AuthRepository_GetByEmail_Call) Run(run func(email string)) *AuthRepository_GetByEmail_Call {
+func (_c *AuthRepository_GetByEmail_Call) Run(run func(ctx context.Context, email string)) *AuthRepository_GetByEmail_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -119,14 +123,73 @@ func (_c *AuthRepository_GetByEmail_Call) Return(_a0 *domain.User, _a1 error) *A
 	return _c
 }
 
-func (_c *AuthRepository_GetByEmail_Call) RunAndReturn(run func(string) (*domain.User, error)) *AuthRepository_GetByEmail_Call {
+func (_c *AuthRepository_GetByEmail_Call) RunAndReturn(run func(context.Context, string) (*domain.User, error)) *AuthRepository_GetByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeletedByEmail provides a mock function with given fields: ctx, email
+func (_m *AuthRepository) GetDeletedByEmail(ctx context.Context, email string) (*domain.User, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedByEmail")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+		r0 = rf(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthRepository_GetDeletedByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedByEmail'
+type AuthRepository_GetDeletedByEmail_Call struct {
+	*mock.Call
+}
+
+// GetDeletedByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *AuthRepository_Expecter) GetDeletedByEmail(ctx interface{}, email interface{}) *AuthRepository_GetDeletedByEmail_Call {
+	return &AuthRepository_GetDeletedByEmail_Call{Call: _e.mock.On("GetDeletedByEmail", ctx, email)}
+}
+
+func (_c *AuthRepository_GetDeletedByEmail_Call) Run(run func(ctx context.Context, email string)) *AuthRepository_GetDeletedByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthRepository_GetDeletedByEmail_Call) Return(_a0 *domain.User, _a1 error) *AuthRepository_GetDeletedByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthRepository_GetDeletedByEmail_Call) RunAndReturn(run func(context.Context, string) (*domain.User, error)) *AuthRepository_GetDeletedByEmail_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRefreshToken provides a mock function with given fields: userID
-func (_m *AuthRepository) GetRefreshToken(userID uint) (*domain.RefreshToken, error) {
-	ret := _m.Called(userID)
+// GetRefreshToken provides a mock function with given fields: ctx, userID
+func (_m *AuthRepository) GetRefreshToken(ctx context.Context, userID uint) (*domain.RefreshToken, error) {
+	ret := _m.Called(ctx, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetRefreshToken")
@@ -134,19 +197,19 @@ func (_m *AuthRepository) GetRefreshToken(userID uint) (*domain.RefreshToken, er
 
 	var r0 *domain.RefreshToken
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uint) (*domain.RefreshToken, error)); ok {
-		return rf(userID)
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*domain.RefreshToken, error)); ok {
+		return rf(ctx, userID)
 	}
-	if rf, ok := ret.Get(0).(func(uint) *domain.RefreshToken); ok {
-		r0 = rf(userID)
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *domain.RefreshToken); ok {
+		r0 = rf(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.RefreshToken)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uint) error); ok {
-		r1 = rf(userID)
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -160,14 +223,15 @@ type AuthRepository_GetRefreshToken_Call struct {
 }
 
 // GetRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
 //   - userID uint
-func (_e *AuthRepository_Expecter) GetRefreshToken(userID interface{}) *AuthRepository_GetRefreshToken_Call {
-	return &AuthRepository_GetRefreshToken_Call{Call: _e.mock.On("GetRefreshToken", userID)}
+func (_e *AuthRepository_Expecter) GetRefreshToken(ctx interface{}, userID interface{}) *AuthRepository_GetRefreshToken_Call {
+	return &AuthRepository_GetRefreshToken_Call{Call: _e.mock.On("GetRefreshToken", ctx, userID)}
 }
 
-func (_c *AuthRepository_GetRefreshToken_Call) Run(run func(userID uint)) *AuthRepository_GetRefreshToken_Call {
+func (_c *AuthRepository_GetRefreshToken_Call) Run(run func(ctx context.Context, userID uint)) *AuthRepository_GetRefreshToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint))
+		run(args[0].(context.Context), args[1].(uint))
 	})
 	return _c
 }
@@ -177,14 +241,14 @@ func (_c *AuthRepository_GetRefreshToken_Call) Return(_a0 *domain.RefreshToken,
 	return _c
 }
 
-func (_c *AuthRepository_GetRefreshToken_Call) RunAndReturn(run func(uint) (*domain.RefreshToken, error)) *AuthRepository_GetRefreshToken_Call {
+func (_c *AuthRepository_GetRefreshToken_Call) RunAndReturn(run func(context.Context, uint) (*domain.RefreshToken, error)) *AuthRepository_GetRefreshToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Register provides a mock function with given fields: req
-func (_m *AuthRepository) Register(req domain.AuthRegisterRequest) (*domain.User, error) {
-	ret := _m.Called(req)
+// Register provides a mock function with given fields: ctx, req
+func (_m *AuthRepository) Register(ctx context.Context, req domain.AuthRegisterRequest) (*domain.User, error) {
+	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Register")
@@ -192,19 +256,19 @@ func (_m *AuthRepository) Register(req domain.AuthRegisterRequest) (*domain.User
 
 	var r0 *domain.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(domain.AuthRegisterRequest) (*domain.User, error)); ok {
-		return rf(req)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AuthRegisterRequest) (*domain.User, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(domain.AuthRegisterRequest) *domain.User); ok {
-		r0 = rf(req)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AuthRegisterRequest) *domain.User); ok {
+		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(domain.AuthRegisterRequest) error); ok {
-		r1 = rf(req)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.AuthRegisterRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -218,14 +282,15 @@ type AuthRepository_Register_Call struct {
 }
 
 // Register is a helper method to define mock.On call
+//   - ctx context.Context
 //   - req domain.AuthRegisterRequest
-func (_e *AuthRepository_Expecter) Register(req interface{}) *AuthRepository_Register_Call {
-	return &AuthRepository_Register_Call{Call: _e.mock.On("Register", req)}
+func (_e *AuthRepository_Expecter) Register(ctx interface{}, req interface{}) *AuthRepository_Register_Call {
+	return &AuthRepository_Register_Call{Call: _e.mock.On("Register", ctx, req)}
 }
 
-func (_c *AuthRepository_Register_Call) Run(run func(req domain.AuthRegisterRequest)) *AuthRepository_Register_Call {
+func (_c *AuthRepository_Register_Call) Run(run func(ctx context.Context, req domain.AuthRegisterRequest)) *AuthRepository_Register_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(domain.AuthRegisterRequest))
+		run(args[0].(context.Context), args[1].(domain.AuthRegisterRequest))
 	})
 	return _c
 }
@@ -235,22 +300,81 @@ func (_c *AuthRepository_Register_Call) Return(_a0 *domain.User, _a1 error) *Aut
 	return _c
 }
 
-func (_c *AuthRepository_Register_Call) RunAndReturn(run func(domain.AuthRegisterRequest) (*domain.User, error)) *AuthRepository_Register_Call {
+func (_c *AuthRepository_Register_Call) RunAndReturn(run func(context.Context, domain.AuthRegisterRequest) (*domain.User, error)) *AuthRepository_Register_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, user
+func (_m *AuthRepository) Restore(ctx context.Context, user *domain.User) (*domain.User, error) {
+	ret := _m.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.User) (*domain.User, error)); ok {
+		return rf(ctx, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.User) *domain.User); ok {
+		r0 = rf(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.User) error); ok {
+		r1 = rf(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type AuthRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *domain.User
+func (_e *AuthRepository_Expecter) Restore(ctx interface{}, user interface{}) *AuthRepository_Restore_Call {
+	return &AuthRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, user)}
+}
+
+func (_c *AuthRepository_Restore_Call) Run(run func(ctx context.Context, user *domain.User)) *AuthRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.User))
+	})
+	return _c
+}
+
+func (_c *AuthRepository_Restore_Call) Return(_a0 *domain.User, _a1 error) *AuthRepository_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthRepository_Restore_Call) RunAndReturn(run func(context.Context, *domain.User) (*domain.User, error)) *AuthRepository_Restore_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// StoreRefreshToken provides a mock function with given fields: userID, token
-func (_m *AuthRepository) StoreRefreshToken(userID uint, token string) error {
-	ret := _m.Called(userID, token)
+// StoreRefreshToken provides a mock function with given fields: ctx, userID, token
+func (_m *AuthRepository) StoreRefreshToken(ctx context.Context, userID uint, token string) error {
+	ret := _m.Called(ctx, userID, token)
 
 	if len(ret) == 0 {
 		panic("no return value specified for StoreRefreshToken")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uint, string) error); ok {
-		r0 = rf(userID, token)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) error); ok {
+		r0 = rf(ctx, userID, token)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -264,15 +388,16 @@ type AuthRepository_StoreRefreshToken_Call struct {
 }
 
 // StoreRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
 //   - userID uint
 //   - token string
-func (_e *AuthRepository_Expecter) StoreRefreshToken(userID interface{}, token interface{}) *AuthRepository_StoreRefreshToken_Call {
-	return &AuthRepository_StoreRefreshToken_Call{Call: _e.mock.On("StoreRefreshToken", userID, token)}
+func (_e *AuthRepository_Expecter) StoreRefreshToken(ctx interface{}, userID interface{}, token interface{}) *AuthRepository_StoreRefreshToken_Call {
+	return &AuthRepository_StoreRefreshToken_Call{Call: _e.mock.On("StoreRefreshToken", ctx, userID, token)}
 }
 
-func (_c *AuthRepository_StoreRefreshToken_Call) Run(run func(userID uint, token string)) *AuthRepository_StoreRefreshToken_Call {
+func (_c *AuthRepository_StoreRefreshToken_Call) Run(run func(ctx context.Context, userID uint, token string)) *AuthRepository_StoreRefreshToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint), args[1].(string))
+		run(args[0].(context.Context), args[1].(uint), args[2].(string))
 	})
 	return _c
 }
@@ -282,7 +407,7 @@ func (_c *AuthRepository_StoreRefreshToken_Call) Return(_a0 error) *AuthReposito
 	return _c
 }
 
-func (_c *AuthRepository_StoreRefreshToken_Call) RunAndReturn(run func(uint, string) error) *AuthRepository_StoreRefreshToken_Call {
+func (_c *AuthRepository_StoreRefreshToken_Call) RunAndReturn(run func(context.Context, uint, string) error) *AuthRepository_StoreRefreshToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	domain "github.com/shironxn/blanknotes/internal/core/domain"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -20,9 +22,9 @@ func (_m *AuthService) EXPECT() *AuthService_Expecter {
 	return &AuthService_Expecter{mock: &_m.Mock}
 }
 
-// Login provides a mock function with given fields: req
-func (_m *AuthService) Login(req domain.AuthLoginRequest) (*domain.User, *domain.UserToken, error) {
-	ret := _m.Called(req)
+// Login provides a mock function with given fields: ctx, req
+func (_m *AuthService) Login(ctx context.Context, req domain.AuthLoginRequest) (*domain.User, *domain.UserToken, error) {
+	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Login")
@@ -31,27 +33,27 @@ func (_m *AuthService) Login(req domain.AuthLoginRequest) (*domain.User, *domain
 	var r0 *domain.User
 	var r1 *domain.UserToken
 	var r2 error
-	if rf, ok := ret.Get(0).(func(domain.AuthLoginRequest) (*domain.User, *domain.UserToken, error)); ok {
-		return rf(req)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AuthLoginRequest) (*domain.User, *domain.UserToken, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(domain.AuthLoginRequest) *domain.User); ok {
-		r0 = rf(req)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AuthLoginRequest) *domain.User); ok {
+		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(domain.AuthLoginRequest) *domain.UserToken); ok {
-		r1 = rf(req)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.AuthLoginRequest) *domain.UserToken); ok {
+		r1 = rf(ctx, req)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*domain.UserToken)
 		}
 	}
 
-	if rf, ok := ret.Get(2).(func(domain.AuthLoginRequest) error); ok {
-		r2 = rf(req)
+	if rf, ok := ret.Get(2).(func(context.Context, domain.AuthLoginRequest) error); ok {
+		r2 = rf(ctx, req)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -65,14 +67,15 @@ type AuthService_Login_Call struct {
 }
 
 // Login is a helper method to define mock.On call
+//   - ctx context.Context
 //   - req domain.AuthLoginRequest
-func (_e *AuthService_Expecter) Login(req interface{}) *AuthService_Login_Call {
-	return &AuthService_Login_Call{Call: _e.mock.On("Login", req)}
+func (_e *AuthService_Expecter) Login(ctx interface{}, req interface{}) *AuthService_Login_Call {
+	return &AuthService_Login_Call{Call: _e.mock.On("Login", ctx, req)}
 }
 
-func (_c *AuthService_Login_Call) Run(run func(req domain.AuthLoginRequest)) *AuthService_Login_Call {
+func (_c *AuthService_Login_Call) Run(run func(ctx context.Context, req domain.AuthLoginRequest)) *AuthService_Login_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(domain.AuthLoginRequest))
+		run(args[0].(context.Context), args[1].(domain.AuthLoginRequest))
 	})
 	return _c
 }
@@ -82,22 +85,22 @@ func (_c *AuthService_Login_Call) Return(_a0 *domain.User, _a1 *domain.UserToken
 	return _c
 }
 
-func (_c *AuthService_Login_Call) RunAndReturn(run func(domain.AuthLoginRequest) (*domain.User, *domain.UserToken, error)) *AuthService_Login_Call {
+func (_c *AuthService_Login_Call) RunAndReturn(run func(context.Context, domain.AuthLoginRequest) (*domain.User, *domain.UserToken, error)) *AuthService_Login_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Logout provides a mock function with given fields: userID
-func (_m *AuthService) Logout(userID uint) error {
-	ret := _m.Called(userID)
+// Logout provides a mock function with given fields: ctx, userID
+func (_m *AuthService) Logout(ctx context.Context, userID uint) error {
+	ret := _m.Called(ctx, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Logout")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uint) error); ok {
-		r0 = rf(userID)
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, userID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -111,14 +114,15 @@ type AuthService_Logout_Call struct {
 }
 
 // Logout is a helper method to define mock.On call
+//   - ctx context.Context
 //   - userID uint
-func (_e *AuthService_Expecter) Logout(userID interface{}) *AuthService_Logout_Call {
-	return &AuthService_Logout_Call{Call: _e.mock.On("Logout", userID)}
+func (_e *AuthService_Expecter) Logout(ctx interface{}, userID interface{}) *AuthService_Logout_Call {
+	return &AuthService_Logout_Call{Call: _e.mock.On("Logout", ctx, userID)}
 }
 
-func (_c *AuthService_Logout_Call) Run(run func(userID uint)) *AuthService_Logout_Call {
+func (_c *AuthService_Logout_Call) Run(run func(ctx context.Context, userID uint)) *AuthService_Logout_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint))
+		run(args[0].(context.Context), args[1].(uint))
 	})
 	return _c
 }
@@ -128,14 +132,14 @@ func (_c *AuthService_Logout_Call) Return(_a0 error) *AuthService_Logout_Call {
 	return _c
 }
 
-func (_c *AuthService_Logout_Call) RunAndReturn(run func(uint) error) *AuthService_Logout_Call {
+func (_c *AuthService_Logout_Call) RunAndReturn(run func(context.Context, uint) error) *AuthService_Logout_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Refresh provides a mock function with given fields: token
-func (_m *AuthService) Refresh(token string) (*string, *domain.Claims, error) {
-	ret := _m.Called(token)
+// Refresh provides a mock function with given fields: ctx, token
+func (_m *AuthService) Refresh(ctx context.Context, token string) (*string, *domain.Claims, error) {
+	ret := _m.Called(ctx, token)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Refresh")
@@ -144,27 +148,27 @@ func (_m *AuthService) Refresh(token string) (*string, *domain.Claims, error) {
 	var r0 *string
 	var r1 *domain.Claims
 	var r2 error
-	if rf, ok := ret.Get(0).(func(string) (*string, *domain.Claims, error)); ok {
-		return rf(token)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*string, *domain.Claims, error)); ok {
+		return rf(ctx, token)
 	}
-	if rf, ok := ret.Get(0).(func(string) *string); ok {
-		r0 = rf(token)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *string); ok {
+		r0 = rf(ctx, token)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*string)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) *domain.Claims); ok {
-		r1 = rf(token)
+	if rf, ok := ret.Get(1).(func(context.Context, string) *domain.Claims); ok {
+		r1 = rf(ctx, token)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*domain.Claims)
 		}
 	}
 
-	if rf, ok := ret.Get(2).(func(string) error); ok {
-		r2 = rf(token)
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, token)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -178,14 +182,15 @@ type AuthService_Refresh_Call struct {
 }
 
 // Refresh is a helper method to define mock.On call
+//   - ctx context.Context
 //   - token string
-func (_e *AuthService_Expecter) Refresh(token interface{}) *AuthService_Refresh_Call {
-	return &AuthService_Refresh_Call{Call: _e.mock.On("Refresh", token)}
+func (_e *AuthService_Expecter) Refresh(ctx interface{}, token interface{}) *AuthService_Refresh_Call {
+	return &AuthService_Refresh_Call{Call: _e.mock.On("Refresh", ctx, token)}
 }
 
-func (_c *AuthService_Refresh_Call) Run(run func(token string)) *AuthService_Refresh_Call {
+func (_c *AuthService_Refresh_Call) Run(run func(ctx context.Context, token string)) *AuthService_Refresh_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -195,14 +200,14 @@ func (_c *AuthService_Refresh_Call) Return(_a0 *string, _a1 *domain.Claims, _a2
 	return _c
 }
 
-func (_c *AuthService_Refresh_Call) RunAndReturn(run func(string) (*string, *domain.Claims, error)) *AuthService_Refresh_Call {
+func (_c *AuthService_Refresh_Call) RunAndReturn(run func(context.Context, string) (*string, *domain.Claims, error)) *AuthService_Refresh_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Register provides a mock function with given fields: req
-func (_m *AuthService) Register(req domain.AuthRegisterRequest) (*domain.User, error) {
-	ret := _m.Called(req)
+// Register provides a mock function with given fields: ctx, req
+func (_m *AuthService) Register(ctx context.Context, req domain.AuthRegisterRequest) (*domain.User, error) {
+	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Register")
@@ -210,19 +215,19 @@ func (_m *AuthService) Register(req domain.AuthRegisterRequest) (*domain.User, e
 
 	var r0 *domain.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(domain.AuthRegisterRequest) (*domain.User, error)); ok {
-		return rf(req)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AuthRegisterRequest) (*domain.User, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(domain.AuthRegisterRequest) *domain.User); ok {
-		r0 = rf(req)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AuthRegisterRequest) *domain.User); ok {
+		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(domain.AuthRegisterRequest) error); ok {
-		r1 = rf(req)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.AuthRegisterRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -236,14 +241,15 @@ type AuthService_Register_Call struct {
 }
 
 // Register is a helper method to define mock.On call
+//   - ctx context.Context
 //   - req domain.AuthRegisterRequest
-func (_e *AuthService_Expecter) Register(req interface{}) *AuthService_Register_Call {
-	return &AuthService_Register_Call{Call: _e.mock.On("Register", req)}
+func (_e *AuthService_Expecter) Register(ctx interface{}, req interface{}) *AuthService_Register_Call {
+	return &AuthService_Register_Call{Call: _e.mock.On("Register", ctx, req)}
 }
 
-func (_c *AuthService_Register_Call) Run(run func(req domain.AuthRegisterRequest)) *AuthService_Register_Call {
+func (_c *AuthService_Register_Call) Run(run func(ctx context.Context, req domain.AuthRegisterRequest)) *AuthService_Register_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(domain.AuthRegisterRequest))
+		run(args[0].(context.Context), args[1].(domain.AuthRegisterRequest))
 	})
 	return _c
 }
@@ -253,7 +259,66 @@ func (_c *AuthService_Register_Call) Return(_a0 *domain.User, _a1 error) *AuthSe
 	return _c
 }
 
-func (_c *AuthService_Register_Call) RunAndReturn(run func(domain.AuthRegisterRequest) (*domain.User, error)) *AuthService_Register_Call {
+func (_c *AuthService_Register_Call) RunAndReturn(run func(context.Context, domain.AuthRegisterRequest) (*domain.User, error)) *AuthService_Register_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, req
+func (_m *AuthService) Restore(ctx context.Context, req domain.AuthLoginRequest) (*domain.User, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AuthLoginRequest) (*domain.User, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AuthLoginRequest) *domain.User); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.AuthLoginRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthService_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type AuthService_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.AuthLoginRequest
+func (_e *AuthService_Expecter) Restore(ctx interface{}, req interface{}) *AuthService_Restore_Call {
+	return &AuthService_Restore_Call{Call: _e.mock.On("Restore", ctx, req)}
+}
+
+func (_c *AuthService_Restore_Call) Run(run func(ctx context.Context, req domain.AuthLoginRequest)) *AuthService_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.AuthLoginRequest))
+	})
+	return _c
+}
+
+func (_c *AuthService_Restore_Call) Return(_a0 *domain.User, _a1 error) *AuthService_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthService_Restore_Call) RunAndReturn(run func(context.Context, domain.AuthLoginRequest) (*domain.User, error)) *AuthService_Restore_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,128 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CollabHandler is an autogenerated mock type for the CollabHandler type
+type CollabHandler struct {
+	mock.Mock
+}
+
+type CollabHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CollabHandler) EXPECT() *CollabHandler_Expecter {
+	return &CollabHandler_Expecter{mock: &_m.Mock}
+}
+
+// Connect provides a mock function with given fields:
+func (_m *CollabHandler) Connect() func(*fiber.Ctx) error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Connect")
+	}
+
+	var r0 func(*fiber.Ctx) error
+	if rf, ok := ret.Get(0).(func() func(*fiber.Ctx) error); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func(*fiber.Ctx) error)
+		}
+	}
+
+	return r0
+}
+
+// CollabHandler_Connect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Connect'
+type CollabHandler_Connect_Call struct {
+	*mock.Call
+}
+
+// Connect is a helper method to define mock.On call
+func (_e *CollabHandler_Expecter) Connect() *CollabHandler_Connect_Call {
+	return &CollabHandler_Connect_Call{Call: _e.mock.On("Connect")}
+}
+
+func (_c *CollabHandler_Connect_Call) Run(run func()) *CollabHandler_Connect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CollabHandler_Connect_Call) Return(_a0 func(*fiber.Ctx) error) *CollabHandler_Connect_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CollabHandler_Connect_Call) RunAndReturn(run func() func(*fiber.Ctx) error) *CollabHandler_Connect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upgrade provides a mock function with given fields: ctx
+func (_m *CollabHandler) Upgrade(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upgrade")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CollabHandler_Upgrade_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upgrade'
+type CollabHandler_Upgrade_Call struct {
+	*mock.Call
+}
+
+// Upgrade is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *CollabHandler_Expecter) Upgrade(ctx interface{}) *CollabHandler_Upgrade_Call {
+	return &CollabHandler_Upgrade_Call{Call: _e.mock.On("Upgrade", ctx)}
+}
+
+func (_c *CollabHandler_Upgrade_Call) Run(run func(ctx *fiber.Ctx)) *CollabHandler_Upgrade_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *CollabHandler_Upgrade_Call) Return(_a0 error) *CollabHandler_Upgrade_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CollabHandler_Upgrade_Call) RunAndReturn(run func(*fiber.Ctx) error) *CollabHandler_Upgrade_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCollabHandler creates a new instance of CollabHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCollabHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CollabHandler {
+	mock := &CollabHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,229 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/shironxn/blanknotes/internal/core/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CollabService is an autogenerated mock type for the CollabService type
+type CollabService struct {
+	mock.Mock
+}
+
+type CollabService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CollabService) EXPECT() *CollabService_Expecter {
+	return &CollabService_Expecter{mock: &_m.Mock}
+}
+
+// Join provides a mock function with given fields: ctx, note, userID, editable
+func (_m *CollabService) Join(ctx context.Context, note *domain.Note, userID uint, editable bool) (*domain.CollabClient, error) {
+	ret := _m.Called(ctx, note, userID, editable)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Join")
+	}
+
+	var r0 *domain.CollabClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Note, uint, bool) (*domain.CollabClient, error)); ok {
+		return rf(ctx, note, userID, editable)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Note, uint, bool) *domain.CollabClient); ok {
+		r0 = rf(ctx, note, userID, editable)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.CollabClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.Note, uint, bool) error); ok {
+		r1 = rf(ctx, note, userID, editable)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CollabService_Join_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Join'
+type CollabService_Join_Call struct {
+	*mock.Call
+}
+
+// Join is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *domain.Note
+//   - userID uint
+//   - editable bool
+func (_e *CollabService_Expecter) Join(ctx interface{}, note interface{}, userID interface{}, editable interface{}) *CollabService_Join_Call {
+	return &CollabService_Join_Call{Call: _e.mock.On("Join", ctx, note, userID, editable)}
+}
+
+func (_c *CollabService_Join_Call) Run(run func(ctx context.Context, note *domain.Note, userID uint, editable bool)) *CollabService_Join_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Note), args[2].(uint), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *CollabService_Join_Call) Return(_a0 *domain.CollabClient, _a1 error) *CollabService_Join_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CollabService_Join_Call) RunAndReturn(run func(context.Context, *domain.Note, uint, bool) (*domain.CollabClient, error)) *CollabService_Join_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Leave provides a mock function with given fields: client
+func (_m *CollabService) Leave(client *domain.CollabClient) {
+	_m.Called(client)
+}
+
+// CollabService_Leave_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Leave'
+type CollabService_Leave_Call struct {
+	*mock.Call
+}
+
+// Leave is a helper method to define mock.On call
+//   - client *domain.CollabClient
+func (_e *CollabService_Expecter) Leave(client interface{}) *CollabService_Leave_Call {
+	return &CollabService_Leave_Call{Call: _e.mock.On("Leave", client)}
+}
+
+func (_c *CollabService_Leave_Call) Run(run func(client *domain.CollabClient)) *CollabService_Leave_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*domain.CollabClient))
+	})
+	return _c
+}
+
+func (_c *CollabService_Leave_Call) Return() *CollabService_Leave_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CollabService_Leave_Call) RunAndReturn(run func(*domain.CollabClient)) *CollabService_Leave_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Receive provides a mock function with given fields: client, msg
+func (_m *CollabService) Receive(client *domain.CollabClient, msg domain.CollabMessage) {
+	_m.Called(client, msg)
+}
+
+// CollabService_Receive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Receive'
+type CollabService_Receive_Call struct {
+	*mock.Call
+}
+
+// Receive is a helper method to define mock.On call
+//   - client *domain.CollabClient
+//   - msg domain.CollabMessage
+func (_e *CollabService_Expecter) Receive(client interface{}, msg interface{}) *CollabService_Receive_Call {
+	return &CollabService_Receive_Call{Call: _e.mock.On("Receive", client, msg)}
+}
+
+func (_c *CollabService_Receive_Call) Run(run func(client *domain.CollabClient, msg domain.CollabMessage)) *CollabService_Receive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*domain.CollabClient), args[1].(domain.CollabMessage))
+	})
+	return _c
+}
+
+func (_c *CollabService_Receive_Call) Return() *CollabService_Receive_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CollabService_Receive_Call) RunAndReturn(run func(*domain.CollabClient, domain.CollabMessage)) *CollabService_Receive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with given fields:
+func (_m *CollabService) Start() {
+	_m.Called()
+}
+
+// CollabService_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type CollabService_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *CollabService_Expecter) Start() *CollabService_Start_Call {
+	return &CollabService_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *CollabService_Start_Call) Run(run func()) *CollabService_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CollabService_Start_Call) Return() *CollabService_Start_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CollabService_Start_Call) RunAndReturn(run func()) *CollabService_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with given fields:
+func (_m *CollabService) Stop() {
+	_m.Called()
+}
+
+// CollabService_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type CollabService_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *CollabService_Expecter) Stop() *CollabService_Stop_Call {
+	return &CollabService_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *CollabService_Stop_Call) Run(run func()) *CollabService_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CollabService_Stop_Call) Return() *CollabService_Stop_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CollabService_Stop_Call) RunAndReturn(run func()) *CollabService_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCollabService creates a new instance of CollabService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCollabService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CollabService {
+	mock := &CollabService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
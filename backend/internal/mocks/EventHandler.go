@@ -0,0 +1,81 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EventHandler is an autogenerated mock type for the EventHandler type
+type EventHandler struct {
+	mock.Mock
+}
+
+type EventHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EventHandler) EXPECT() *EventHandler_Expecter {
+	return &EventHandler_Expecter{mock: &_m.Mock}
+}
+
+// Stream provides a mock function with given fields: ctx
+func (_m *EventHandler) Stream(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stream")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EventHandler_Stream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stream'
+type EventHandler_Stream_Call struct {
+	*mock.Call
+}
+
+// Stream is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *EventHandler_Expecter) Stream(ctx interface{}) *EventHandler_Stream_Call {
+	return &EventHandler_Stream_Call{Call: _e.mock.On("Stream", ctx)}
+}
+
+func (_c *EventHandler_Stream_Call) Run(run func(ctx *fiber.Ctx)) *EventHandler_Stream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *EventHandler_Stream_Call) Return(_a0 error) *EventHandler_Stream_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EventHandler_Stream_Call) RunAndReturn(run func(*fiber.Ctx) error) *EventHandler_Stream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewEventHandler creates a new instance of EventHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEventHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EventHandler {
+	mock := &EventHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
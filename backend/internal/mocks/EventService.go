@@ -0,0 +1,164 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/shironxn/blanknotes/internal/core/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EventService is an autogenerated mock type for the EventService type
+type EventService struct {
+	mock.Mock
+}
+
+type EventService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EventService) EXPECT() *EventService_Expecter {
+	return &EventService_Expecter{mock: &_m.Mock}
+}
+
+// Publish provides a mock function with given fields: eventType, note, sharedWith
+func (_m *EventService) Publish(eventType domain.NoteEventType, note domain.Note, sharedWith []uint) {
+	_m.Called(eventType, note, sharedWith)
+}
+
+// EventService_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type EventService_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - eventType domain.NoteEventType
+//   - note domain.Note
+//   - sharedWith []uint
+func (_e *EventService_Expecter) Publish(eventType interface{}, note interface{}, sharedWith interface{}) *EventService_Publish_Call {
+	return &EventService_Publish_Call{Call: _e.mock.On("Publish", eventType, note, sharedWith)}
+}
+
+func (_c *EventService_Publish_Call) Run(run func(eventType domain.NoteEventType, note domain.Note, sharedWith []uint)) *EventService_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.NoteEventType), args[1].(domain.Note), args[2].([]uint))
+	})
+	return _c
+}
+
+func (_c *EventService_Publish_Call) Return() *EventService_Publish_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *EventService_Publish_Call) RunAndReturn(run func(domain.NoteEventType, domain.Note, []uint)) *EventService_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function with given fields: userID, lastEventID
+func (_m *EventService) Subscribe(userID uint, lastEventID uint64) (*domain.NoteSubscription, []domain.NoteEvent) {
+	ret := _m.Called(userID, lastEventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 *domain.NoteSubscription
+	var r1 []domain.NoteEvent
+	if rf, ok := ret.Get(0).(func(uint, uint64) (*domain.NoteSubscription, []domain.NoteEvent)); ok {
+		return rf(userID, lastEventID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint64) *domain.NoteSubscription); ok {
+		r0 = rf(userID, lastEventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NoteSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint64) []domain.NoteEvent); ok {
+		r1 = rf(userID, lastEventID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]domain.NoteEvent)
+		}
+	}
+
+	return r0, r1
+}
+
+// EventService_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type EventService_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - userID uint
+//   - lastEventID uint64
+func (_e *EventService_Expecter) Subscribe(userID interface{}, lastEventID interface{}) *EventService_Subscribe_Call {
+	return &EventService_Subscribe_Call{Call: _e.mock.On("Subscribe", userID, lastEventID)}
+}
+
+func (_c *EventService_Subscribe_Call) Run(run func(userID uint, lastEventID uint64)) *EventService_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *EventService_Subscribe_Call) Return(_a0 *domain.NoteSubscription, _a1 []domain.NoteEvent) *EventService_Subscribe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EventService_Subscribe_Call) RunAndReturn(run func(uint, uint64) (*domain.NoteSubscription, []domain.NoteEvent)) *EventService_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unsubscribe provides a mock function with given fields: subscription
+func (_m *EventService) Unsubscribe(subscription *domain.NoteSubscription) {
+	_m.Called(subscription)
+}
+
+// EventService_Unsubscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unsubscribe'
+type EventService_Unsubscribe_Call struct {
+	*mock.Call
+}
+
+// Unsubscribe is a helper method to define mock.On call
+//   - subscription *domain.NoteSubscription
+func (_e *EventService_Expecter) Unsubscribe(subscription interface{}) *EventService_Unsubscribe_Call {
+	return &EventService_Unsubscribe_Call{Call: _e.mock.On("Unsubscribe", subscription)}
+}
+
+func (_c *EventService_Unsubscribe_Call) Run(run func(subscription *domain.NoteSubscription)) *EventService_Unsubscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*domain.NoteSubscription))
+	})
+	return _c
+}
+
+func (_c *EventService_Unsubscribe_Call) Return() *EventService_Unsubscribe_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *EventService_Unsubscribe_Call) RunAndReturn(run func(*domain.NoteSubscription)) *EventService_Unsubscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewEventService creates a new instance of EventService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEventService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EventService {
+	mock := &EventService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
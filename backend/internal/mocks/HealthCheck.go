@@ -0,0 +1,82 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// HealthCheck is an autogenerated mock type for the HealthCheck type
+type HealthCheck struct {
+	mock.Mock
+}
+
+type HealthCheck_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HealthCheck) EXPECT() *HealthCheck_Expecter {
+	return &HealthCheck_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function with given fields: ctx
+func (_m *HealthCheck) Check(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HealthCheck_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type HealthCheck_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *HealthCheck_Expecter) Check(ctx interface{}) *HealthCheck_Check_Call {
+	return &HealthCheck_Check_Call{Call: _e.mock.On("Check", ctx)}
+}
+
+func (_c *HealthCheck_Check_Call) Run(run func(ctx context.Context)) *HealthCheck_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *HealthCheck_Check_Call) Return(_a0 error) *HealthCheck_Check_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HealthCheck_Check_Call) RunAndReturn(run func(context.Context) error) *HealthCheck_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewHealthCheck creates a new instance of HealthCheck. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHealthCheck(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HealthCheck {
+	mock := &HealthCheck{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,173 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// HealthHandler is an autogenerated mock type for the HealthHandler type
+type HealthHandler struct {
+	mock.Mock
+}
+
+type HealthHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HealthHandler) EXPECT() *HealthHandler_Expecter {
+	return &HealthHandler_Expecter{mock: &_m.Mock}
+}
+
+// Live provides a mock function with given fields: ctx
+func (_m *HealthHandler) Live(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Live")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HealthHandler_Live_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Live'
+type HealthHandler_Live_Call struct {
+	*mock.Call
+}
+
+// Live is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *HealthHandler_Expecter) Live(ctx interface{}) *HealthHandler_Live_Call {
+	return &HealthHandler_Live_Call{Call: _e.mock.On("Live", ctx)}
+}
+
+func (_c *HealthHandler_Live_Call) Run(run func(ctx *fiber.Ctx)) *HealthHandler_Live_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *HealthHandler_Live_Call) Return(_a0 error) *HealthHandler_Live_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HealthHandler_Live_Call) RunAndReturn(run func(*fiber.Ctx) error) *HealthHandler_Live_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Ready provides a mock function with given fields: ctx
+func (_m *HealthHandler) Ready(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ready")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HealthHandler_Ready_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ready'
+type HealthHandler_Ready_Call struct {
+	*mock.Call
+}
+
+// Ready is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *HealthHandler_Expecter) Ready(ctx interface{}) *HealthHandler_Ready_Call {
+	return &HealthHandler_Ready_Call{Call: _e.mock.On("Ready", ctx)}
+}
+
+func (_c *HealthHandler_Ready_Call) Run(run func(ctx *fiber.Ctx)) *HealthHandler_Ready_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *HealthHandler_Ready_Call) Return(_a0 error) *HealthHandler_Ready_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HealthHandler_Ready_Call) RunAndReturn(run func(*fiber.Ctx) error) *HealthHandler_Ready_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Version provides a mock function with given fields: ctx
+func (_m *HealthHandler) Version(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Version")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HealthHandler_Version_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Version'
+type HealthHandler_Version_Call struct {
+	*mock.Call
+}
+
+// Version is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *HealthHandler_Expecter) Version(ctx interface{}) *HealthHandler_Version_Call {
+	return &HealthHandler_Version_Call{Call: _e.mock.On("Version", ctx)}
+}
+
+func (_c *HealthHandler_Version_Call) Run(run func(ctx *fiber.Ctx)) *HealthHandler_Version_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *HealthHandler_Version_Call) Return(_a0 error) *HealthHandler_Version_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HealthHandler_Version_Call) RunAndReturn(run func(*fiber.Ctx) error) *HealthHandler_Version_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewHealthHandler creates a new instance of HealthHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHealthHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HealthHandler {
+	mock := &HealthHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
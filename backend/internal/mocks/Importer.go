@@ -0,0 +1,180 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	io "io"
+
+	domain "github.com/shironxn/blanknotes/internal/core/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Importer is an autogenerated mock type for the Importer type
+type Importer struct {
+	mock.Mock
+}
+
+type Importer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Importer) EXPECT() *Importer_Expecter {
+	return &Importer_Expecter{mock: &_m.Mock}
+}
+
+// Detect provides a mock function with given fields: filename, file, size
+func (_m *Importer) Detect(filename string, file io.ReaderAt, size int64) bool {
+	ret := _m.Called(filename, file, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Detect")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, io.ReaderAt, int64) bool); ok {
+		r0 = rf(filename, file, size)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Importer_Detect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Detect'
+type Importer_Detect_Call struct {
+	*mock.Call
+}
+
+// Detect is a helper method to define mock.On call
+//   - filename string
+//   - file io.ReaderAt
+//   - size int64
+func (_e *Importer_Expecter) Detect(filename interface{}, file interface{}, size interface{}) *Importer_Detect_Call {
+	return &Importer_Detect_Call{Call: _e.mock.On("Detect", filename, file, size)}
+}
+
+func (_c *Importer_Detect_Call) Run(run func(filename string, file io.ReaderAt, size int64)) *Importer_Detect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(io.ReaderAt), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *Importer_Detect_Call) Return(_a0 bool) *Importer_Detect_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Importer_Detect_Call) RunAndReturn(run func(string, io.ReaderAt, int64) bool) *Importer_Detect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Format provides a mock function with given fields:
+func (_m *Importer) Format() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Format")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Importer_Format_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Format'
+type Importer_Format_Call struct {
+	*mock.Call
+}
+
+// Format is a helper method to define mock.On call
+func (_e *Importer_Expecter) Format() *Importer_Format_Call {
+	return &Importer_Format_Call{Call: _e.mock.On("Format")}
+}
+
+func (_c *Importer_Format_Call) Run(run func()) *Importer_Format_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Importer_Format_Call) Return(_a0 string) *Importer_Format_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Importer_Format_Call) RunAndReturn(run func() string) *Importer_Format_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Import provides a mock function with given fields: filename, file, size, yield
+func (_m *Importer) Import(filename string, file io.ReaderAt, size int64, yield func(domain.ImportedNote) error) error {
+	ret := _m.Called(filename, file, size, yield)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Import")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.ReaderAt, int64, func(domain.ImportedNote) error) error); ok {
+		r0 = rf(filename, file, size, yield)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Importer_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type Importer_Import_Call struct {
+	*mock.Call
+}
+
+// Import is a helper method to define mock.On call
+//   - filename string
+//   - file io.ReaderAt
+//   - size int64
+//   - yield func(domain.ImportedNote) error
+func (_e *Importer_Expecter) Import(filename interface{}, file interface{}, size interface{}, yield interface{}) *Importer_Import_Call {
+	return &Importer_Import_Call{Call: _e.mock.On("Import", filename, file, size, yield)}
+}
+
+func (_c *Importer_Import_Call) Run(run func(filename string, file io.ReaderAt, size int64, yield func(domain.ImportedNote) error)) *Importer_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(io.ReaderAt), args[2].(int64), args[3].(func(domain.ImportedNote) error))
+	})
+	return _c
+}
+
+func (_c *Importer_Import_Call) Return(_a0 error) *Importer_Import_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Importer_Import_Call) RunAndReturn(run func(string, io.ReaderAt, int64, func(domain.ImportedNote) error) error) *Importer_Import_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewImporter creates a new instance of Importer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewImporter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Importer {
+	mock := &Importer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
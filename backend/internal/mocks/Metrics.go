@@ -0,0 +1,97 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// Metrics is an autogenerated mock type for the Metrics type
+type Metrics struct {
+	mock.Mock
+}
+
+type Metrics_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Metrics) EXPECT() *Metrics_Expecter {
+	return &Metrics_Expecter{mock: &_m.Mock}
+}
+
+// Login provides a mock function with given fields: succeeded
+func (_m *Metrics) Login(succeeded bool) {
+	_m.Called(succeeded)
+}
+
+// Metrics_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type Metrics_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//   - succeeded bool
+func (_e *Metrics_Expecter) Login(succeeded interface{}) *Metrics_Login_Call {
+	return &Metrics_Login_Call{Call: _e.mock.On("Login", succeeded)}
+}
+
+func (_c *Metrics_Login_Call) Run(run func(succeeded bool)) *Metrics_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *Metrics_Login_Call) Return() *Metrics_Login_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Metrics_Login_Call) RunAndReturn(run func(bool)) *Metrics_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NoteCreated provides a mock function with given fields:
+func (_m *Metrics) NoteCreated() {
+	_m.Called()
+}
+
+// Metrics_NoteCreated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NoteCreated'
+type Metrics_NoteCreated_Call struct {
+	*mock.Call
+}
+
+// NoteCreated is a helper method to define mock.On call
+func (_e *Metrics_Expecter) NoteCreated() *Metrics_NoteCreated_Call {
+	return &Metrics_NoteCreated_Call{Call: _e.mock.On("NoteCreated")}
+}
+
+func (_c *Metrics_NoteCreated_Call) Run(run func()) *Metrics_NoteCreated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Metrics_NoteCreated_Call) Return() *Metrics_NoteCreated_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Metrics_NoteCreated_Call) RunAndReturn(run func()) *Metrics_NoteCreated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMetrics creates a new instance of Metrics. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMetrics(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Metrics {
+	mock := &Metrics{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
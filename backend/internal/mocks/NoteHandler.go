@@ -112,6 +112,98 @@ func (_c *NoteHandler_Delete_Call) RunAndReturn(run func(*fiber.Ctx) error) *Not
 	return _c
 }
 
+// Export provides a mock function with given fields: ctx
+func (_m *NoteHandler) Export(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NoteHandler_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type NoteHandler_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *NoteHandler_Expecter) Export(ctx interface{}) *NoteHandler_Export_Call {
+	return &NoteHandler_Export_Call{Call: _e.mock.On("Export", ctx)}
+}
+
+func (_c *NoteHandler_Export_Call) Run(run func(ctx *fiber.Ctx)) *NoteHandler_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *NoteHandler_Export_Call) Return(_a0 error) *NoteHandler_Export_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NoteHandler_Export_Call) RunAndReturn(run func(*fiber.Ctx) error) *NoteHandler_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ForceDelete provides a mock function with given fields: ctx
+func (_m *NoteHandler) ForceDelete(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForceDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NoteHandler_ForceDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForceDelete'
+type NoteHandler_ForceDelete_Call struct {
+	*mock.Call
+}
+
+// ForceDelete is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *NoteHandler_Expecter) ForceDelete(ctx interface{}) *NoteHandler_ForceDelete_Call {
+	return &NoteHandler_ForceDelete_Call{Call: _e.mock.On("ForceDelete", ctx)}
+}
+
+func (_c *NoteHandler_ForceDelete_Call) Run(run func(ctx *fiber.Ctx)) *NoteHandler_ForceDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *NoteHandler_ForceDelete_Call) Return(_a0 error) *NoteHandler_ForceDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NoteHandler_ForceDelete_Call) RunAndReturn(run func(*fiber.Ctx) error) *NoteHandler_ForceDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAll provides a mock function with given fields: ctx
 func (_m *NoteHandler) GetAll(ctx *fiber.Ctx) error {
 	ret := _m.Called(ctx)
@@ -204,6 +296,144 @@ func (_c *NoteHandler_GetByID_Call) RunAndReturn(run func(*fiber.Ctx) error) *No
 	return _c
 }
 
+// GetTrash provides a mock function with given fields: ctx
+func (_m *NoteHandler) GetTrash(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrash")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NoteHandler_GetTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrash'
+type NoteHandler_GetTrash_Call struct {
+	*mock.Call
+}
+
+// GetTrash is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *NoteHandler_Expecter) GetTrash(ctx interface{}) *NoteHandler_GetTrash_Call {
+	return &NoteHandler_GetTrash_Call{Call: _e.mock.On("GetTrash", ctx)}
+}
+
+func (_c *NoteHandler_GetTrash_Call) Run(run func(ctx *fiber.Ctx)) *NoteHandler_GetTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *NoteHandler_GetTrash_Call) Return(_a0 error) *NoteHandler_GetTrash_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NoteHandler_GetTrash_Call) RunAndReturn(run func(*fiber.Ctx) error) *NoteHandler_GetTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Import provides a mock function with given fields: ctx
+func (_m *NoteHandler) Import(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Import")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NoteHandler_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type NoteHandler_Import_Call struct {
+	*mock.Call
+}
+
+// Import is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *NoteHandler_Expecter) Import(ctx interface{}) *NoteHandler_Import_Call {
+	return &NoteHandler_Import_Call{Call: _e.mock.On("Import", ctx)}
+}
+
+func (_c *NoteHandler_Import_Call) Run(run func(ctx *fiber.Ctx)) *NoteHandler_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *NoteHandler_Import_Call) Return(_a0 error) *NoteHandler_Import_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NoteHandler_Import_Call) RunAndReturn(run func(*fiber.Ctx) error) *NoteHandler_Import_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx
+func (_m *NoteHandler) Restore(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NoteHandler_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type NoteHandler_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *NoteHandler_Expecter) Restore(ctx interface{}) *NoteHandler_Restore_Call {
+	return &NoteHandler_Restore_Call{Call: _e.mock.On("Restore", ctx)}
+}
+
+func (_c *NoteHandler_Restore_Call) Run(run func(ctx *fiber.Ctx)) *NoteHandler_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *NoteHandler_Restore_Call) Return(_a0 error) *NoteHandler_Restore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NoteHandler_Restore_Call) RunAndReturn(run func(*fiber.Ctx) error) *NoteHandler_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx
 func (_m *NoteHandler) Update(ctx *fiber.Ctx) error {
 	ret := _m.Called(ctx)
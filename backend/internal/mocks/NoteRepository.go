@@ -3,8 +3,12 @@
 package mocks
 
 import (
+	context "context"
+
 	domain "github.com/shironxn/blanknotes/internal/core/domain"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // NoteRepository is an autogenerated mock type for the NoteRepository type
@@ -20,9 +24,9 @@ func (_m *NoteRepository) EXPECT() *NoteRepository_Expecter {
 	return &NoteRepository_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function with given fields: req
-func (_m *NoteRepository) Create(req domain.NoteRequest) (*domain.Note, error) {
-	ret := _m.Called(req)
+// Create provides a mock function with given fields: ctx, req
+func (_m *NoteRepository) Create(ctx context.Context, req domain.NoteRequest) (*domain.Note, error) {
+	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
@@ -30,19 +34,19 @@ func (_m *NoteRepository) Create(req domain.NoteRequest) (*domain.Note, error) {
 
 	var r0 *domain.Note
 	var r1 error
-	if rf, ok := ret.Get(0).(func(domain.NoteRequest) (*domain.Note, error)); ok {
-		return rf(req)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.NoteRequest) (*domain.Note, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(domain.NoteRequest) *domain.Note); ok {
-		r0 = rf(req)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.NoteRequest) *domain.Note); ok {
+		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Note)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(domain.NoteRequest) error); ok {
-		r1 = rf(req)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.NoteRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -56,14 +60,15 @@ type NoteRepository_Create_Call struct {
 }
 
 // Create is a helper method to define mock.On call
+//   - ctx context.Context
 //   - req domain.NoteRequest
-func (_e *NoteRepository_Expecter) Create(req interface{}) *NoteRepository_Create_Call {
-	return &NoteRepository_Create_Call{Call: _e.mock.On("Create", req)}
+func (_e *NoteRepository_Expecter) Create(ctx interface{}, req interface{}) *NoteRepository_Create_Call {
+	return &NoteRepository_Create_Call{Call: _e.mock.On("Create", ctx, req)}
 }
 
-func (_c *NoteRepository_Create_Call) Run(run func(req domain.NoteRequest)) *NoteRepository_Create_Call {
+func (_c *NoteRepository_Create_Call) Run(run func(ctx context.Context, req domain.NoteRequest)) *NoteRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(domain.NoteRequest))
+		run(args[0].(context.Context), args[1].(domain.NoteRequest))
 	})
 	return _c
 }
@@ -73,22 +78,22 @@ func (_c *NoteRepository_Create_Call) Return(_a0 *domain.Note, _a1 error) *NoteR
 	return _c
 }
 
-func (_c *NoteRepository_Create_Call) RunAndReturn(run func(domain.NoteRequest) (*domain.Note, error)) *NoteRepository_Create_Call {
+func (_c *NoteRepository_Create_Call) RunAndReturn(run func(context.Context, domain.NoteRequest) (*domain.Note, error)) *NoteRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function with given fields: note
-func (_m *NoteRepository) Delete(note *domain.Note) error {
-	ret := _m.Called(note)
+// Delete provides a mock function with given fields: ctx, note
+func (_m *NoteRepository) Delete(ctx context.Context, note *domain.Note) error {
+	ret := _m.Called(ctx, note)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*domain.Note) error); ok {
-		r0 = rf(note)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Note) error); ok {
+		r0 = rf(ctx, note)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -102,14 +107,15 @@ type NoteRepository_Delete_Call struct {
 }
 
 // Delete is a helper method to define mock.On call
+//   - ctx context.Context
 //   - note *domain.Note
-func (_e *NoteRepository_Expecter) Delete(note interface{}) *NoteRepository_Delete_Call {
-	return &NoteRepository_Delete_Call{Call: _e.mock.On("Delete", note)}
+func (_e *NoteRepository_Expecter) Delete(ctx interface{}, note interface{}) *NoteRepository_Delete_Call {
+	return &NoteRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, note)}
 }
 
-func (_c *NoteRepository_Delete_Call) Run(run func(note *domain.Note)) *NoteRepository_Delete_Call {
+func (_c *NoteRepository_Delete_Call) Run(run func(ctx context.Context, note *domain.Note)) *NoteRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*domain.Note))
+		run(args[0].(context.Context), args[1].(*domain.Note))
 	})
 	return _c
 }
@@ -119,14 +125,109 @@ func (_c *NoteRepository_Delete_Call) Return(_a0 error) *NoteRepository_Delete_C
 	return _c
 }
 
-func (_c *NoteRepository_Delete_Call) RunAndReturn(run func(*domain.Note) error) *NoteRepository_Delete_Call {
+func (_c *NoteRepository_Delete_Call) RunAndReturn(run func(context.Context, *domain.Note) error) *NoteRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Export provides a mock function with given fields: ctx, userID, batch
+func (_m *NoteRepository) Export(ctx context.Context, userID uint, batch func([]domain.Note) error) error {
+	ret := _m.Called(ctx, userID, batch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, func([]domain.Note) error) error); ok {
+		r0 = rf(ctx, userID, batch)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NoteRepository_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type NoteRepository_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - batch func([]domain.Note) error
+func (_e *NoteRepository_Expecter) Export(ctx interface{}, userID interface{}, batch interface{}) *NoteRepository_Export_Call {
+	return &NoteRepository_Export_Call{Call: _e.mock.On("Export", ctx, userID, batch)}
+}
+
+func (_c *NoteRepository_Export_Call) Run(run func(ctx context.Context, userID uint, batch func([]domain.Note) error)) *NoteRepository_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(func([]domain.Note) error))
+	})
+	return _c
+}
+
+func (_c *NoteRepository_Export_Call) Return(_a0 error) *NoteRepository_Export_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NoteRepository_Export_Call) RunAndReturn(run func(context.Context, uint, func([]domain.Note) error) error) *NoteRepository_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ForceDelete provides a mock function with given fields: ctx, note
+func (_m *NoteRepository) ForceDelete(ctx context.Context, note *domain.Note) error {
+	ret := _m.Called(ctx, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForceDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Note) error); ok {
+		r0 = rf(ctx, note)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NoteRepository_ForceDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForceDelete'
+type NoteRepository_ForceDelete_Call struct {
+	*mock.Call
+}
+
+// ForceDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *domain.Note
+func (_e *NoteRepository_Expecter) ForceDelete(ctx interface{}, note interface{}) *NoteRepository_ForceDelete_Call {
+	return &NoteRepository_ForceDelete_Call{Call: _e.mock.On("ForceDelete", ctx, note)}
+}
+
+func (_c *NoteRepository_ForceDelete_Call) Run(run func(ctx context.Context, note *domain.Note)) *NoteRepository_ForceDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Note))
+	})
+	return _c
+}
+
+func (_c *NoteRepository_ForceDelete_Call) Return(_a0 error) *NoteRepository_ForceDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NoteRepository_ForceDelete_Call) RunAndReturn(run func(context.Context, *domain.Note) error) *NoteRepository_ForceDelete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAll provides a mock function with given fields: req, metdata
-func (_m *NoteRepository) GetAll(req domain.NoteQuery, metdata *domain.Metadata) ([]domain.Note, error) {
-	ret := _m.Called(req, metdata)
+// GetAll provides a mock function with given fields: ctx, req, metdata
+func (_m *NoteRepository) GetAll(ctx context.Context, req domain.NoteQuery, metdata *domain.Metadata) ([]domain.Note, error) {
+	ret := _m.Called(ctx, req, metdata)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAll")
@@ -134,19 +235,19 @@ func (_m *NoteRepository) GetAll(req domain.NoteQuery, metdata *domain.Metadata)
 
 	var r0 []domain.Note
 	var r1 error
-	if rf, ok := ret.Get(0).(func(domain.NoteQuery, *domain.Metadata) ([]domain.Note, error)); ok {
-		return rf(req, metdata)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.NoteQuery, *domain.Metadata) ([]domain.Note, error)); ok {
+		return rf(ctx, req, metdata)
 	}
-	if rf, ok := ret.Get(0).(func(domain.NoteQuery, *domain.Metadata) []domain.Note); ok {
-		r0 = rf(req, metdata)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.NoteQuery, *domain.Metadata) []domain.Note); ok {
+		r0 = rf(ctx, req, metdata)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.Note)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(domain.NoteQuery, *domain.Metadata) error); ok {
-		r1 = rf(req, metdata)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.NoteQuery, *domain.Metadata) error); ok {
+		r1 = rf(ctx, req, metdata)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -160,15 +261,16 @@ type NoteRepository_GetAll_Call struct {
 }
 
 // GetAll is a helper method to define mock.On call
+//   - ctx context.Context
 //   - req domain.NoteQuery
 //   - metdata *domain.Metadata
-func (_e *NoteRepository_Expecter) GetAll(req interface{}, metdata interface{}) *NoteRepository_GetAll_Call {
-	return &NoteRepository_GetAll_Call{Call: _e.mock.On("GetAll", req, metdata)}
+func (_e *NoteRepository_Expecter) GetAll(ctx interface{}, req interface{}, metdata interface{}) *NoteRepository_GetAll_Call {
+	return &NoteRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx, req, metdata)}
 }
 
-func (_c *NoteRepository_GetAll_Call) Run(run func(req domain.NoteQuery, metdata *domain.Metadata)) *NoteRepository_GetAll_Call {
+func (_c *NoteRepository_GetAll_Call) Run(run func(ctx context.Context, req domain.NoteQuery, metdata *domain.Metadata)) *NoteRepository_GetAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(domain.NoteQuery), args[1].(*domain.Metadata))
+		run(args[0].(context.Context), args[1].(domain.NoteQuery), args[2].(*domain.Metadata))
 	})
 	return _c
 }
@@ -178,14 +280,14 @@ func (_c *NoteRepository_GetAll_Call) Return(_a0 []domain.Note, _a1 error) *Note
 	return _c
 }
 
-func (_c *NoteRepository_GetAll_Call) RunAndReturn(run func(domain.NoteQuery, *domain.Metadata) ([]domain.Note, error)) *NoteRepository_GetAll_Call {
+func (_c *NoteRepository_GetAll_Call) RunAndReturn(run func(context.Context, domain.NoteQuery, *domain.Metadata) ([]domain.Note, error)) *NoteRepository_GetAll_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function with given fields: id
-func (_m *NoteRepository) GetByID(id uint) (*domain.Note, error) {
-	ret := _m.Called(id)
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *NoteRepository) GetByID(ctx context.Context, id uint) (*domain.Note, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -193,19 +295,19 @@ func (_m *NoteRepository) GetByID(id uint) (*domain.Note, error) {
 
 	var r0 *domain.Note
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uint) (*domain.Note, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*domain.Note, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(uint) *domain.Note); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *domain.Note); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Note)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uint) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -219,14 +321,15 @@ type NoteRepository_GetByID_Call struct {
 }
 
 // GetByID is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id uint
-func (_e *NoteRepository_Expecter) GetByID(id interface{}) *NoteRepository_GetByID_Call {
-	return &NoteRepository_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+func (_e *NoteRepository_Expecter) GetByID(ctx interface{}, id interface{}) *NoteRepository_GetByID_Call {
+	return &NoteRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
 }
 
-func (_c *NoteRepository_GetByID_Call) Run(run func(id uint)) *NoteRepository_GetByID_Call {
+func (_c *NoteRepository_GetByID_Call) Run(run func(ctx context.Context, id uint)) *NoteRepository_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint))
+		run(args[0].(context.Context), args[1].(uint))
 	})
 	return _c
 }
@@ -236,14 +339,318 @@ func (_c *NoteRepository_GetByID_Call) Return(_a0 *domain.Note, _a1 error) *Note
 	return _c
 }
 
-func (_c *NoteRepository_GetByID_Call) RunAndReturn(run func(uint) (*domain.Note, error)) *NoteRepository_GetByID_Call {
+func (_c *NoteRepository_GetByID_Call) RunAndReturn(run func(context.Context, uint) (*domain.Note, error)) *NoteRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTitle provides a mock function with given fields: ctx, userID, title
+func (_m *NoteRepository) GetByTitle(ctx context.Context, userID uint, title string) (*domain.Note, error) {
+	ret := _m.Called(ctx, userID, title)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTitle")
+	}
+
+	var r0 *domain.Note
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) (*domain.Note, error)); ok {
+		return rf(ctx, userID, title)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) *domain.Note); ok {
+		r0 = rf(ctx, userID, title)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Note)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, string) error); ok {
+		r1 = rf(ctx, userID, title)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NoteRepository_GetByTitle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTitle'
+type NoteRepository_GetByTitle_Call struct {
+	*mock.Call
+}
+
+// GetByTitle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - title string
+func (_e *NoteRepository_Expecter) GetByTitle(ctx interface{}, userID interface{}, title interface{}) *NoteRepository_GetByTitle_Call {
+	return &NoteRepository_GetByTitle_Call{Call: _e.mock.On("GetByTitle", ctx, userID, title)}
+}
+
+func (_c *NoteRepository_GetByTitle_Call) Run(run func(ctx context.Context, userID uint, title string)) *NoteRepository_GetByTitle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *NoteRepository_GetByTitle_Call) Return(_a0 *domain.Note, _a1 error) *NoteRepository_GetByTitle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NoteRepository_GetByTitle_Call) RunAndReturn(run func(context.Context, uint, string) (*domain.Note, error)) *NoteRepository_GetByTitle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTrash provides a mock function with given fields: ctx, req, metadata
+func (_m *NoteRepository) GetTrash(ctx context.Context, req domain.NoteQuery, metadata *domain.Metadata) ([]domain.Note, error) {
+	ret := _m.Called(ctx, req, metadata)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrash")
+	}
+
+	var r0 []domain.Note
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.NoteQuery, *domain.Metadata) ([]domain.Note, error)); ok {
+		return rf(ctx, req, metadata)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.NoteQuery, *domain.Metadata) []domain.Note); ok {
+		r0 = rf(ctx, req, metadata)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Note)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.NoteQuery, *domain.Metadata) error); ok {
+		r1 = rf(ctx, req, metadata)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NoteRepository_GetTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrash'
+type NoteRepository_GetTrash_Call struct {
+	*mock.Call
+}
+
+// GetTrash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.NoteQuery
+//   - metadata *domain.Metadata
+func (_e *NoteRepository_Expecter) GetTrash(ctx interface{}, req interface{}, metadata interface{}) *NoteRepository_GetTrash_Call {
+	return &NoteRepository_GetTrash_Call{Call: _e.mock.On("GetTrash", ctx, req, metadata)}
+}
+
+func (_c *NoteRepository_GetTrash_Call) Run(run func(ctx context.Context, req domain.NoteQuery, metadata *domain.Metadata)) *NoteRepository_GetTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.NoteQuery), args[2].(*domain.Metadata))
+	})
+	return _c
+}
+
+func (_c *NoteRepository_GetTrash_Call) Return(_a0 []domain.Note, _a1 error) *NoteRepository_GetTrash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NoteRepository_GetTrash_Call) RunAndReturn(run func(context.Context, domain.NoteQuery, *domain.Metadata) ([]domain.Note, error)) *NoteRepository_GetTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTrashByID provides a mock function with given fields: ctx, id
+func (_m *NoteRepository) GetTrashByID(ctx context.Context, id uint) (*domain.Note, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrashByID")
+	}
+
+	var r0 *domain.Note
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*domain.Note, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *domain.Note); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Note)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NoteRepository_GetTrashByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrashByID'
+type NoteRepository_GetTrashByID_Call struct {
+	*mock.Call
+}
+
+// GetTrashByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *NoteRepository_Expecter) GetTrashByID(ctx interface{}, id interface{}) *NoteRepository_GetTrashByID_Call {
+	return &NoteRepository_GetTrashByID_Call{Call: _e.mock.On("GetTrashByID", ctx, id)}
+}
+
+func (_c *NoteRepository_GetTrashByID_Call) Run(run func(ctx context.Context, id uint)) *NoteRepository_GetTrashByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *NoteRepository_GetTrashByID_Call) Return(_a0 *domain.Note, _a1 error) *NoteRepository_GetTrashByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NoteRepository_GetTrashByID_Call) RunAndReturn(run func(context.Context, uint) (*domain.Note, error)) *NoteRepository_GetTrashByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function with given fields: ctx, before
+func (_m *NoteRepository) Purge(ctx context.Context, before time.Time) (int64, []string, error) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 int64
+	var r1 []string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, []string, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) []string); ok {
+		r1 = rf(ctx, before)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, time.Time) error); ok {
+		r2 = rf(ctx, before)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// NoteRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type NoteRepository_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *NoteRepository_Expecter) Purge(ctx interface{}, before interface{}) *NoteRepository_Purge_Call {
+	return &NoteRepository_Purge_Call{Call: _e.mock.On("Purge", ctx, before)}
+}
+
+func (_c *NoteRepository_Purge_Call) Run(run func(ctx context.Context, before time.Time)) *NoteRepository_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *NoteRepository_Purge_Call) Return(_a0 int64, _a1 []string, _a2 error) *NoteRepository_Purge_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *NoteRepository_Purge_Call) RunAndReturn(run func(context.Context, time.Time) (int64, []string, error)) *NoteRepository_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, note
+func (_m *NoteRepository) Restore(ctx context.Context, note *domain.Note) (*domain.Note, error) {
+	ret := _m.Called(ctx, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 *domain.Note
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Note) (*domain.Note, error)); ok {
+		return rf(ctx, note)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Note) *domain.Note); ok {
+		r0 = rf(ctx, note)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Note)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.Note) error); ok {
+		r1 = rf(ctx, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NoteRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type NoteRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *domain.Note
+func (_e *NoteRepository_Expecter) Restore(ctx interface{}, note interface{}) *NoteRepository_Restore_Call {
+	return &NoteRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, note)}
+}
+
+func (_c *NoteRepository_Restore_Call) Run(run func(ctx context.Context, note *domain.Note)) *NoteRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Note))
+	})
+	return _c
+}
+
+func (_c *NoteRepository_Restore_Call) Return(_a0 *domain.Note, _a1 error) *NoteRepository_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NoteRepository_Restore_Call) RunAndReturn(run func(context.Context, *domain.Note) (*domain.Note, error)) *NoteRepository_Restore_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function with given fields: req, note
-func (_m *NoteRepository) Update(req domain.NoteUpdateRequest, note *domain.Note) (*domain.Note, error) {
-	ret := _m.Called(req, note)
+// Update provides a mock function with given fields: ctx, req, note
+func (_m *NoteRepository) Update(ctx context.Context, req domain.NoteUpdateRequest, note *domain.Note) (*domain.Note, error) {
+	ret := _m.Called(ctx, req, note)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -251,19 +658,19 @@ func (_m *NoteRepository) Update(req domain.NoteUpdateRequest, note *domain.Note
 
 	var r0 *domain.Note
 	var r1 error
-	if rf, ok := ret.Get(0).(func(domain.NoteUpdateRequest, *domain.Note) (*domain.Note, error)); ok {
-		return rf(req, note)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.NoteUpdateRequest, *domain.Note) (*domain.Note, error)); ok {
+		return rf(ctx, req, note)
 	}
-	if rf, ok := ret.Get(0).(func(domain.NoteUpdateRequest, *domain.Note) *domain.Note); ok {
-		r0 = rf(req, note)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.NoteUpdateRequest, *domain.Note) *domain.Note); ok {
+		r0 = rf(ctx, req, note)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Note)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(domain.NoteUpdateRequest, *domain.Note) error); ok {
-		r1 = rf(req, note)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.NoteUpdateRequest, *domain.Note) error); ok {
+		r1 = rf(ctx, req, note)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -277,15 +684,16 @@ type NoteRepository_Update_Call struct {
 }
 
 // Update is a helper method to define mock.On call
+//   - ctx context.Context
 //   - req domain.NoteUpdateRequest
 //   - note *domain.Note
-func (_e *NoteRepository_Expecter) Update(req interface{}, note interface{}) *NoteRepository_Update_Call {
-	return &NoteRepository_Update_Call{Call: _e.mock.On("Update", req, note)}
+func (_e *NoteRepository_Expecter) Update(ctx interface{}, req interface{}, note interface{}) *NoteRepository_Update_Call {
+	return &NoteRepository_Update_Call{Call: _e.mock.On("Update", ctx, req, note)}
 }
 
-func (_c *NoteRepository_Update_Call) Run(run func(req domain.NoteUpdateRequest, note *domain.Note)) *NoteRepository_Update_Call {
+func (_c *NoteRepository_Update_Call) Run(run func(ctx context.Context, req domain.NoteUpdateRequest, note *domain.Note)) *NoteRepository_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(domain.NoteUpdateRequest), args[1].(*domain.Note))
+		run(args[0].(context.Context), args[1].(domain.NoteUpdateRequest), args[2].(*domain.Note))
 	})
 	return _c
 }
@@ -295,7 +703,7 @@ func (_c *NoteRepository_Update_Call) Return(_a0 *domain.Note, _a1 error) *NoteR
 	return _c
 }
 
-func (_c *NoteRepository_Update_Call) RunAndReturn(run func(domain.NoteUpdateRequest, *domain.Note) (*domain.Note, error)) *NoteRepository_Update_Call {
+func (_c *NoteRepository_Update_Call) RunAndReturn(run func(context.Context, domain.NoteUpdateRequest, *domain.Note) (*domain.Note, error)) *NoteRepository_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3,8 +3,12 @@
 package mocks
 
 import (
+	context "context"
+
 	domain "github.com/shironxn/blanknotes/internal/core/domain"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // NoteService is an autogenerated mock type for the NoteService type
@@ -20,9 +24,9 @@ func (_m *NoteService) EXPECT() *NoteService_Expecter {
 	return &NoteService_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function with given fields: req
-func (_m *NoteService) Create(req domain.NoteRequest) (*domain.Note, error) {
-	ret := _m.Called(req)
+// Create provides a mock function with given fields: ctx, req
+func (_m *NoteService) Create(ctx context.Context, req domain.NoteRequest) (*domain.Note, error) {
+	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
@@ -30,19 +34,19 @@ func (_m *NoteService) Create(req domain.NoteRequest) (*domain.Note, error) {
 
 	var r0 *domain.Note
 	var r1 error
-	if rf, ok := ret.Get(0).(func(domain.NoteRequest) (*domain.Note, error)); ok {
-		return rf(req)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.NoteRequest) (*domain.Note, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(domain.NoteRequest) *domain.Note); ok {
-		r0 = rf(req)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.NoteRequest) *domain.Note); ok {
+		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Note)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(domain.NoteRequest) error); ok {
-		r1 = rf(req)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.NoteRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -56,14 +60,15 @@ type NoteService_Create_Call struct {
 }
 
 // Create is a helper method to define mock.On call
+//   - ctx context.Context
 //   - req domain.NoteRequest
-func (_e *NoteService_Expecter) Create(req interface{}) *NoteService_Create_Call {
-	return &NoteService_Create_Call{Call: _e.mock.On("Create", req)}
+func (_e *NoteService_Expecter) Create(ctx interface{}, req interface{}) *NoteService_Create_Call {
+	return &NoteService_Create_Call{Call: _e.mock.On("Create", ctx, req)}
 }
 
-func (_c *NoteService_Create_Call) Run(run func(req domain.NoteRequest)) *NoteService_Create_Call {
+func (_c *NoteService_Create_Call) Run(run func(ctx context.Context, req domain.NoteRequest)) *NoteService_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(domain.NoteRequest))
+		run(args[0].(context.Context), args[1].(domain.NoteRequest))
 	})
 	return _c
 }
@@ -73,22 +78,22 @@ func (_c *NoteService_Create_Call) Return(_a0 *domain.Note, _a1 error) *NoteServ
 	return _c
 }
 
-func (_c *NoteService_Create_Call) RunAndReturn(run func(domain.NoteRequest) (*domain.Note, error)) *NoteService_Create_Call {
+func (_c *NoteService_Create_Call) RunAndReturn(run func(context.Context, domain.NoteRequest) (*domain.Note, error)) *NoteService_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function with given fields: id, claims
-func (_m *NoteService) Delete(id uint, claims domain.Claims) error {
-	ret := _m.Called(id, claims)
+// Delete provides a mock function with given fields: ctx, id, claims
+func (_m *NoteService) Delete(ctx context.Context, id uint, claims domain.Claims) error {
+	ret := _m.Called(ctx, id, claims)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uint, domain.Claims) error); ok {
-		r0 = rf(id, claims)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, domain.Claims) error); ok {
+		r0 = rf(ctx, id, claims)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -102,15 +107,16 @@ type NoteService_Delete_Call struct {
 }
 
 // Delete is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id uint
 //   - claims domain.Claims
-func (_e *NoteService_Expecter) Delete(id interface{}, claims interface{}) *NoteService_Delete_Call {
-	return &NoteService_Delete_Call{Call: _e.mock.On("Delete", id, claims)}
+func (_e *NoteService_Expecter) Delete(ctx interface{}, id interface{}, claims interface{}) *NoteService_Delete_Call {
+	return &NoteService_Delete_Call{Call: _e.mock.On("Delete", ctx, id, claims)}
 }
 
-func (_c *NoteService_Delete_Call) Run(run func(id uint, claims domain.Claims)) *NoteService_Delete_Call {
+func (_c *NoteService_Delete_Call) Run(run func(ctx context.Context, id uint, claims domain.Claims)) *NoteService_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint), args[1].(domain.Claims))
+		run(args[0].(context.Context), args[1].(uint), args[2].(domain.Claims))
 	})
 	return _c
 }
@@ -120,14 +126,110 @@ func (_c *NoteService_Delete_Call) Return(_a0 error) *NoteService_Delete_Call {
 	return _c
 }
 
-func (_c *NoteService_Delete_Call) RunAndReturn(run func(uint, domain.Claims) error) *NoteService_Delete_Call {
+func (_c *NoteService_Delete_Call) RunAndReturn(run func(context.Context, uint, domain.Claims) error) *NoteService_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAll provides a mock function with given fields: req, metadata
-func (_m *NoteService) GetAll(req domain.NoteQuery, metadata *domain.Metadata) ([]domain.Note, error) {
-	ret := _m.Called(req, metadata)
+// Export provides a mock function with given fields: ctx, claims, batch
+func (_m *NoteService) Export(ctx context.Context, claims domain.Claims, batch func([]domain.Note) error) error {
+	ret := _m.Called(ctx, claims, batch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Claims, func([]domain.Note) error) error); ok {
+		r0 = rf(ctx, claims, batch)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NoteService_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type NoteService_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - ctx context.Context
+//   - claims domain.Claims
+//   - batch func([]domain.Note) error
+func (_e *NoteService_Expecter) Export(ctx interface{}, claims interface{}, batch interface{}) *NoteService_Export_Call {
+	return &NoteService_Export_Call{Call: _e.mock.On("Export", ctx, claims, batch)}
+}
+
+func (_c *NoteService_Export_Call) Run(run func(ctx context.Context, claims domain.Claims, batch func([]domain.Note) error)) *NoteService_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Claims), args[2].(func([]domain.Note) error))
+	})
+	return _c
+}
+
+func (_c *NoteService_Export_Call) Return(_a0 error) *NoteService_Export_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NoteService_Export_Call) RunAndReturn(run func(context.Context, domain.Claims, func([]domain.Note) error) error) *NoteService_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ForceDelete provides a mock function with given fields: ctx, id, claims
+func (_m *NoteService) ForceDelete(ctx context.Context, id uint, claims domain.Claims) error {
+	ret := _m.Called(ctx, id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForceDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, domain.Claims) error); ok {
+		r0 = rf(ctx, id, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NoteService_ForceDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForceDelete'
+type NoteService_ForceDelete_Call struct {
+	*mock.Call
+}
+
+// ForceDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - claims domain.Claims
+func (_e *NoteService_Expecter) ForceDelete(ctx interface{}, id interface{}, claims interface{}) *NoteService_ForceDelete_Call {
+	return &NoteService_ForceDelete_Call{Call: _e.mock.On("ForceDelete", ctx, id, claims)}
+}
+
+func (_c *NoteService_ForceDelete_Call) Run(run func(ctx context.Context, id uint, claims domain.Claims)) *NoteService_ForceDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(domain.Claims))
+	})
+	return _c
+}
+
+func (_c *NoteService_ForceDelete_Call) Return(_a0 error) *NoteService_ForceDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NoteService_ForceDelete_Call) RunAndReturn(run func(context.Context, uint, domain.Claims) error) *NoteService_ForceDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, req, metadata
+func (_m *NoteService) GetAll(ctx context.Context, req domain.NoteQuery, metadata *domain.Metadata) ([]domain.Note, error) {
+	ret := _m.Called(ctx, req, metadata)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAll")
@@ -135,19 +237,19 @@ func (_m *NoteService) GetAll(req domain.NoteQuery, metadata *domain.Metadata) (
 
 	var r0 []domain.Note
 	var r1 error
-	if rf, ok := ret.Get(0).(func(domain.NoteQuery, *domain.Metadata) ([]domain.Note, error)); ok {
-		return rf(req, metadata)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.NoteQuery, *domain.Metadata) ([]domain.Note, error)); ok {
+		return rf(ctx, req, metadata)
 	}
-	if rf, ok := ret.Get(0).(func(domain.NoteQuery, *domain.Metadata) []domain.Note); ok {
-		r0 = rf(req, metadata)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.NoteQuery, *domain.Metadata) []domain.Note); ok {
+		r0 = rf(ctx, req, metadata)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.Note)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(domain.NoteQuery, *domain.Metadata) error); ok {
-		r1 = rf(req, metadata)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.NoteQuery, *domain.Metadata) error); ok {
+		r1 = rf(ctx, req, metadata)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -161,15 +263,16 @@ type NoteService_GetAll_Call struct {
 }
 
 // GetAll is a helper method to define mock.On call
+//   - ctx context.Context
 //   - req domain.NoteQuery
 //   - metadata *domain.Metadata
-func (_e *NoteService_Expecter) GetAll(req interface{}, metadata interface{}) *NoteService_GetAll_Call {
-	return &NoteService_GetAll_Call{Call: _e.mock.On("GetAll", req, metadata)}
+func (_e *NoteService_Expecter) GetAll(ctx interface{}, req interface{}, metadata interface{}) *NoteService_GetAll_Call {
+	return &NoteService_GetAll_Call{Call: _e.mock.On("GetAll", ctx, req, metadata)}
 }
 
-func (_c *NoteService_GetAll_Call) Run(run func(req domain.NoteQuery, metadata *domain.Metadata)) *NoteService_GetAll_Call {
+func (_c *NoteService_GetAll_Call) Run(run func(ctx context.Context, req domain.NoteQuery, metadata *domain.Metadata)) *NoteService_GetAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(domain.NoteQuery), args[1].(*domain.Metadata))
+		run(args[0].(context.Context), args[1].(domain.NoteQuery), args[2].(*domain.Metadata))
 	})
 	return _c
 }
@@ -179,14 +282,14 @@ func (_c *NoteService_GetAll_Call) Return(_a0 []domain.Note, _a1 error) *NoteSer
 	return _c
 }
 
-func (_c *NoteService_GetAll_Call) RunAndReturn(run func(domain.NoteQuery, *domain.Metadata) ([]domain.Note, error)) *NoteService_GetAll_Call {
+func (_c *NoteService_GetAll_Call) RunAndReturn(run func(context.Context, domain.NoteQuery, *domain.Metadata) ([]domain.Note, error)) *NoteService_GetAll_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function with given fields: id, claims
-func (_m *NoteService) GetByID(id uint, claims *domain.Claims) (*domain.Note, error) {
-	ret := _m.Called(id, claims)
+// GetByID provides a mock function with given fields: ctx, id, claims
+func (_m *NoteService) GetByID(ctx context.Context, id uint, claims *domain.Claims) (*domain.Note, error) {
+	ret := _m.Called(ctx, id, claims)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -194,19 +297,19 @@ func (_m *NoteService) GetByID(id uint, claims *domain.Claims) (*domain.Note, er
 
 	var r0 *domain.Note
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uint, *domain.Claims) (*domain.Note, error)); ok {
-		return rf(id, claims)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *domain.Claims) (*domain.Note, error)); ok {
+		return rf(ctx, id, claims)
 	}
-	if rf, ok := ret.Get(0).(func(uint, *domain.Claims) *domain.Note); ok {
-		r0 = rf(id, claims)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *domain.Claims) *domain.Note); ok {
+		r0 = rf(ctx, id, claims)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Note)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uint, *domain.Claims) error); ok {
-		r1 = rf(id, claims)
+	if rf, ok := ret.Get(1).(func(context.Context, uint, *domain.Claims) error); ok {
+		r1 = rf(ctx, id, claims)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -220,15 +323,16 @@ type NoteService_GetByID_Call struct {
 }
 
 // GetByID is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id uint
 //   - claims *domain.Claims
-func (_e *NoteService_Expecter) GetByID(id interface{}, claims interface{}) *NoteService_GetByID_Call {
-	return &NoteService_GetByID_Call{Call: _e.mock.On("GetByID", id, claims)}
+func (_e *NoteService_Expecter) GetByID(ctx interface{}, id interface{}, claims interface{}) *NoteService_GetByID_Call {
+	return &NoteService_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id, claims)}
 }
 
-func (_c *NoteService_GetByID_Call) Run(run func(id uint, claims *domain.Claims)) *NoteService_GetByID_Call {
+func (_c *NoteService_GetByID_Call) Run(run func(ctx context.Context, id uint, claims *domain.Claims)) *NoteService_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint), args[1].(*domain.Claims))
+		run(args[0].(context.Context), args[1].(uint), args[2].(*domain.Claims))
 	})
 	return _c
 }
@@ -238,14 +342,374 @@ func (_c *NoteService_GetByID_Call) Return(_a0 *domain.Note, _a1 error) *NoteSer
 	return _c
 }
 
-func (_c *NoteService_GetByID_Call) RunAndReturn(run func(uint, *domain.Claims) (*domain.Note, error)) *NoteService_GetByID_Call {
+func (_c *NoteService_GetByID_Call) RunAndReturn(run func(context.Context, uint, *domain.Claims) (*domain.Note, error)) *NoteService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEditable provides a mock function with given fields: ctx, id, claims
+func (_m *NoteService) GetEditable(ctx context.Context, id uint, claims domain.Claims) (*domain.Note, error) {
+	ret := _m.Called(ctx, id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEditable")
+	}
+
+	var r0 *domain.Note
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, domain.Claims) (*domain.Note, error)); ok {
+		return rf(ctx, id, claims)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, domain.Claims) *domain.Note); ok {
+		r0 = rf(ctx, id, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Note)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, domain.Claims) error); ok {
+		r1 = rf(ctx, id, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NoteService_GetEditable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEditable'
+type NoteService_GetEditable_Call struct {
+	*mock.Call
+}
+
+// GetEditable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - claims domain.Claims
+func (_e *NoteService_Expecter) GetEditable(ctx interface{}, id interface{}, claims interface{}) *NoteService_GetEditable_Call {
+	return &NoteService_GetEditable_Call{Call: _e.mock.On("GetEditable", ctx, id, claims)}
+}
+
+func (_c *NoteService_GetEditable_Call) Run(run func(ctx context.Context, id uint, claims domain.Claims)) *NoteService_GetEditable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(domain.Claims))
+	})
+	return _c
+}
+
+func (_c *NoteService_GetEditable_Call) Return(_a0 *domain.Note, _a1 error) *NoteService_GetEditable_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NoteService_GetEditable_Call) RunAndReturn(run func(context.Context, uint, domain.Claims) (*domain.Note, error)) *NoteService_GetEditable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTrash provides a mock function with given fields: ctx, metadata, claims
+func (_m *NoteService) GetTrash(ctx context.Context, metadata *domain.Metadata, claims domain.Claims) ([]domain.Note, error) {
+	ret := _m.Called(ctx, metadata, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrash")
+	}
+
+	var r0 []domain.Note
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Metadata, domain.Claims) ([]domain.Note, error)); ok {
+		return rf(ctx, metadata, claims)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Metadata, domain.Claims) []domain.Note); ok {
+		r0 = rf(ctx, metadata, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Note)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.Metadata, domain.Claims) error); ok {
+		r1 = rf(ctx, metadata, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NoteService_GetTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrash'
+type NoteService_GetTrash_Call struct {
+	*mock.Call
+}
+
+// GetTrash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - metadata *domain.Metadata
+//   - claims domain.Claims
+func (_e *NoteService_Expecter) GetTrash(ctx interface{}, metadata interface{}, claims interface{}) *NoteService_GetTrash_Call {
+	return &NoteService_GetTrash_Call{Call: _e.mock.On("GetTrash", ctx, metadata, claims)}
+}
+
+func (_c *NoteService_GetTrash_Call) Run(run func(ctx context.Context, metadata *domain.Metadata, claims domain.Claims)) *NoteService_GetTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Metadata), args[2].(domain.Claims))
+	})
+	return _c
+}
+
+func (_c *NoteService_GetTrash_Call) Return(_a0 []domain.Note, _a1 error) *NoteService_GetTrash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NoteService_GetTrash_Call) RunAndReturn(run func(context.Context, *domain.Metadata, domain.Claims) ([]domain.Note, error)) *NoteService_GetTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Import provides a mock function with given fields: ctx, req, conflict
+func (_m *NoteService) Import(ctx context.Context, req domain.NoteRequest, conflict domain.ImportConflict) (*domain.Note, string, error) {
+	ret := _m.Called(ctx, req, conflict)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Import")
+	}
+
+	var r0 *domain.Note
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.NoteRequest, domain.ImportConflict) (*domain.Note, string, error)); ok {
+		return rf(ctx, req, conflict)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.NoteRequest, domain.ImportConflict) *domain.Note); ok {
+		r0 = rf(ctx, req, conflict)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Note)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.NoteRequest, domain.ImportConflict) string); ok {
+		r1 = rf(ctx, req, conflict)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.NoteRequest, domain.ImportConflict) error); ok {
+		r2 = rf(ctx, req, conflict)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// NoteService_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type NoteService_Import_Call struct {
+	*mock.Call
+}
+
+// Import is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.NoteRequest
+//   - conflict domain.ImportConflict
+func (_e *NoteService_Expecter) Import(ctx interface{}, req interface{}, conflict interface{}) *NoteService_Import_Call {
+	return &NoteService_Import_Call{Call: _e.mock.On("Import", ctx, req, conflict)}
+}
+
+func (_c *NoteService_Import_Call) Run(run func(ctx context.Context, req domain.NoteRequest, conflict domain.ImportConflict)) *NoteService_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.NoteRequest), args[2].(domain.ImportConflict))
+	})
+	return _c
+}
+
+func (_c *NoteService_Import_Call) Return(_a0 *domain.Note, _a1 string, _a2 error) *NoteService_Import_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *NoteService_Import_Call) RunAndReturn(run func(context.Context, domain.NoteRequest, domain.ImportConflict) (*domain.Note, string, error)) *NoteService_Import_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function with given fields: ctx, retention
+func (_m *NoteService) Purge(ctx context.Context, retention time.Duration) (int64, error) {
+	ret := _m.Called(ctx, retention)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) (int64, error)); ok {
+		return rf(ctx, retention)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) int64); ok {
+		r0 = rf(ctx, retention)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = rf(ctx, retention)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NoteService_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type NoteService_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - retention time.Duration
+func (_e *NoteService_Expecter) Purge(ctx interface{}, retention interface{}) *NoteService_Purge_Call {
+	return &NoteService_Purge_Call{Call: _e.mock.On("Purge", ctx, retention)}
+}
+
+func (_c *NoteService_Purge_Call) Run(run func(ctx context.Context, retention time.Duration)) *NoteService_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *NoteService_Purge_Call) Return(_a0 int64, _a1 error) *NoteService_Purge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NoteService_Purge_Call) RunAndReturn(run func(context.Context, time.Duration) (int64, error)) *NoteService_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Render provides a mock function with given fields: note
+func (_m *NoteService) Render(note *domain.Note) (string, error) {
+	ret := _m.Called(note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Render")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*domain.Note) (string, error)); ok {
+		return rf(note)
+	}
+	if rf, ok := ret.Get(0).(func(*domain.Note) string); ok {
+		r0 = rf(note)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(*domain.Note) error); ok {
+		r1 = rf(note)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NoteService_Render_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Render'
+type NoteService_Render_Call struct {
+	*mock.Call
+}
+
+// Render is a helper method to define mock.On call
+//   - note *domain.Note
+func (_e *NoteService_Expecter) Render(note interface{}) *NoteService_Render_Call {
+	return &NoteService_Render_Call{Call: _e.mock.On("Render", note)}
+}
+
+func (_c *NoteService_Render_Call) Run(run func(note *domain.Note)) *NoteService_Render_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*domain.Note))
+	})
+	return _c
+}
+
+func (_c *NoteService_Render_Call) Return(_a0 string, _a1 error) *NoteService_Render_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NoteService_Render_Call) RunAndReturn(run func(*domain.Note) (string, error)) *NoteService_Render_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, id, claims
+func (_m *NoteService) Restore(ctx context.Context, id uint, claims domain.Claims) (*domain.Note, error) {
+	ret := _m.Called(ctx, id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 *domain.Note
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, domain.Claims) (*domain.Note, error)); ok {
+		return rf(ctx, id, claims)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, domain.Claims) *domain.Note); ok {
+		r0 = rf(ctx, id, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Note)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, domain.Claims) error); ok {
+		r1 = rf(ctx, id, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NoteService_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type NoteService_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - claims domain.Claims
+func (_e *NoteService_Expecter) Restore(ctx interface{}, id interface{}, claims interface{}) *NoteService_Restore_Call {
+	return &NoteService_Restore_Call{Call: _e.mock.On("Restore", ctx, id, claims)}
+}
+
+func (_c *NoteService_Restore_Call) Run(run func(ctx context.Context, id uint, claims domain.Claims)) *NoteService_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(domain.Claims))
+	})
+	return _c
+}
+
+func (_c *NoteService_Restore_Call) Return(_a0 *domain.Note, _a1 error) *NoteService_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NoteService_Restore_Call) RunAndReturn(run func(context.Context, uint, domain.Claims) (*domain.Note, error)) *NoteService_Restore_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function with given fields: req, claims
-func (_m *NoteService) Update(req domain.NoteUpdateRequest, claims domain.Claims) (*domain.Note, error) {
-	ret := _m.Called(req, claims)
+// Update provides a mock function with given fields: ctx, req, claims
+func (_m *NoteService) Update(ctx context.Context, req domain.NoteUpdateRequest, claims domain.Claims) (*domain.Note, error) {
+	ret := _m.Called(ctx, req, claims)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -253,19 +717,19 @@ func (_m *NoteService) Update(req domain.NoteUpdateRequest, claims domain.Claims
 
 	var r0 *domain.Note
 	var r1 error
-	if rf, ok := ret.Get(0).(func(domain.NoteUpdateRequest, domain.Claims) (*domain.Note, error)); ok {
-		return rf(req, claims)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.NoteUpdateRequest, domain.Claims) (*domain.Note, error)); ok {
+		return rf(ctx, req, claims)
 	}
-	if rf, ok := ret.Get(0).(func(domain.NoteUpdateRequest, domain.Claims) *domain.Note); ok {
-		r0 = rf(req, claims)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.NoteUpdateRequest, domain.Claims) *domain.Note); ok {
+		r0 = rf(ctx, req, claims)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Note)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(domain.NoteUpdateRequest, domain.Claims) error); ok {
-		r1 = rf(req, claims)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.NoteUpdateRequest, domain.Claims) error); ok {
+		r1 = rf(ctx, req, claims)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -279,15 +743,16 @@ type NoteService_Update_Call struct {
 }
 
 // Update is a helper method to define mock.On call
+//   - ctx context.Context
 //   - req domain.NoteUpdateRequest
 //   - claims domain.Claims
-func (_e *NoteService_Expecter) Update(req interface{}, claims interface{}) *NoteService_Update_Call {
-	return &NoteService_Update_Call{Call: _e.mock.On("Update", req, claims)}
+func (_e *NoteService_Expecter) Update(ctx interface{}, req interface{}, claims interface{}) *NoteService_Update_Call {
+	return &NoteService_Update_Call{Call: _e.mock.On("Update", ctx, req, claims)}
 }
 
-func (_c *NoteService_Update_Call) Run(run func(req domain.NoteUpdateRequest, claims domain.Claims)) *NoteService_Update_Call {
+func (_c *NoteService_Update_Call) Run(run func(ctx context.Context, req domain.NoteUpdateRequest, claims domain.Claims)) *NoteService_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(domain.NoteUpdateRequest), args[1].(domain.Claims))
+		run(args[0].(context.Context), args[1].(domain.NoteUpdateRequest), args[2].(domain.Claims))
 	})
 	return _c
 }
@@ -297,7 +762,7 @@ func (_c *NoteService_Update_Call) Return(_a0 *domain.Note, _a1 error) *NoteServ
 	return _c
 }
 
-func (_c *NoteService_Update_Call) RunAndReturn(run func(domain.NoteUpdateRequest, domain.Claims) (*domain.Note, error)) *NoteService_Update_Call {
+func (_c *NoteService_Update_Call) RunAndReturn(run func(context.Context, domain.NoteUpdateRequest, domain.Claims) (*domain.Note, error)) *NoteService_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
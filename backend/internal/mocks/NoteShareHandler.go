@@ -0,0 +1,219 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NoteShareHandler is an autogenerated mock type for the NoteShareHandler type
+type NoteShareHandler struct {
+	mock.Mock
+}
+
+type NoteShareHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NoteShareHandler) EXPECT() *NoteShareHandler_Expecter {
+	return &NoteShareHandler_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx
+func (_m *NoteShareHandler) Delete(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NoteShareHandler_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type NoteShareHandler_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *NoteShareHandler_Expecter) Delete(ctx interface{}) *NoteShareHandler_Delete_Call {
+	return &NoteShareHandler_Delete_Call{Call: _e.mock.On("Delete", ctx)}
+}
+
+func (_c *NoteShareHandler_Delete_Call) Run(run func(ctx *fiber.Ctx)) *NoteShareHandler_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *NoteShareHandler_Delete_Call) Return(_a0 error) *NoteShareHandler_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NoteShareHandler_Delete_Call) RunAndReturn(run func(*fiber.Ctx) error) *NoteShareHandler_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *NoteShareHandler) GetAll(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NoteShareHandler_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type NoteShareHandler_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *NoteShareHandler_Expecter) GetAll(ctx interface{}) *NoteShareHandler_GetAll_Call {
+	return &NoteShareHandler_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *NoteShareHandler_GetAll_Call) Run(run func(ctx *fiber.Ctx)) *NoteShareHandler_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *NoteShareHandler_GetAll_Call) Return(_a0 error) *NoteShareHandler_GetAll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NoteShareHandler_GetAll_Call) RunAndReturn(run func(*fiber.Ctx) error) *NoteShareHandler_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetShared provides a mock function with given fields: ctx
+func (_m *NoteShareHandler) GetShared(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShared")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NoteShareHandler_GetShared_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShared'
+type NoteShareHandler_GetShared_Call struct {
+	*mock.Call
+}
+
+// GetShared is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *NoteShareHandler_Expecter) GetShared(ctx interface{}) *NoteShareHandler_GetShared_Call {
+	return &NoteShareHandler_GetShared_Call{Call: _e.mock.On("GetShared", ctx)}
+}
+
+func (_c *NoteShareHandler_GetShared_Call) Run(run func(ctx *fiber.Ctx)) *NoteShareHandler_GetShared_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *NoteShareHandler_GetShared_Call) Return(_a0 error) *NoteShareHandler_GetShared_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NoteShareHandler_GetShared_Call) RunAndReturn(run func(*fiber.Ctx) error) *NoteShareHandler_GetShared_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx
+func (_m *NoteShareHandler) Save(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NoteShareHandler_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type NoteShareHandler_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *NoteShareHandler_Expecter) Save(ctx interface{}) *NoteShareHandler_Save_Call {
+	return &NoteShareHandler_Save_Call{Call: _e.mock.On("Save", ctx)}
+}
+
+func (_c *NoteShareHandler_Save_Call) Run(run func(ctx *fiber.Ctx)) *NoteShareHandler_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *NoteShareHandler_Save_Call) Return(_a0 error) *NoteShareHandler_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NoteShareHandler_Save_Call) RunAndReturn(run func(*fiber.Ctx) error) *NoteShareHandler_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNoteShareHandler creates a new instance of NoteShareHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNoteShareHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NoteShareHandler {
+	mock := &NoteShareHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
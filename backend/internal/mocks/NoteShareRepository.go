@@ -0,0 +1,322 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/shironxn/blanknotes/internal/core/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NoteShareRepository is an autogenerated mock type for the NoteShareRepository type
+type NoteShareRepository struct {
+	mock.Mock
+}
+
+type NoteShareRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NoteShareRepository) EXPECT() *NoteShareRepository_Expecter {
+	return &NoteShareRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, share
+func (_m *NoteShareRepository) Delete(ctx context.Context, share *domain.NoteShare) error {
+	ret := _m.Called(ctx, share)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.NoteShare) error); ok {
+		r0 = rf(ctx, share)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NoteShareRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type NoteShareRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - share *domain.NoteShare
+func (_e *NoteShareRepository_Expecter) Delete(ctx interface{}, share interface{}) *NoteShareRepository_Delete_Call {
+	return &NoteShareRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, share)}
+}
+
+func (_c *NoteShareRepository_Delete_Call) Run(run func(ctx context.Context, share *domain.NoteShare)) *NoteShareRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.NoteShare))
+	})
+	return _c
+}
+
+func (_c *NoteShareRepository_Delete_Call) Return(_a0 error) *NoteShareRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NoteShareRepository_Delete_Call) RunAndReturn(run func(context.Context, *domain.NoteShare) error) *NoteShareRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, noteID, userID
+func (_m *NoteShareRepository) Get(ctx context.Context, noteID uint, userID uint) (*domain.NoteShare, error) {
+	ret := _m.Called(ctx, noteID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *domain.NoteShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) (*domain.NoteShare, error)); ok {
+		return rf(ctx, noteID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) *domain.NoteShare); ok {
+		r0 = rf(ctx, noteID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NoteShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint) error); ok {
+		r1 = rf(ctx, noteID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NoteShareRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type NoteShareRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - noteID uint
+//   - userID uint
+func (_e *NoteShareRepository_Expecter) Get(ctx interface{}, noteID interface{}, userID interface{}) *NoteShareRepository_Get_Call {
+	return &NoteShareRepository_Get_Call{Call: _e.mock.On("Get", ctx, noteID, userID)}
+}
+
+func (_c *NoteShareRepository_Get_Call) Run(run func(ctx context.Context, noteID uint, userID uint)) *NoteShareRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *NoteShareRepository_Get_Call) Return(_a0 *domain.NoteShare, _a1 error) *NoteShareRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NoteShareRepository_Get_Call) RunAndReturn(run func(context.Context, uint, uint) (*domain.NoteShare, error)) *NoteShareRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, noteID
+func (_m *NoteShareRepository) GetAll(ctx context.Context, noteID uint) ([]domain.NoteShare, error) {
+	ret := _m.Called(ctx, noteID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []domain.NoteShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]domain.NoteShare, error)); ok {
+		return rf(ctx, noteID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []domain.NoteShare); ok {
+		r0 = rf(ctx, noteID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.NoteShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, noteID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NoteShareRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type NoteShareRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - noteID uint
+func (_e *NoteShareRepository_Expecter) GetAll(ctx interface{}, noteID interface{}) *NoteShareRepository_GetAll_Call {
+	return &NoteShareRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx, noteID)}
+}
+
+func (_c *NoteShareRepository_GetAll_Call) Run(run func(ctx context.Context, noteID uint)) *NoteShareRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *NoteShareRepository_GetAll_Call) Return(_a0 []domain.NoteShare, _a1 error) *NoteShareRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NoteShareRepository_GetAll_Call) RunAndReturn(run func(context.Context, uint) ([]domain.NoteShare, error)) *NoteShareRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetShared provides a mock function with given fields: ctx, userID, metadata
+func (_m *NoteShareRepository) GetShared(ctx context.Context, userID uint, metadata *domain.Metadata) ([]domain.Note, error) {
+	ret := _m.Called(ctx, userID, metadata)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShared")
+	}
+
+	var r0 []domain.Note
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *domain.Metadata) ([]domain.Note, error)); ok {
+		return rf(ctx, userID, metadata)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *domain.Metadata) []domain.Note); ok {
+		r0 = rf(ctx, userID, metadata)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Note)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, *domain.Metadata) error); ok {
+		r1 = rf(ctx, userID, metadata)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NoteShareRepository_GetShared_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShared'
+type NoteShareRepository_GetShared_Call struct {
+	*mock.Call
+}
+
+// GetShared is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - metadata *domain.Metadata
+func (_e *NoteShareRepository_Expecter) GetShared(ctx interface{}, userID interface{}, metadata interface{}) *NoteShareRepository_GetShared_Call {
+	return &NoteShareRepository_GetShared_Call{Call: _e.mock.On("GetShared", ctx, userID, metadata)}
+}
+
+func (_c *NoteShareRepository_GetShared_Call) Run(run func(ctx context.Context, userID uint, metadata *domain.Metadata)) *NoteShareRepository_GetShared_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(*domain.Metadata))
+	})
+	return _c
+}
+
+func (_c *NoteShareRepository_GetShared_Call) Return(_a0 []domain.Note, _a1 error) *NoteShareRepository_GetShared_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NoteShareRepository_GetShared_Call) RunAndReturn(run func(context.Context, uint, *domain.Metadata) ([]domain.Note, error)) *NoteShareRepository_GetShared_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, req
+func (_m *NoteShareRepository) Save(ctx context.Context, req domain.NoteShareRequest) (*domain.NoteShare, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 *domain.NoteShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.NoteShareRequest) (*domain.NoteShare, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.NoteShareRequest) *domain.NoteShare); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NoteShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.NoteShareRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NoteShareRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type NoteShareRepository_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.NoteShareRequest
+func (_e *NoteShareRepository_Expecter) Save(ctx interface{}, req interface{}) *NoteShareRepository_Save_Call {
+	return &NoteShareRepository_Save_Call{Call: _e.mock.On("Save", ctx, req)}
+}
+
+func (_c *NoteShareRepository_Save_Call) Run(run func(ctx context.Context, req domain.NoteShareRequest)) *NoteShareRepository_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.NoteShareRequest))
+	})
+	return _c
+}
+
+func (_c *NoteShareRepository_Save_Call) Return(_a0 *domain.NoteShare, _a1 error) *NoteShareRepository_Save_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NoteShareRepository_Save_Call) RunAndReturn(run func(context.Context, domain.NoteShareRequest) (*domain.NoteShare, error)) *NoteShareRepository_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNoteShareRepository creates a new instance of NoteShareRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNoteShareRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NoteShareRepository {
+	mock := &NoteShareRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
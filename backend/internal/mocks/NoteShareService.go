@@ -0,0 +1,266 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/shironxn/blanknotes/internal/core/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NoteShareService is an autogenerated mock type for the NoteShareService type
+type NoteShareService struct {
+	mock.Mock
+}
+
+type NoteShareService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NoteShareService) EXPECT() *NoteShareService_Expecter {
+	return &NoteShareService_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, noteID, userID, claims
+func (_m *NoteShareService) Delete(ctx context.Context, noteID uint, userID uint, claims domain.Claims) error {
+	ret := _m.Called(ctx, noteID, userID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, domain.Claims) error); ok {
+		r0 = rf(ctx, noteID, userID, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NoteShareService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type NoteShareService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - noteID uint
+//   - userID uint
+//   - claims domain.Claims
+func (_e *NoteShareService_Expecter) Delete(ctx interface{}, noteID interface{}, userID interface{}, claims interface{}) *NoteShareService_Delete_Call {
+	return &NoteShareService_Delete_Call{Call: _e.mock.On("Delete", ctx, noteID, userID, claims)}
+}
+
+func (_c *NoteShareService_Delete_Call) Run(run func(ctx context.Context, noteID uint, userID uint, claims domain.Claims)) *NoteShareService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint), args[3].(domain.Claims))
+	})
+	return _c
+}
+
+func (_c *NoteShareService_Delete_Call) Return(_a0 error) *NoteShareService_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NoteShareService_Delete_Call) RunAndReturn(run func(context.Context, uint, uint, domain.Claims) error) *NoteShareService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, noteID, claims
+func (_m *NoteShareService) GetAll(ctx context.Context, noteID uint, claims domain.Claims) ([]domain.NoteShare, error) {
+	ret := _m.Called(ctx, noteID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []domain.NoteShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, domain.Claims) ([]domain.NoteShare, error)); ok {
+		return rf(ctx, noteID, claims)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, domain.Claims) []domain.NoteShare); ok {
+		r0 = rf(ctx, noteID, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.NoteShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, domain.Claims) error); ok {
+		r1 = rf(ctx, noteID, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NoteShareService_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type NoteShareService_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - noteID uint
+//   - claims domain.Claims
+func (_e *NoteShareService_Expecter) GetAll(ctx interface{}, noteID interface{}, claims interface{}) *NoteShareService_GetAll_Call {
+	return &NoteShareService_GetAll_Call{Call: _e.mock.On("GetAll", ctx, noteID, claims)}
+}
+
+func (_c *NoteShareService_GetAll_Call) Run(run func(ctx context.Context, noteID uint, claims domain.Claims)) *NoteShareService_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(domain.Claims))
+	})
+	return _c
+}
+
+func (_c *NoteShareService_GetAll_Call) Return(_a0 []domain.NoteShare, _a1 error) *NoteShareService_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NoteShareService_GetAll_Call) RunAndReturn(run func(context.Context, uint, domain.Claims) ([]domain.NoteShare, error)) *NoteShareService_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetShared provides a mock function with given fields: ctx, metadata, claims
+func (_m *NoteShareService) GetShared(ctx context.Context, metadata *domain.Metadata, claims domain.Claims) ([]domain.Note, error) {
+	ret := _m.Called(ctx, metadata, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShared")
+	}
+
+	var r0 []domain.Note
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Metadata, domain.Claims) ([]domain.Note, error)); ok {
+		return rf(ctx, metadata, claims)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Metadata, domain.Claims) []domain.Note); ok {
+		r0 = rf(ctx, metadata, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Note)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.Metadata, domain.Claims) error); ok {
+		r1 = rf(ctx, metadata, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NoteShareService_GetShared_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShared'
+type NoteShareService_GetShared_Call struct {
+	*mock.Call
+}
+
+// GetShared is a helper method to define mock.On call
+//   - ctx context.Context
+//   - metadata *domain.Metadata
+//   - claims domain.Claims
+func (_e *NoteShareService_Expecter) GetShared(ctx interface{}, metadata interface{}, claims interface{}) *NoteShareService_GetShared_Call {
+	return &NoteShareService_GetShared_Call{Call: _e.mock.On("GetShared", ctx, metadata, claims)}
+}
+
+func (_c *NoteShareService_GetShared_Call) Run(run func(ctx context.Context, metadata *domain.Metadata, claims domain.Claims)) *NoteShareService_GetShared_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Metadata), args[2].(domain.Claims))
+	})
+	return _c
+}
+
+func (_c *NoteShareService_GetShared_Call) Return(_a0 []domain.Note, _a1 error) *NoteShareService_GetShared_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NoteShareService_GetShared_Call) RunAndReturn(run func(context.Context, *domain.Metadata, domain.Claims) ([]domain.Note, error)) *NoteShareService_GetShared_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, req, claims
+func (_m *NoteShareService) Save(ctx context.Context, req domain.NoteShareRequest, claims domain.Claims) (*domain.NoteShare, error) {
+	ret := _m.Called(ctx, req, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 *domain.NoteShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.NoteShareRequest, domain.Claims) (*domain.NoteShare, error)); ok {
+		return rf(ctx, req, claims)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.NoteShareRequest, domain.Claims) *domain.NoteShare); ok {
+		r0 = rf(ctx, req, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NoteShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.NoteShareRequest, domain.Claims) error); ok {
+		r1 = rf(ctx, req, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NoteShareService_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type NoteShareService_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.NoteShareRequest
+//   - claims domain.Claims
+func (_e *NoteShareService_Expecter) Save(ctx interface{}, req interface{}, claims interface{}) *NoteShareService_Save_Call {
+	return &NoteShareService_Save_Call{Call: _e.mock.On("Save", ctx, req, claims)}
+}
+
+func (_c *NoteShareService_Save_Call) Run(run func(ctx context.Context, req domain.NoteShareRequest, claims domain.Claims)) *NoteShareService_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.NoteShareRequest), args[2].(domain.Claims))
+	})
+	return _c
+}
+
+func (_c *NoteShareService_Save_Call) Return(_a0 *domain.NoteShare, _a1 error) *NoteShareService_Save_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NoteShareService_Save_Call) RunAndReturn(run func(context.Context, domain.NoteShareRequest, domain.Claims) (*domain.NoteShare, error)) *NoteShareService_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNoteShareService creates a new instance of NoteShareService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNoteShareService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NoteShareService {
+	mock := &NoteShareService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
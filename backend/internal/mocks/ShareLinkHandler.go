@@ -0,0 +1,219 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ShareLinkHandler is an autogenerated mock type for the ShareLinkHandler type
+type ShareLinkHandler struct {
+	mock.Mock
+}
+
+type ShareLinkHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ShareLinkHandler) EXPECT() *ShareLinkHandler_Expecter {
+	return &ShareLinkHandler_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx
+func (_m *ShareLinkHandler) Create(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ShareLinkHandler_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ShareLinkHandler_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *ShareLinkHandler_Expecter) Create(ctx interface{}) *ShareLinkHandler_Create_Call {
+	return &ShareLinkHandler_Create_Call{Call: _e.mock.On("Create", ctx)}
+}
+
+func (_c *ShareLinkHandler_Create_Call) Run(run func(ctx *fiber.Ctx)) *ShareLinkHandler_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *ShareLinkHandler_Create_Call) Return(_a0 error) *ShareLinkHandler_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ShareLinkHandler_Create_Call) RunAndReturn(run func(*fiber.Ctx) error) *ShareLinkHandler_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx
+func (_m *ShareLinkHandler) Delete(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ShareLinkHandler_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ShareLinkHandler_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *ShareLinkHandler_Expecter) Delete(ctx interface{}) *ShareLinkHandler_Delete_Call {
+	return &ShareLinkHandler_Delete_Call{Call: _e.mock.On("Delete", ctx)}
+}
+
+func (_c *ShareLinkHandler_Delete_Call) Run(run func(ctx *fiber.Ctx)) *ShareLinkHandler_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *ShareLinkHandler_Delete_Call) Return(_a0 error) *ShareLinkHandler_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ShareLinkHandler_Delete_Call) RunAndReturn(run func(*fiber.Ctx) error) *ShareLinkHandler_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *ShareLinkHandler) GetAll(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ShareLinkHandler_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type ShareLinkHandler_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *ShareLinkHandler_Expecter) GetAll(ctx interface{}) *ShareLinkHandler_GetAll_Call {
+	return &ShareLinkHandler_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *ShareLinkHandler_GetAll_Call) Run(run func(ctx *fiber.Ctx)) *ShareLinkHandler_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *ShareLinkHandler_GetAll_Call) Return(_a0 error) *ShareLinkHandler_GetAll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ShareLinkHandler_GetAll_Call) RunAndReturn(run func(*fiber.Ctx) error) *ShareLinkHandler_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Open provides a mock function with given fields: ctx
+func (_m *ShareLinkHandler) Open(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Open")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ShareLinkHandler_Open_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Open'
+type ShareLinkHandler_Open_Call struct {
+	*mock.Call
+}
+
+// Open is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *ShareLinkHandler_Expecter) Open(ctx interface{}) *ShareLinkHandler_Open_Call {
+	return &ShareLinkHandler_Open_Call{Call: _e.mock.On("Open", ctx)}
+}
+
+func (_c *ShareLinkHandler_Open_Call) Run(run func(ctx *fiber.Ctx)) *ShareLinkHandler_Open_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *ShareLinkHandler_Open_Call) Return(_a0 error) *ShareLinkHandler_Open_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ShareLinkHandler_Open_Call) RunAndReturn(run func(*fiber.Ctx) error) *ShareLinkHandler_Open_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewShareLinkHandler creates a new instance of ShareLinkHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewShareLinkHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ShareLinkHandler {
+	mock := &ShareLinkHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,320 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/shironxn/blanknotes/internal/core/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ShareLinkRepository is an autogenerated mock type for the ShareLinkRepository type
+type ShareLinkRepository struct {
+	mock.Mock
+}
+
+type ShareLinkRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ShareLinkRepository) EXPECT() *ShareLinkRepository_Expecter {
+	return &ShareLinkRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *ShareLinkRepository) Create(ctx context.Context, req domain.ShareLinkRequest) (*domain.ShareLink, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.ShareLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ShareLinkRequest) (*domain.ShareLink, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ShareLinkRequest) *domain.ShareLink); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ShareLink)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ShareLinkRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ShareLinkRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ShareLinkRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.ShareLinkRequest
+func (_e *ShareLinkRepository_Expecter) Create(ctx interface{}, req interface{}) *ShareLinkRepository_Create_Call {
+	return &ShareLinkRepository_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *ShareLinkRepository_Create_Call) Run(run func(ctx context.Context, req domain.ShareLinkRequest)) *ShareLinkRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ShareLinkRequest))
+	})
+	return _c
+}
+
+func (_c *ShareLinkRepository_Create_Call) Return(_a0 *domain.ShareLink, _a1 error) *ShareLinkRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ShareLinkRepository_Create_Call) RunAndReturn(run func(context.Context, domain.ShareLinkRequest) (*domain.ShareLink, error)) *ShareLinkRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, link
+func (_m *ShareLinkRepository) Delete(ctx context.Context, link *domain.ShareLink) error {
+	ret := _m.Called(ctx, link)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ShareLink) error); ok {
+		r0 = rf(ctx, link)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ShareLinkRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ShareLinkRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - link *domain.ShareLink
+func (_e *ShareLinkRepository_Expecter) Delete(ctx interface{}, link interface{}) *ShareLinkRepository_Delete_Call {
+	return &ShareLinkRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, link)}
+}
+
+func (_c *ShareLinkRepository_Delete_Call) Run(run func(ctx context.Context, link *domain.ShareLink)) *ShareLinkRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.ShareLink))
+	})
+	return _c
+}
+
+func (_c *ShareLinkRepository_Delete_Call) Return(_a0 error) *ShareLinkRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ShareLinkRepository_Delete_Call) RunAndReturn(run func(context.Context, *domain.ShareLink) error) *ShareLinkRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, noteID
+func (_m *ShareLinkRepository) GetAll(ctx context.Context, noteID uint) ([]domain.ShareLink, error) {
+	ret := _m.Called(ctx, noteID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []domain.ShareLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]domain.ShareLink, error)); ok {
+		return rf(ctx, noteID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []domain.ShareLink); ok {
+		r0 = rf(ctx, noteID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ShareLink)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, noteID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ShareLinkRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type ShareLinkRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - noteID uint
+func (_e *ShareLinkRepository_Expecter) GetAll(ctx interface{}, noteID interface{}) *ShareLinkRepository_GetAll_Call {
+	return &ShareLinkRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx, noteID)}
+}
+
+func (_c *ShareLinkRepository_GetAll_Call) Run(run func(ctx context.Context, noteID uint)) *ShareLinkRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *ShareLinkRepository_GetAll_Call) Return(_a0 []domain.ShareLink, _a1 error) *ShareLinkRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ShareLinkRepository_GetAll_Call) RunAndReturn(run func(context.Context, uint) ([]domain.ShareLink, error)) *ShareLinkRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *ShareLinkRepository) GetByID(ctx context.Context, id uint) (*domain.ShareLink, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.ShareLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*domain.ShareLink, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *domain.ShareLink); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ShareLink)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ShareLinkRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type ShareLinkRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *ShareLinkRepository_Expecter) GetByID(ctx interface{}, id interface{}) *ShareLinkRepository_GetByID_Call {
+	return &ShareLinkRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *ShareLinkRepository_GetByID_Call) Run(run func(ctx context.Context, id uint)) *ShareLinkRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *ShareLinkRepository_GetByID_Call) Return(_a0 *domain.ShareLink, _a1 error) *ShareLinkRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ShareLinkRepository_GetByID_Call) RunAndReturn(run func(context.Context, uint) (*domain.ShareLink, error)) *ShareLinkRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByToken provides a mock function with given fields: ctx, token
+func (_m *ShareLinkRepository) GetByToken(ctx context.Context, token string) (*domain.ShareLink, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByToken")
+	}
+
+	var r0 *domain.ShareLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.ShareLink, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.ShareLink); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ShareLink)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ShareLinkRepository_GetByToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByToken'
+type ShareLinkRepository_GetByToken_Call struct {
+	*mock.Call
+}
+
+// GetByToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *ShareLinkRepository_Expecter) GetByToken(ctx interface{}, token interface{}) *ShareLinkRepository_GetByToken_Call {
+	return &ShareLinkRepository_GetByToken_Call{Call: _e.mock.On("GetByToken", ctx, token)}
+}
+
+func (_c *ShareLinkRepository_GetByToken_Call) Run(run func(ctx context.Context, token string)) *ShareLinkRepository_GetByToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ShareLinkRepository_GetByToken_Call) Return(_a0 *domain.ShareLink, _a1 error) *ShareLinkRepository_GetByToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ShareLinkRepository_GetByToken_Call) RunAndReturn(run func(context.Context, string) (*domain.ShareLink, error)) *ShareLinkRepository_GetByToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewShareLinkRepository creates a new instance of ShareLinkRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewShareLinkRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ShareLinkRepository {
+	mock := &ShareLinkRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
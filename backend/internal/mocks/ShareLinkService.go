@@ -0,0 +1,266 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/shironxn/blanknotes/internal/core/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ShareLinkService is an autogenerated mock type for the ShareLinkService type
+type ShareLinkService struct {
+	mock.Mock
+}
+
+type ShareLinkService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ShareLinkService) EXPECT() *ShareLinkService_Expecter {
+	return &ShareLinkService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req, claims
+func (_m *ShareLinkService) Create(ctx context.Context, req domain.ShareLinkRequest, claims domain.Claims) (*domain.ShareLink, error) {
+	ret := _m.Called(ctx, req, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.ShareLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ShareLinkRequest, domain.Claims) (*domain.ShareLink, error)); ok {
+		return rf(ctx, req, claims)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ShareLinkRequest, domain.Claims) *domain.ShareLink); ok {
+		r0 = rf(ctx, req, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ShareLink)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ShareLinkRequest, domain.Claims) error); ok {
+		r1 = rf(ctx, req, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ShareLinkService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ShareLinkService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.ShareLinkRequest
+//   - claims domain.Claims
+func (_e *ShareLinkService_Expecter) Create(ctx interface{}, req interface{}, claims interface{}) *ShareLinkService_Create_Call {
+	return &ShareLinkService_Create_Call{Call: _e.mock.On("Create", ctx, req, claims)}
+}
+
+func (_c *ShareLinkService_Create_Call) Run(run func(ctx context.Context, req domain.ShareLinkRequest, claims domain.Claims)) *ShareLinkService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ShareLinkRequest), args[2].(domain.Claims))
+	})
+	return _c
+}
+
+func (_c *ShareLinkService_Create_Call) Return(_a0 *domain.ShareLink, _a1 error) *ShareLinkService_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ShareLinkService_Create_Call) RunAndReturn(run func(context.Context, domain.ShareLinkRequest, domain.Claims) (*domain.ShareLink, error)) *ShareLinkService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, noteID, id, claims
+func (_m *ShareLinkService) Delete(ctx context.Context, noteID uint, id uint, claims domain.Claims) error {
+	ret := _m.Called(ctx, noteID, id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, domain.Claims) error); ok {
+		r0 = rf(ctx, noteID, id, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ShareLinkService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ShareLinkService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - noteID uint
+//   - id uint
+//   - claims domain.Claims
+func (_e *ShareLinkService_Expecter) Delete(ctx interface{}, noteID interface{}, id interface{}, claims interface{}) *ShareLinkService_Delete_Call {
+	return &ShareLinkService_Delete_Call{Call: _e.mock.On("Delete", ctx, noteID, id, claims)}
+}
+
+func (_c *ShareLinkService_Delete_Call) Run(run func(ctx context.Context, noteID uint, id uint, claims domain.Claims)) *ShareLinkService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint), args[3].(domain.Claims))
+	})
+	return _c
+}
+
+func (_c *ShareLinkService_Delete_Call) Return(_a0 error) *ShareLinkService_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ShareLinkService_Delete_Call) RunAndReturn(run func(context.Context, uint, uint, domain.Claims) error) *ShareLinkService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, noteID, claims
+func (_m *ShareLinkService) GetAll(ctx context.Context, noteID uint, claims domain.Claims) ([]domain.ShareLink, error) {
+	ret := _m.Called(ctx, noteID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []domain.ShareLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, domain.Claims) ([]domain.ShareLink, error)); ok {
+		return rf(ctx, noteID, claims)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, domain.Claims) []domain.ShareLink); ok {
+		r0 = rf(ctx, noteID, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ShareLink)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, domain.Claims) error); ok {
+		r1 = rf(ctx, noteID, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ShareLinkService_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type ShareLinkService_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - noteID uint
+//   - claims domain.Claims
+func (_e *ShareLinkService_Expecter) GetAll(ctx interface{}, noteID interface{}, claims interface{}) *ShareLinkService_GetAll_Call {
+	return &ShareLinkService_GetAll_Call{Call: _e.mock.On("GetAll", ctx, noteID, claims)}
+}
+
+func (_c *ShareLinkService_GetAll_Call) Run(run func(ctx context.Context, noteID uint, claims domain.Claims)) *ShareLinkService_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(domain.Claims))
+	})
+	return _c
+}
+
+func (_c *ShareLinkService_GetAll_Call) Return(_a0 []domain.ShareLink, _a1 error) *ShareLinkService_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ShareLinkService_GetAll_Call) RunAndReturn(run func(context.Context, uint, domain.Claims) ([]domain.ShareLink, error)) *ShareLinkService_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Open provides a mock function with given fields: ctx, token, password
+func (_m *ShareLinkService) Open(ctx context.Context, token string, password string) (*domain.Note, error) {
+	ret := _m.Called(ctx, token, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Open")
+	}
+
+	var r0 *domain.Note
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.Note, error)); ok {
+		return rf(ctx, token, password)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.Note); ok {
+		r0 = rf(ctx, token, password)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Note)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, token, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ShareLinkService_Open_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Open'
+type ShareLinkService_Open_Call struct {
+	*mock.Call
+}
+
+// Open is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - password string
+func (_e *ShareLinkService_Expecter) Open(ctx interface{}, token interface{}, password interface{}) *ShareLinkService_Open_Call {
+	return &ShareLinkService_Open_Call{Call: _e.mock.On("Open", ctx, token, password)}
+}
+
+func (_c *ShareLinkService_Open_Call) Run(run func(ctx context.Context, token string, password string)) *ShareLinkService_Open_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ShareLinkService_Open_Call) Return(_a0 *domain.Note, _a1 error) *ShareLinkService_Open_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ShareLinkService_Open_Call) RunAndReturn(run func(context.Context, string, string) (*domain.Note, error)) *ShareLinkService_Open_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewShareLinkService creates a new instance of ShareLinkService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewShareLinkService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ShareLinkService {
+	mock := &ShareLinkService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
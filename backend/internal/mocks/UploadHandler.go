@@ -0,0 +1,173 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UploadHandler is an autogenerated mock type for the UploadHandler type
+type UploadHandler struct {
+	mock.Mock
+}
+
+type UploadHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UploadHandler) EXPECT() *UploadHandler_Expecter {
+	return &UploadHandler_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx
+func (_m *UploadHandler) Create(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UploadHandler_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type UploadHandler_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *UploadHandler_Expecter) Create(ctx interface{}) *UploadHandler_Create_Call {
+	return &UploadHandler_Create_Call{Call: _e.mock.On("Create", ctx)}
+}
+
+func (_c *UploadHandler_Create_Call) Run(run func(ctx *fiber.Ctx)) *UploadHandler_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *UploadHandler_Create_Call) Return(_a0 error) *UploadHandler_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UploadHandler_Create_Call) RunAndReturn(run func(*fiber.Ctx) error) *UploadHandler_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx
+func (_m *UploadHandler) Delete(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UploadHandler_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type UploadHandler_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *UploadHandler_Expecter) Delete(ctx interface{}) *UploadHandler_Delete_Call {
+	return &UploadHandler_Delete_Call{Call: _e.mock.On("Delete", ctx)}
+}
+
+func (_c *UploadHandler_Delete_Call) Run(run func(ctx *fiber.Ctx)) *UploadHandler_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *UploadHandler_Delete_Call) Return(_a0 error) *UploadHandler_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UploadHandler_Delete_Call) RunAndReturn(run func(*fiber.Ctx) error) *UploadHandler_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Serve provides a mock function with given fields: ctx
+func (_m *UploadHandler) Serve(ctx *fiber.Ctx) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Serve")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UploadHandler_Serve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Serve'
+type UploadHandler_Serve_Call struct {
+	*mock.Call
+}
+
+// Serve is a helper method to define mock.On call
+//   - ctx *fiber.Ctx
+func (_e *UploadHandler_Expecter) Serve(ctx interface{}) *UploadHandler_Serve_Call {
+	return &UploadHandler_Serve_Call{Call: _e.mock.On("Serve", ctx)}
+}
+
+func (_c *UploadHandler_Serve_Call) Run(run func(ctx *fiber.Ctx)) *UploadHandler_Serve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *UploadHandler_Serve_Call) Return(_a0 error) *UploadHandler_Serve_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UploadHandler_Serve_Call) RunAndReturn(run func(*fiber.Ctx) error) *UploadHandler_Serve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUploadHandler creates a new instance of UploadHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUploadHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UploadHandler {
+	mock := &UploadHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,473 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/shironxn/blanknotes/internal/core/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UploadRepository is an autogenerated mock type for the UploadRepository type
+type UploadRepository struct {
+	mock.Mock
+}
+
+type UploadRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UploadRepository) EXPECT() *UploadRepository_Expecter {
+	return &UploadRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, upload
+func (_m *UploadRepository) Create(ctx context.Context, upload *domain.Upload) error {
+	ret := _m.Called(ctx, upload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Upload) error); ok {
+		r0 = rf(ctx, upload)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UploadRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type UploadRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - upload *domain.Upload
+func (_e *UploadRepository_Expecter) Create(ctx interface{}, upload interface{}) *UploadRepository_Create_Call {
+	return &UploadRepository_Create_Call{Call: _e.mock.On("Create", ctx, upload)}
+}
+
+func (_c *UploadRepository_Create_Call) Run(run func(ctx context.Context, upload *domain.Upload)) *UploadRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Upload))
+	})
+	return _c
+}
+
+func (_c *UploadRepository_Create_Call) Return(_a0 error) *UploadRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UploadRepository_Create_Call) RunAndReturn(run func(context.Context, *domain.Upload) error) *UploadRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, upload
+func (_m *UploadRepository) Delete(ctx context.Context, upload *domain.Upload) error {
+	ret := _m.Called(ctx, upload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Upload) error); ok {
+		r0 = rf(ctx, upload)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UploadRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type UploadRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - upload *domain.Upload
+func (_e *UploadRepository_Expecter) Delete(ctx interface{}, upload interface{}) *UploadRepository_Delete_Call {
+	return &UploadRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, upload)}
+}
+
+func (_c *UploadRepository_Delete_Call) Run(run func(ctx context.Context, upload *domain.Upload)) *UploadRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Upload))
+	})
+	return _c
+}
+
+func (_c *UploadRepository_Delete_Call) Return(_a0 error) *UploadRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UploadRepository_Delete_Call) RunAndReturn(run func(context.Context, *domain.Upload) error) *UploadRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *UploadRepository) GetByID(ctx context.Context, id uint) (*domain.Upload, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.Upload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*domain.Upload, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *domain.Upload); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Upload)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UploadRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type UploadRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *UploadRepository_Expecter) GetByID(ctx interface{}, id interface{}) *UploadRepository_GetByID_Call {
+	return &UploadRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *UploadRepository_GetByID_Call) Run(run func(ctx context.Context, id uint)) *UploadRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *UploadRepository_GetByID_Call) Return(_a0 *domain.Upload, _a1 error) *UploadRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UploadRepository_GetByID_Call) RunAndReturn(run func(context.Context, uint) (*domain.Upload, error)) *UploadRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByKey provides a mock function with given fields: ctx, key
+func (_m *UploadRepository) GetByKey(ctx context.Context, key string) (*domain.Upload, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByKey")
+	}
+
+	var r0 *domain.Upload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.Upload, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Upload); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Upload)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UploadRepository_GetByKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByKey'
+type UploadRepository_GetByKey_Call struct {
+	*mock.Call
+}
+
+// GetByKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *UploadRepository_Expecter) GetByKey(ctx interface{}, key interface{}) *UploadRepository_GetByKey_Call {
+	return &UploadRepository_GetByKey_Call{Call: _e.mock.On("GetByKey", ctx, key)}
+}
+
+func (_c *UploadRepository_GetByKey_Call) Run(run func(ctx context.Context, key string)) *UploadRepository_GetByKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UploadRepository_GetByKey_Call) Return(_a0 *domain.Upload, _a1 error) *UploadRepository_GetByKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UploadRepository_GetByKey_Call) RunAndReturn(run func(context.Context, string) (*domain.Upload, error)) *UploadRepository_GetByKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByKeys provides a mock function with given fields: ctx, keys
+func (_m *UploadRepository) GetByKeys(ctx context.Context, keys []string) ([]domain.Upload, error) {
+	ret := _m.Called(ctx, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByKeys")
+	}
+
+	var r0 []domain.Upload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]domain.Upload, error)); ok {
+		return rf(ctx, keys)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []domain.Upload); ok {
+		r0 = rf(ctx, keys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Upload)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, keys)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UploadRepository_GetByKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByKeys'
+type UploadRepository_GetByKeys_Call struct {
+	*mock.Call
+}
+
+// GetByKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keys []string
+func (_e *UploadRepository_Expecter) GetByKeys(ctx interface{}, keys interface{}) *UploadRepository_GetByKeys_Call {
+	return &UploadRepository_GetByKeys_Call{Call: _e.mock.On("GetByKeys", ctx, keys)}
+}
+
+func (_c *UploadRepository_GetByKeys_Call) Run(run func(ctx context.Context, keys []string)) *UploadRepository_GetByKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *UploadRepository_GetByKeys_Call) Return(_a0 []domain.Upload, _a1 error) *UploadRepository_GetByKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UploadRepository_GetByKeys_Call) RunAndReturn(run func(context.Context, []string) ([]domain.Upload, error)) *UploadRepository_GetByKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPending provides a mock function with given fields: ctx, limit
+func (_m *UploadRepository) GetPending(ctx context.Context, limit int) ([]domain.Upload, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPending")
+	}
+
+	var r0 []domain.Upload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]domain.Upload, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.Upload); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Upload)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UploadRepository_GetPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPending'
+type UploadRepository_GetPending_Call struct {
+	*mock.Call
+}
+
+// GetPending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *UploadRepository_Expecter) GetPending(ctx interface{}, limit interface{}) *UploadRepository_GetPending_Call {
+	return &UploadRepository_GetPending_Call{Call: _e.mock.On("GetPending", ctx, limit)}
+}
+
+func (_c *UploadRepository_GetPending_Call) Run(run func(ctx context.Context, limit int)) *UploadRepository_GetPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *UploadRepository_GetPending_Call) Return(_a0 []domain.Upload, _a1 error) *UploadRepository_GetPending_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UploadRepository_GetPending_Call) RunAndReturn(run func(context.Context, int) ([]domain.Upload, error)) *UploadRepository_GetPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVariantByKey provides a mock function with given fields: ctx, key
+func (_m *UploadRepository) GetVariantByKey(ctx context.Context, key string) (*domain.UploadVariant, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVariantByKey")
+	}
+
+	var r0 *domain.UploadVariant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.UploadVariant, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.UploadVariant); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UploadVariant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UploadRepository_GetVariantByKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVariantByKey'
+type UploadRepository_GetVariantByKey_Call struct {
+	*mock.Call
+}
+
+// GetVariantByKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *UploadRepository_Expecter) GetVariantByKey(ctx interface{}, key interface{}) *UploadRepository_GetVariantByKey_Call {
+	return &UploadRepository_GetVariantByKey_Call{Call: _e.mock.On("GetVariantByKey", ctx, key)}
+}
+
+func (_c *UploadRepository_GetVariantByKey_Call) Run(run func(ctx context.Context, key string)) *UploadRepository_GetVariantByKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UploadRepository_GetVariantByKey_Call) Return(_a0 *domain.UploadVariant, _a1 error) *UploadRepository_GetVariantByKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UploadRepository_GetVariantByKey_Call) RunAndReturn(run func(context.Context, string) (*domain.UploadVariant, error)) *UploadRepository_GetVariantByKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, upload
+func (_m *UploadRepository) Update(ctx context.Context, upload *domain.Upload) error {
+	ret := _m.Called(ctx, upload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Upload) error); ok {
+		r0 = rf(ctx, upload)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UploadRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type UploadRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - upload *domain.Upload
+func (_e *UploadRepository_Expecter) Update(ctx interface{}, upload interface{}) *UploadRepository_Update_Call {
+	return &UploadRepository_Update_Call{Call: _e.mock.On("Update", ctx, upload)}
+}
+
+func (_c *UploadRepository_Update_Call) Run(run func(ctx context.Context, upload *domain.Upload)) *UploadRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Upload))
+	})
+	return _c
+}
+
+func (_c *UploadRepository_Update_Call) Return(_a0 error) *UploadRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UploadRepository_Update_Call) RunAndReturn(run func(context.Context, *domain.Upload) error) *UploadRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUploadRepository creates a new instance of UploadRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUploadRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UploadRepository {
+	mock := &UploadRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,414 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	io "io"
+
+	domain "github.com/shironxn/blanknotes/internal/core/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UploadService is an autogenerated mock type for the UploadService type
+type UploadService struct {
+	mock.Mock
+}
+
+type UploadService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UploadService) EXPECT() *UploadService_Expecter {
+	return &UploadService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, file, size, claims
+func (_m *UploadService) Create(ctx context.Context, file io.Reader, size int64, claims domain.Claims) (*domain.Upload, error) {
+	ret := _m.Called(ctx, file, size, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.Upload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader, int64, domain.Claims) (*domain.Upload, error)); ok {
+		return rf(ctx, file, size, claims)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader, int64, domain.Claims) *domain.Upload); ok {
+		r0 = rf(ctx, file, size, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Upload)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, io.Reader, int64, domain.Claims) error); ok {
+		r1 = rf(ctx, file, size, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UploadService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type UploadService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - file io.Reader
+//   - size int64
+//   - claims domain.Claims
+func (_e *UploadService_Expecter) Create(ctx interface{}, file interface{}, size interface{}, claims interface{}) *UploadService_Create_Call {
+	return &UploadService_Create_Call{Call: _e.mock.On("Create", ctx, file, size, claims)}
+}
+
+func (_c *UploadService_Create_Call) Run(run func(ctx context.Context, file io.Reader, size int64, claims domain.Claims)) *UploadService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(io.Reader), args[2].(int64), args[3].(domain.Claims))
+	})
+	return _c
+}
+
+func (_c *UploadService_Create_Call) Return(_a0 *domain.Upload, _a1 error) *UploadService_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UploadService_Create_Call) RunAndReturn(run func(context.Context, io.Reader, int64, domain.Claims) (*domain.Upload, error)) *UploadService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id, claims
+func (_m *UploadService) Delete(ctx context.Context, id uint, claims domain.Claims) error {
+	ret := _m.Called(ctx, id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, domain.Claims) error); ok {
+		r0 = rf(ctx, id, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UploadService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type UploadService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - claims domain.Claims
+func (_e *UploadService_Expecter) Delete(ctx interface{}, id interface{}, claims interface{}) *UploadService_Delete_Call {
+	return &UploadService_Delete_Call{Call: _e.mock.On("Delete", ctx, id, claims)}
+}
+
+func (_c *UploadService_Delete_Call) Run(run func(ctx context.Context, id uint, claims domain.Claims)) *UploadService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(domain.Claims))
+	})
+	return _c
+}
+
+func (_c *UploadService_Delete_Call) Return(_a0 error) *UploadService_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UploadService_Delete_Call) RunAndReturn(run func(context.Context, uint, domain.Claims) error) *UploadService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Open provides a mock function with given fields: ctx, key
+func (_m *UploadService) Open(ctx context.Context, key string) (*domain.Upload, io.ReadCloser, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Open")
+	}
+
+	var r0 *domain.Upload
+	var r1 io.ReadCloser
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.Upload, io.ReadCloser, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Upload); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Upload)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) io.ReadCloser); ok {
+		r1 = rf(ctx, key)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, key)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// UploadService_Open_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Open'
+type UploadService_Open_Call struct {
+	*mock.Call
+}
+
+// Open is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *UploadService_Expecter) Open(ctx interface{}, key interface{}) *UploadService_Open_Call {
+	return &UploadService_Open_Call{Call: _e.mock.On("Open", ctx, key)}
+}
+
+func (_c *UploadService_Open_Call) Run(run func(ctx context.Context, key string)) *UploadService_Open_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UploadService_Open_Call) Return(_a0 *domain.Upload, _a1 io.ReadCloser, _a2 error) *UploadService_Open_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *UploadService_Open_Call) RunAndReturn(run func(context.Context, string) (*domain.Upload, io.ReadCloser, error)) *UploadService_Open_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Pending provides a mock function with given fields: ctx
+func (_m *UploadService) Pending(ctx context.Context) ([]uint, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pending")
+	}
+
+	var r0 []uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]uint, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []uint); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UploadService_Pending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pending'
+type UploadService_Pending_Call struct {
+	*mock.Call
+}
+
+// Pending is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UploadService_Expecter) Pending(ctx interface{}) *UploadService_Pending_Call {
+	return &UploadService_Pending_Call{Call: _e.mock.On("Pending", ctx)}
+}
+
+func (_c *UploadService_Pending_Call) Run(run func(ctx context.Context)) *UploadService_Pending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UploadService_Pending_Call) Return(_a0 []uint, _a1 error) *UploadService_Pending_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UploadService_Pending_Call) RunAndReturn(run func(context.Context) ([]uint, error)) *UploadService_Pending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Process provides a mock function with given fields: ctx, id
+func (_m *UploadService) Process(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Process")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UploadService_Process_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Process'
+type UploadService_Process_Call struct {
+	*mock.Call
+}
+
+// Process is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *UploadService_Expecter) Process(ctx interface{}, id interface{}) *UploadService_Process_Call {
+	return &UploadService_Process_Call{Call: _e.mock.On("Process", ctx, id)}
+}
+
+func (_c *UploadService_Process_Call) Run(run func(ctx context.Context, id uint)) *UploadService_Process_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *UploadService_Process_Call) Return(_a0 error) *UploadService_Process_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UploadService_Process_Call) RunAndReturn(run func(context.Context, uint) error) *UploadService_Process_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Queue provides a mock function with given fields:
+func (_m *UploadService) Queue() <-chan uint {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Queue")
+	}
+
+	var r0 <-chan uint
+	if rf, ok := ret.Get(0).(func() <-chan uint); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan uint)
+		}
+	}
+
+	return r0
+}
+
+// UploadService_Queue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Queue'
+type UploadService_Queue_Call struct {
+	*mock.Call
+}
+
+// Queue is a helper method to define mock.On call
+func (_e *UploadService_Expecter) Queue() *UploadService_Queue_Call {
+	return &UploadService_Queue_Call{Call: _e.mock.On("Queue")}
+}
+
+func (_c *UploadService_Queue_Call) Run(run func()) *UploadService_Queue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UploadService_Queue_Call) Return(_a0 <-chan uint) *UploadService_Queue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UploadService_Queue_Call) RunAndReturn(run func() <-chan uint) *UploadService_Queue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// URL provides a mock function with given fields: upload
+func (_m *UploadService) URL(upload *domain.Upload) string {
+	ret := _m.Called(upload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for URL")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*domain.Upload) string); ok {
+		r0 = rf(upload)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// UploadService_URL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'URL'
+type UploadService_URL_Call struct {
+	*mock.Call
+}
+
+// URL is a helper method to define mock.On call
+//   - upload *domain.Upload
+func (_e *UploadService_Expecter) URL(upload interface{}) *UploadService_URL_Call {
+	return &UploadService_URL_Call{Call: _e.mock.On("URL", upload)}
+}
+
+func (_c *UploadService_URL_Call) Run(run func(upload *domain.Upload)) *UploadService_URL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*domain.Upload))
+	})
+	return _c
+}
+
+func (_c *UploadService_URL_Call) Return(_a0 string) *UploadService_URL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UploadService_URL_Call) RunAndReturn(run func(*domain.Upload) string) *UploadService_URL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUploadService creates a new instance of UploadService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUploadService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UploadService {
+	mock := &UploadService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
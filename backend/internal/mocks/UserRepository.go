@@ -3,8 +3,12 @@
 package mocks
 
 import (
+	context "context"
+
 	domain "github.com/shironxn/blanknotes/internal/core/domain"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // UserRepository is an autogenerated mock type for the UserRepository type
@@ -20,17 +24,17 @@ func (_m *UserRepository) EXPECT() *UserRepository_Expecter {
 	return &UserRepository_Expecter{mock: &_m.Mock}
 }
 
-// Delete provides a mock function with given fields: user
-func (_m *UserRepository) Delete(user *domain.User) error {
-	ret := _m.Called(user)
+// Delete provides a mock function with given fields: ctx, user
+func (_m *UserRepository) Delete(ctx context.Context, user *domain.User) error {
+	ret := _m.Called(ctx, user)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*domain.User) error); ok {
-		r0 = rf(user)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.User) error); ok {
+		r0 = rf(ctx, user)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -44,14 +48,15 @@ type UserRepository_Delete_Call struct {
 }
 
 // Delete is a helper method to define mock.On call
+//   - ctx context.Context
 //   - user *domain.User
-func (_e *UserRepository_Expecter) Delete(user interface{}) *UserRepository_Delete_Call {
-	return &UserRepository_Delete_Call{Call: _e.mock.On("Delete", user)}
+func (_e *UserRepository_Expecter) Delete(ctx interface{}, user interface{}) *UserRepository_Delete_Call {
+	return &UserRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, user)}
 }
 
-func (_c *UserRepository_Delete_Call) Run(run func(user *domain.User)) *UserRepository_Delete_Call {
+func (_c *UserRepository_Delete_Call) Run(run func(ctx context.Context, user *domain.User)) *UserRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*domain.User))
+		run(args[0].(context.Context), args[1].(*domain.User))
 	})
 	return _c
 }
@@ -61,14 +66,14 @@ func (_c *UserRepository_Delete_Call) Return(_a0 error) *UserRepository_Delete_C
 	return _c
 }
 
-func (_c *UserRepository_Delete_Call) RunAndReturn(run func(*domain.User) error) *UserRepository_Delete_Call {
+func (_c *UserRepository_Delete_Call) RunAndReturn(run func(context.Context, *domain.User) error) *UserRepository_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAll provides a mock function with given fields: req, metdata
-func (_m *UserRepository) GetAll(req domain.UserQuery, metdata *domain.Metadata) ([]domain.User, error) {
-	ret := _m.Called(req, metdata)
+// GetAll provides a mock function with given fields: ctx, req, metdata
+func (_m *UserRepository) GetAll(ctx context.Context, req domain.UserQuery, metdata *domain.Metadata) ([]domain.User, error) {
+	ret := _m.Called(ctx, req, metdata)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAll")
@@ -76,19 +81,19 @@ func (_m *UserRepository) GetAll(req domain.UserQuery, metdata *domain.Metadata)
 
 	var r0 []domain.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(domain.UserQuery, *domain.Metadata) ([]domain.User, error)); ok {
-		return rf(req, metdata)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserQuery, *domain.Metadata) ([]domain.User, error)); ok {
+		return rf(ctx, req, metdata)
 	}
-	if rf, ok := ret.Get(0).(func(domain.UserQuery, *domain.Metadata) []domain.User); ok {
-		r0 = rf(req, metdata)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserQuery, *domain.Metadata) []domain.User); ok {
+		r0 = rf(ctx, req, metdata)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(domain.UserQuery, *domain.Metadata) error); ok {
-		r1 = rf(req, metdata)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.UserQuery, *domain.Metadata) error); ok {
+		r1 = rf(ctx, req, metdata)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -102,15 +107,16 @@ type UserRepository_GetAll_Call struct {
 }
 
 // GetAll is a helper method to define mock.On call
+//   - ctx context.Context
 //   - req domain.UserQuery
 //   - metdata *domain.Metadata
-func (_e *UserRepository_Expecter) GetAll(req interface{}, metdata interface{}) *UserRepository_GetAll_Call {
-	return &UserRepository_GetAll_Call{Call: _e.mock.On("GetAll", req, metdata)}
+func (_e *UserRepository_Expecter) GetAll(ctx interface{}, req interface{}, metdata interface{}) *UserRepository_GetAll_Call {
+	return &UserRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx, req, metdata)}
 }
 
-func (_c *UserRepository_GetAll_Call) Run(run func(req domain.UserQuery, metdata *domain.Metadata)) *UserRepository_GetAll_Call {
+func (_c *UserRepository_GetAll_Call) Run(run func(ctx context.Context, req domain.UserQuery, metdata *domain.Metadata)) *UserRepository_GetAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(domain.UserQuery), args[1].(*domain.Metadata))
+		run(args[0].(context.Context), args[1].(domain.UserQuery), args[2].(*domain.Metadata))
 	})
 	return _c
 }
@@ -120,14 +126,14 @@ func (_c *UserRepository_GetAll_Call) Return(_a0 []domain.User, _a1 error) *User
 	return _c
 }
 
-func (_c *UserRepository_GetAll_Call) RunAndReturn(run func(domain.UserQuery, *domain.Metadata) ([]domain.User, error)) *UserRepository_GetAll_Call {
+func (_c *UserRepository_GetAll_Call) RunAndReturn(run func(context.Context, domain.UserQuery, *domain.Metadata) ([]domain.User, error)) *UserRepository_GetAll_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function with given fields: id
-func (_m *UserRepository) GetByID(id uint) (*domain.User, error) {
-	ret := _m.Called(id)
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *UserRepository) GetByID(ctx context.Context, id uint) (*domain.User, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -135,19 +141,19 @@ func (_m *UserRepository) GetByID(id uint) (*domain.User, error) {
 
 	var r0 *domain.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uint) (*domain.User, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*domain.User, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(uint) *domain.User); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *domain.User); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uint) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -161,14 +167,15 @@ type UserRepository_GetByID_Call struct {
 }
 
 // GetByID is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id uint
-func (_e *UserRepository_Expecter) GetByID(id interface{}) *UserRepository_GetByID_Call {
-	return &UserRepository_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+func (_e *UserRepository_Expecter) GetByID(ctx interface{}, id interface{}) *UserRepository_GetByID_Call {
+	return &UserRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
 }
 
-func (_c *UserRepository_GetByID_Call) Run(run func(id uint)) *UserRepository_GetByID_Call {
+func (_c *UserRepository_GetByID_Call) Run(run func(ctx context.Context, id uint)) *UserRepository_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint))
+		run(args[0].(context.Context), args[1].(uint))
 	})
 	return _c
 }
@@ -178,14 +185,80 @@ func (_c *UserRepository_GetByID_Call) Return(_a0 *domain.User, _a1 error) *User
 	return _c
 }
 
-func (_c *UserRepository_GetByID_Call) RunAndReturn(run func(uint) (*domain.User, error)) *UserRepository_GetByID_Call {
+func (_c *UserRepository_GetByID_Call) RunAndReturn(run func(context.Context, uint) (*domain.User, error)) *UserRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function with given fields: ctx, before
+func (_m *UserRepository) Purge(ctx context.Context, before time.Time) (int64, []string, error) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 int64
+	var r1 []string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, []string, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) []string); ok {
+		r1 = rf(ctx, before)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, time.Time) error); ok {
+		r2 = rf(ctx, before)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// UserRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type UserRepository_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *UserRepository_Expecter) Purge(ctx interface{}, before interface{}) *UserRepository_Purge_Call {
+	return &UserRepository_Purge_Call{Call: _e.mock.On("Purge", ctx, before)}
+}
+
+func (_c *UserRepository_Purge_Call) Run(run func(ctx context.Context, before time.Time)) *UserRepository_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *UserRepository_Purge_Call) Return(_a0 int64, _a1 []string, _a2 error) *UserRepository_Purge_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *UserRepository_Purge_Call) RunAndReturn(run func(context.Context, time.Time) (int64, []string, error)) *UserRepository_Purge_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function with given fields: req, user
-func (_m *UserRepository) Update(req domain.UserRequest, user *domain.User) (*domain.User, error) {
-	ret := _m.Called(req, user)
+// Update provides a mock function with given fields: ctx, req, user
+func (_m *UserRepository) Update(ctx context.Context, req domain.UserRequest, user *domain.User) (*domain.User, error) {
+	ret := _m.Called(ctx, req, user)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -193,19 +266,19 @@ func (_m *UserRepository) Update(req domain.UserRequest, user *domain.User) (*do
 
 	var r0 *domain.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(domain.UserRequest, *domain.User) (*domain.User, error)); ok {
-		return rf(req, user)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserRequest, *domain.User) (*domain.User, error)); ok {
+		return rf(ctx, req, user)
 	}
-	if rf, ok := ret.Get(0).(func(domain.UserRequest, *domain.User) *domain.User); ok {
-		r0 = rf(req, user)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserRequest, *domain.User) *domain.User); ok {
+		r0 = rf(ctx, req, user)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(domain.UserRequest, *domain.User) error); ok {
-		r1 = rf(req, user)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.UserRequest, *domain.User) error); ok {
+		r1 = rf(ctx, req, user)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -219,15 +292,16 @@ type UserRepository_Update_Call struct {
 }
 
 // Update is a helper method to define mock.On call
+//   - ctx context.Context
 //   - req domain.UserRequest
 //   - user *domain.User
-func (_e *UserRepository_Expecter) Update(req interface{}, user interface{}) *UserRepository_Update_Call {
-	return &UserRepository_Update_Call{Call: _e.mock.On("Update", req, user)}
+func (_e *UserRepository_Expecter) Update(ctx interface{}, req interface{}, user interface{}) *UserRepository_Update_Call {
+	return &UserRepository_Update_Call{Call: _e.mock.On("Update", ctx, req, user)}
 }
 
-func (_c *UserRepository_Update_Call) Run(run func(req domain.UserRequest, user *domain.User)) *UserRepository_Update_Call {
+func (_c *UserRepository_Update_Call) Run(run func(ctx context.Context, req domain.UserRequest, user *domain.User)) *UserRepository_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(domain.UserRequest), args[1].(*domain.User))
+		run(args[0].(context.Context), args[1].(domain.UserRequest), args[2].(*domain.User))
 	})
 	return _c
 }
@@ -237,7 +311,7 @@ func (_c *UserRepository_Update_Call) Return(_a0 *domain.User, _a1 error) *UserR
 	return _c
 }
 
-func (_c *UserRepository_Update_Call) RunAndReturn(run func(domain.UserRequest, *domain.User) (*domain.User, error)) *UserRepository_Update_Call {
+func (_c *UserRepository_Update_Call) RunAndReturn(run func(context.Context, domain.UserRequest, *domain.User) (*domain.User, error)) *UserRepository_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3,8 +3,12 @@
 package mocks
 
 import (
+	context "context"
+
 	domain "github.com/shironxn/blanknotes/internal/core/domain"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // UserService is an autogenerated mock type for the UserService type
@@ -20,17 +24,17 @@ func (_m *UserService) EXPECT() *UserService_Expecter {
 	return &UserService_Expecter{mock: &_m.Mock}
 }
 
-// Delete provides a mock function with given fields: req, claims
-func (_m *UserService) Delete(req domain.UserRequest, claims domain.Claims) error {
-	ret := _m.Called(req, claims)
+// Delete provides a mock function with given fields: ctx, req, claims
+func (_m *UserService) Delete(ctx context.Context, req domain.UserRequest, claims domain.Claims) error {
+	ret := _m.Called(ctx, req, claims)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(domain.UserRequest, domain.Claims) error); ok {
-		r0 = rf(req, claims)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserRequest, domain.Claims) error); ok {
+		r0 = rf(ctx, req, claims)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -44,15 +48,16 @@ type UserService_Delete_Call struct {
 }
 
 // Delete is a helper method to define mock.On call
+//   - ctx context.Context
 //   - req domain.UserRequest
 //   - claims domain.Claims
-func (_e *UserService_Expecter) Delete(req interface{}, claims interface{}) *UserService_Delete_Call {
-	return &UserService_Delete_Call{Call: _e.mock.On("Delete", req, claims)}
+func (_e *UserService_Expecter) Delete(ctx interface{}, req interface{}, claims interface{}) *UserService_Delete_Call {
+	return &UserService_Delete_Call{Call: _e.mock.On("Delete", ctx, req, claims)}
 }
 
-func (_c *UserService_Delete_Call) Run(run func(req domain.UserRequest, claims domain.Claims)) *UserService_Delete_Call {
+func (_c *UserService_Delete_Call) Run(run func(ctx context.Context, req domain.UserRequest, claims domain.Claims)) *UserService_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(domain.UserRequest), args[1].(domain.Claims))
+		run(args[0].(context.Context), args[1].(domain.UserRequest), args[2].(domain.Claims))
 	})
 	return _c
 }
@@ -62,14 +67,14 @@ func (_c *UserService_Delete_Call) Return(_a0 error) *UserService_Delete_Call {
 	return _c
 }
 
-func (_c *UserService_Delete_Call) RunAndReturn(run func(domain.UserRequest, domain.Claims) error) *UserService_Delete_Call {
+func (_c *UserService_Delete_Call) RunAndReturn(run func(context.Context, domain.UserRequest, domain.Claims) error) *UserService_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAll provides a mock function with given fields: req, metdata
-func (_m *UserService) GetAll(req domain.UserQuery, metdata *domain.Metadata) ([]domain.User, error) {
-	ret := _m.Called(req, metdata)
+// GetAll provides a mock function with given fields: ctx, req, metdata
+func (_m *UserService) GetAll(ctx context.Context, req domain.UserQuery, metdata *domain.Metadata) ([]domain.User, error) {
+	ret := _m.Called(ctx, req, metdata)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAll")
@@ -77,19 +82,19 @@ func (_m *UserService) GetAll(req domain.UserQuery, metdata *domain.Metadata) ([
 
 	var r0 []domain.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(domain.UserQuery, *domain.Metadata) ([]domain.User, error)); ok {
-		return rf(req, metdata)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserQuery, *domain.Metadata) ([]domain.User, error)); ok {
+		return rf(ctx, req, metdata)
 	}
-	if rf, ok := ret.Get(0).(func(domain.UserQuery, *domain.Metadata) []domain.User); ok {
-		r0 = rf(req, metdata)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserQuery, *domain.Metadata) []domain.User); ok {
+		r0 = rf(ctx, req, metdata)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(domain.UserQuery, *domain.Metadata) error); ok {
-		r1 = rf(req, metdata)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.UserQuery, *domain.Metadata) error); ok {
+		r1 = rf(ctx, req, metdata)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -103,15 +108,16 @@ type UserService_GetAll_Call struct {
 }
 
 // GetAll is a helper method to define mock.On call
+//   - ctx context.Context
 //   - req domain.UserQuery
 //   - metdata *domain.Metadata
-func (_e *UserService_Expecter) GetAll(req interface{}, metdata interface{}) *UserService_GetAll_Call {
-	return &UserService_GetAll_Call{Call: _e.mock.On("GetAll", req, metdata)}
+func (_e *UserService_Expecter) GetAll(ctx interface{}, req interface{}, metdata interface{}) *UserService_GetAll_Call {
+	return &UserService_GetAll_Call{Call: _e.mock.On("GetAll", ctx, req, metdata)}
 }
 
-func (_c *UserService_GetAll_Call) Run(run func(req domain.UserQuery, metdata *domain.Metadata)) *UserService_GetAll_Call {
+func (_c *UserService_GetAll_Call) Run(run func(ctx context.Context, req domain.UserQuery, metdata *domain.Metadata)) *UserService_GetAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(domain.UserQuery), args[1].(*domain.Metadata))
+		run(args[0].(context.Context), args[1].(domain.UserQuery), args[2].(*domain.Metadata))
 	})
 	return _c
 }
@@ -121,14 +127,14 @@ func (_c *UserService_GetAll_Call) Return(_a0 []domain.User, _a1 error) *UserSer
 	return _c
 }
 
-func (_c *UserService_GetAll_Call) RunAndReturn(run func(domain.UserQuery, *domain.Metadata) ([]domain.User, error)) *UserService_GetAll_Call {
+func (_c *UserService_GetAll_Call) RunAndReturn(run func(context.Context, domain.UserQuery, *domain.Metadata) ([]domain.User, error)) *UserService_GetAll_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function with given fields: id
-func (_m *UserService) GetByID(id uint) (*domain.User, error) {
-	ret := _m.Called(id)
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *UserService) GetByID(ctx context.Context, id uint) (*domain.User, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -136,19 +142,19 @@ func (_m *UserService) GetByID(id uint) (*domain.User, error) {
 
 	var r0 *domain.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uint) (*domain.User, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*domain.User, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(uint) *domain.User); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *domain.User); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uint) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -162,14 +168,15 @@ type UserService_GetByID_Call struct {
 }
 
 // GetByID is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id uint
-func (_e *UserService_Expecter) GetByID(id interface{}) *UserService_GetByID_Call {
-	return &UserService_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+func (_e *UserService_Expecter) GetByID(ctx interface{}, id interface{}) *UserService_GetByID_Call {
+	return &UserService_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
 }
 
-func (_c *UserService_GetByID_Call) Run(run func(id uint)) *UserService_GetByID_Call {
+func (_c *UserService_GetByID_Call) Run(run func(ctx context.Context, id uint)) *UserService_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint))
+		run(args[0].(context.Context), args[1].(uint))
 	})
 	return _c
 }
@@ -179,14 +186,71 @@ func (_c *UserService_GetByID_Call) Return(_a0 *domain.User, _a1 error) *UserSer
 	return _c
 }
 
-func (_c *UserService_GetByID_Call) RunAndReturn(run func(uint) (*domain.User, error)) *UserService_GetByID_Call {
+func (_c *UserService_GetByID_Call) RunAndReturn(run func(context.Context, uint) (*domain.User, error)) *UserService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function with given fields: ctx, gracePeriod
+func (_m *UserService) Purge(ctx context.Context, gracePeriod time.Duration) (int64, error) {
+	ret := _m.Called(ctx, gracePeriod)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) (int64, error)); ok {
+		return rf(ctx, gracePeriod)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) int64); ok {
+		r0 = rf(ctx, gracePeriod)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = rf(ctx, gracePeriod)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type UserService_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gracePeriod time.Duration
+func (_e *UserService_Expecter) Purge(ctx interface{}, gracePeriod interface{}) *UserService_Purge_Call {
+	return &UserService_Purge_Call{Call: _e.mock.On("Purge", ctx, gracePeriod)}
+}
+
+func (_c *UserService_Purge_Call) Run(run func(ctx context.Context, gracePeriod time.Duration)) *UserService_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *UserService_Purge_Call) Return(_a0 int64, _a1 error) *UserService_Purge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_Purge_Call) RunAndReturn(run func(context.Context, time.Duration) (int64, error)) *UserService_Purge_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function with given fields: req, claims
-func (_m *UserService) Update(req domain.UserRequest, claims domain.Claims) (*domain.User, error) {
-	ret := _m.Called(req, claims)
+// Update provides a mock function with given fields: ctx, req, claims
+func (_m *UserService) Update(ctx context.Context, req domain.UserRequest, claims domain.Claims) (*domain.User, error) {
+	ret := _m.Called(ctx, req, claims)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -194,19 +258,19 @@ func (_m *UserService) Update(req domain.UserRequest, claims domain.Claims) (*do
 
 	var r0 *domain.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(domain.UserRequest, domain.Claims) (*domain.User, error)); ok {
-		return rf(req, claims)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserRequest, domain.Claims) (*domain.User, error)); ok {
+		return rf(ctx, req, claims)
 	}
-	if rf, ok := ret.Get(0).(func(domain.UserRequest, domain.Claims) *domain.User); ok {
-		r0 = rf(req, claims)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserRequest, domain.Claims) *domain.User); ok {
+		r0 = rf(ctx, req, claims)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(domain.UserRequest, domain.Claims) error); ok {
-		r1 = rf(req, claims)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.UserRequest, domain.Claims) error); ok {
+		r1 = rf(ctx, req, claims)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -220,15 +284,16 @@ type UserService_Update_Call struct {
 }
 
 // Update is a helper method to define mock.On call
+//   - ctx context.Context
 //   - req domain.UserRequest
 //   - claims domain.Claims
-func (_e *UserService_Expecter) Update(req interface{}, claims interface{}) *UserService_Update_Call {
-	return &UserService_Update_Call{Call: _e.mock.On("Update", req, claims)}
+func (_e *UserService_Expecter) Update(ctx interface{}, req interface{}, claims interface{}) *UserService_Update_Call {
+	return &UserService_Update_Call{Call: _e.mock.On("Update", ctx, req, claims)}
 }
 
-func (_c *UserService_Update_Call) Run(run func(req domain.UserRequest, claims domain.Claims)) *UserService_Update_Call {
+func (_c *UserService_Update_Call) Run(run func(ctx context.Context, req domain.UserRequest, claims domain.Claims)) *UserService_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(domain.UserRequest), args[1].(domain.Claims))
+		run(args[0].(context.Context), args[1].(domain.UserRequest), args[2].(domain.Claims))
 	})
 	return _c
 }
@@ -238,7 +303,7 @@ func (_c *UserService_Update_Call) Return(_a0 *domain.User, _a1 error) *UserServ
 	return _c
 }
 
-func (_c *UserService_Update_Call) RunAndReturn(run func(domain.UserRequest, domain.Claims) (*domain.User, error)) *UserService_Update_Call {
+func (_c *UserService_Update_Call) RunAndReturn(run func(context.Context, domain.UserRequest, domain.Claims) (*domain.User, error)) *UserService_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
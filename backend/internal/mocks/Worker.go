@@ -0,0 +1,96 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// Worker is an autogenerated mock type for the Worker type
+type Worker struct {
+	mock.Mock
+}
+
+type Worker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Worker) EXPECT() *Worker_Expecter {
+	return &Worker_Expecter{mock: &_m.Mock}
+}
+
+// Start provides a mock function with given fields:
+func (_m *Worker) Start() {
+	_m.Called()
+}
+
+// Worker_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type Worker_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *Worker_Expecter) Start() *Worker_Start_Call {
+	return &Worker_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *Worker_Start_Call) Run(run func()) *Worker_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Worker_Start_Call) Return() *Worker_Start_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Worker_Start_Call) RunAndReturn(run func()) *Worker_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with given fields:
+func (_m *Worker) Stop() {
+	_m.Called()
+}
+
+// Worker_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type Worker_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *Worker_Expecter) Stop() *Worker_Stop_Call {
+	return &Worker_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *Worker_Stop_Call) Run(run func()) *Worker_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Worker_Stop_Call) Return() *Worker_Stop_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Worker_Stop_Call) RunAndReturn(run func()) *Worker_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWorker creates a new instance of Worker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWorker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Worker {
+	mock := &Worker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,67 @@
+package util
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+)
+
+// NoteArchive writes notes into a zip as Markdown files with front matter, the
+// format the Markdown importer reads back.
+type NoteArchive struct {
+	zip   *zip.Writer
+	names map[string]int
+}
+
+func NewNoteArchive(w io.Writer) *NoteArchive {
+	return &NoteArchive{
+		zip:   zip.NewWriter(w),
+		names: make(map[string]int),
+	}
+}
+
+// Add writes note to a file named after its title, numbered when the title
+// was already used.
+func (a *NoteArchive) Add(note domain.Note) error {
+	data, err := FormatFrontMatter(domain.NoteFrontMatter{
+		Title:       note.Title,
+		Description: note.Description,
+		CoverURL:    note.CoverURL,
+		Visibility:  string(note.Visibility),
+		Notebook:    note.Notebook,
+		Tags:        note.Tags,
+		CreatedAt:   note.CreatedAt,
+		UpdatedAt:   note.UpdatedAt,
+	}, note.Content)
+	if err != nil {
+		return err
+	}
+
+	name := Slugify(note.Title)
+	if a.names[name]++; a.names[name] > 1 {
+		name = fmt.Sprintf("%s-%d", name, a.names[name])
+	}
+
+	return a.AddFile(name+".md", note.UpdatedAt, data)
+}
+
+func (a *NoteArchive) AddFile(name string, modified time.Time, data []byte) error {
+	file, err := a.zip.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: modified,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(data)
+	return err
+}
+
+func (a *NoteArchive) Close() error {
+	return a.zip.Close()
+}
@@ -0,0 +1,49 @@
+package util
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoteArchive(t *testing.T) {
+	var buf bytes.Buffer
+	archive := NewNoteArchive(&buf)
+
+	note := domain.Note{Title: "Hello World", Content: "# hi", Visibility: domain.Public, Notebook: "Journal", Tags: []string{"daily"}}
+	note.UpdatedAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, archive.AddFile("user.json", note.UpdatedAt, []byte("{}")))
+	assert.NoError(t, archive.Add(note))
+	assert.NoError(t, archive.Add(note))
+	assert.NoError(t, archive.Close())
+
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.NoError(t, err)
+
+	var names []string
+	for _, file := range reader.File {
+		names = append(names, file.Name)
+	}
+	assert.Equal(t, []string{"user.json", "hello-world.md", "hello-world-2.md"}, names)
+
+	file, err := reader.File[1].Open()
+	assert.NoError(t, err)
+	data, err := io.ReadAll(file)
+	assert.NoError(t, err)
+
+	var front domain.NoteFrontMatter
+	content, err := ParseFrontMatter(data, &front)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello World", front.Title)
+	assert.Equal(t, "public", front.Visibility)
+	assert.Equal(t, "Journal", front.Notebook)
+	assert.Equal(t, []string{"daily"}, front.Tags)
+	assert.Equal(t, "# hi\n", content)
+}
@@ -0,0 +1,102 @@
+package util
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// ParseFieldset reads the comma separated fields and include parameters out of
+// the query string. Every field is selected when fields is missing and the
+// default relations are included when include is missing.
+func ParseFieldset(queries map[string]string, selection domain.Selection) (*domain.Fieldset, *domain.ErrorResponse) {
+	var fieldset domain.Fieldset
+	var errs []domain.ValidationError
+
+	fields, ok := queries["fields"]
+	if ok && strings.TrimSpace(fields) != "" {
+		for _, field := range splitList(fields) {
+			if _, ok := selection.Fields[field]; !ok {
+				errs = append(errs, domain.ValidationError{Field: "fields", Error: fmt.Sprintf("selecting %s is not supported", field)})
+				continue
+			}
+			fieldset.Fields = append(fieldset.Fields, field)
+		}
+	} else {
+		for field := range selection.Fields {
+			fieldset.Fields = append(fieldset.Fields, field)
+		}
+		sort.Strings(fieldset.Fields)
+	}
+
+	include, ok := queries["include"]
+	if !ok {
+		fieldset.Include = selection.Default
+	}
+	for _, relation := range splitList(include) {
+		if _, ok := selection.Include[relation]; !ok {
+			errs = append(errs, domain.ValidationError{Field: "include", Error: fmt.Sprintf("including %s is not supported", relation)})
+			continue
+		}
+		fieldset.Include = append(fieldset.Include, relation)
+	}
+
+	if len(errs) > 0 {
+		return nil, &domain.ErrorResponse{
+			Code:  fiber.StatusBadRequest,
+			Error: errs,
+		}
+	}
+
+	return &fieldset, nil
+}
+
+// Select limits the columns read to those needed for fieldset and preloads the
+// included relations. The id is always read.
+func Select(selection domain.Selection, fieldset domain.Fieldset) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		include := fieldset.Include
+		if len(fieldset.Fields) == 0 && len(include) == 0 {
+			include = selection.Default
+		}
+
+		columns := []string{"id"}
+		for _, field := range fieldset.Fields {
+			columns = append(columns, selection.Fields[field]...)
+		}
+
+		for _, name := range include {
+			relation, ok := selection.Include[name]
+			if !ok {
+				db.AddError(fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("including %s is not supported", name)))
+				return db
+			}
+			columns = append(columns, relation.Columns...)
+			db = db.Preload(relation.Preload)
+		}
+
+		if len(fieldset.Fields) == 0 {
+			return db
+		}
+
+		slices.Sort(columns)
+		return db.Select(slices.Compact(columns))
+	}
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		// a + in the query string is decoded as a space
+		if item = strings.TrimSpace(item); item != "" && !slices.Contains(items, item) {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,52 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFieldset(t *testing.T) {
+	fieldset, err := ParseFieldset(map[string]string{}, domain.NoteSelection)
+	assert.Nil(t, err)
+	assert.Equal(t, &domain.Fieldset{
+		Fields:  []string{"content", "cover", "created_at", "description", "id", "notebook", "tags", "title", "updated_at", "visibility"},
+		Include: []string{"author"},
+	}, fieldset)
+
+	fieldset, err = ParseFieldset(map[string]string{"fields": "id, title,id", "include": ""}, domain.NoteSelection)
+	assert.Nil(t, err)
+	assert.Equal(t, &domain.Fieldset{Fields: []string{"id", "title"}}, fieldset)
+
+	fieldset, err = ParseFieldset(map[string]string{"fields": "id,password", "include": "author,tags"}, domain.NoteSelection)
+	assert.Nil(t, fieldset)
+	assert.Equal(t, &domain.ErrorResponse{
+		Code: 400,
+		Error: []domain.ValidationError{
+			{Field: "fields", Error: "selecting password is not supported"},
+			{Field: "include", Error: "including tags is not supported"},
+		},
+	}, err)
+}
+
+func TestSelect(t *testing.T) {
+	p, db := newTestPagination(t)
+
+	fieldset := domain.Fieldset{Fields: []string{"title", "cover"}, Include: []string{"author"}}
+	metadata := domain.Metadata{Sort: "-updated_at", Limit: 10, SkipCount: true}
+
+	stmt := db.Model(&domain.Note{}).
+		Scopes(Select(domain.NoteSelection, fieldset), p.Paginate(&metadata, domain.NoteFields)).
+		Find(&[]domain.Note{}).
+		Statement
+	// the sort columns are read too, cursors are made of them
+	assert.Equal(t, `SELECT "cover_url","id","title","user_id","updated_at" FROM "notes" WHERE "notes"."deleted_at" IS NULL ORDER BY "notes"."updated_at" DESC,"notes"."id" DESC LIMIT $1`, stmt.SQL.String())
+	assert.Contains(t, stmt.Preloads, "Author")
+
+	// the zero value reads every column and the default relations
+	stmt = db.Model(&domain.Note{}).Scopes(Select(domain.NoteSelection, domain.Fieldset{})).Find(&[]domain.Note{}).Statement
+	assert.Equal(t, `SELECT * FROM "notes" WHERE "notes"."deleted_at" IS NULL`, stmt.SQL.String())
+	assert.Contains(t, stmt.Preloads, "Author")
+}
@@ -0,0 +1,249 @@
+package util
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	maxFilters    = 20
+	maxFilterIn   = 50
+	maxSortFields = 3
+)
+
+var filterKey = regexp.MustCompile(`^filter\[([a-z_]+)\](?:\[([a-z]+)\])?$`)
+
+// operators lists what each field type can be compared with, eq is the
+// default when a filter has no operator.
+var operators = map[domain.FieldType][]string{
+	domain.FieldString: {"eq", "ne", "contains", "in"},
+	domain.FieldEnum:   {"eq", "ne", "in"},
+	domain.FieldNumber: {"eq", "ne", "gt", "gte", "lt", "lte", "in"},
+	domain.FieldTime:   {"gt", "gte", "lt", "lte"},
+}
+
+// ParseFilters reads the filter[field][operator]=value parameters out of the
+// query string. Only fields of the allow-list can be filtered, and values are
+// converted to the type of the field.
+func ParseFilters(queries map[string]string, fields domain.Fields) ([]domain.Filter, *domain.ErrorResponse) {
+	var filters []domain.Filter
+	var errs []domain.ValidationError
+
+	keys := make([]string, 0, len(queries))
+	for key := range queries {
+		if strings.HasPrefix(key, "filter[") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	if len(keys) > maxFilters {
+		return nil, &domain.ErrorResponse{
+			Code: fiber.StatusBadRequest,
+			Error: []domain.ValidationError{{
+				Field: "filter",
+				Error: fmt.Sprintf("filter must not have more than %d conditions", maxFilters),
+			}},
+		}
+	}
+
+	for _, key := range keys {
+		match := filterKey.FindStringSubmatch(key)
+		if match == nil {
+			errs = append(errs, domain.ValidationError{Field: key, Error: "filter must look like filter[field][operator]"})
+			continue
+		}
+
+		name, operator := match[1], match[2]
+		if operator == "" {
+			operator = "eq"
+		}
+
+		field, ok := fields[name]
+		if !ok {
+			errs = append(errs, domain.ValidationError{Field: key, Error: fmt.Sprintf("filtering by %s is not supported", name)})
+			continue
+		}
+		if !slices.Contains(operators[field.Type], operator) {
+			errs = append(errs, domain.ValidationError{Field: key, Error: fmt.Sprintf("%s does not support the %s operator", name, operator)})
+			continue
+		}
+
+		value, err := filterValue(field, operator, queries[key])
+		if err != nil {
+			errs = append(errs, domain.ValidationError{Field: key, Error: err.Error()})
+			continue
+		}
+
+		filters = append(filters, domain.Filter{
+			Field:    name,
+			Operator: operator,
+			Value:    value,
+		})
+	}
+
+	if len(errs) > 0 {
+		return nil, &domain.ErrorResponse{
+			Code:  fiber.StatusBadRequest,
+			Error: errs,
+		}
+	}
+
+	return filters, nil
+}
+
+func filterValue(field domain.Field, operator, value string) (interface{}, error) {
+	if operator != "in" {
+		return parseFilterValue(field, value)
+	}
+
+	parts := strings.Split(value, ",")
+	if len(parts) > maxFilterIn {
+		return nil, fmt.Errorf("in must not have more than %d values", maxFilterIn)
+	}
+
+	values := make([]interface{}, 0, len(parts))
+	for _, part := range parts {
+		v, err := parseFilterValue(field, strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+
+	return values, nil
+}
+
+func parseFilterValue(field domain.Field, value string) (interface{}, error) {
+	switch field.Type {
+	case domain.FieldEnum:
+		if !slices.Contains(field.Values, value) {
+			return nil, fmt.Errorf("value must be one of [%s]", strings.Join(field.Values, " "))
+		}
+		return value, nil
+	case domain.FieldNumber:
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("value must be a number")
+		}
+		return v, nil
+	case domain.FieldTime:
+		for _, layout := range []string{time.RFC3339Nano, time.DateOnly} {
+			if v, err := time.Parse(layout, value); err == nil {
+				return v, nil
+			}
+		}
+		return nil, fmt.Errorf("value must be an RFC 3339 timestamp or a date")
+	default:
+		if value == "" {
+			return nil, fmt.Errorf("value must not be empty")
+		}
+		return value, nil
+	}
+}
+
+// ParseSort reads a comma separated list of fields, prefixed with - to sort
+// descending or + to sort ascending. Fields without a prefix use order.
+func ParseSort(sort, order string, fields domain.Fields) ([]domain.SortField, error) {
+	columns := splitSort(sort, order)
+
+	if len(columns) > maxSortFields {
+		return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("sort must not have more than %d fields", maxSortFields))
+	}
+
+	for _, column := range columns {
+		if _, ok := fields[column.Field]; !ok {
+			return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("sorting by %s is not supported", column.Field))
+		}
+	}
+
+	return columns, nil
+}
+
+func splitSort(sort, order string) []domain.SortField {
+	if strings.TrimSpace(sort) == "" {
+		sort = "created_at"
+	}
+
+	var columns []domain.SortField
+	for _, part := range strings.Split(sort, ",") {
+		// a + in the query string is decoded as a space
+		part = strings.TrimSpace(part)
+
+		column := domain.SortField{Field: part, Desc: order == "desc"}
+		switch {
+		case strings.HasPrefix(part, "-"):
+			column = domain.SortField{Field: part[1:], Desc: true}
+		case strings.HasPrefix(part, "+"):
+			column = domain.SortField{Field: part[1:]}
+		}
+
+		if !slices.ContainsFunc(columns, func(c domain.SortField) bool { return c.Field == column.Field }) {
+			columns = append(columns, column)
+		}
+	}
+
+	return columns
+}
+
+// formatSort is the inverse of ParseSort, every field gets an explicit
+// direction so the result does not depend on the order parameter.
+func formatSort(columns []domain.SortField) string {
+	parts := make([]string, len(columns))
+	for i, column := range columns {
+		parts[i] = column.Field
+		if column.Desc {
+			parts[i] = "-" + column.Field
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// Filter compiles filters parsed by ParseFilters into where conditions. Field
+// names come from the allow-list and values are always bound as parameters.
+func Filter(filters []domain.Filter) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		for _, filter := range filters {
+			column := clause.Column{Table: clause.CurrentTable, Name: filter.Field}
+
+			switch filter.Operator {
+			case "eq":
+				db = db.Where(clause.Eq{Column: column, Value: filter.Value})
+			case "ne":
+				db = db.Where(clause.Neq{Column: column, Value: filter.Value})
+			case "gt":
+				db = db.Where(clause.Gt{Column: column, Value: filter.Value})
+			case "gte":
+				db = db.Where(clause.Gte{Column: column, Value: filter.Value})
+			case "lt":
+				db = db.Where(clause.Lt{Column: column, Value: filter.Value})
+			case "lte":
+				db = db.Where(clause.Lte{Column: column, Value: filter.Value})
+			case "in":
+				db = db.Where(clause.IN{Column: column, Values: filter.Value.([]interface{})})
+			case "contains":
+				pattern := "%" + escapeLike(strings.ToLower(fmt.Sprint(filter.Value))) + "%"
+				db = db.Where("LOWER(?) LIKE ? ESCAPE '!'", column, pattern)
+			default:
+				db.AddError(fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("unknown filter operator %s", filter.Operator)))
+			}
+		}
+
+		return db
+	}
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(value)
+}
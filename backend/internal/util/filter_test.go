@@ -0,0 +1,93 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFilters(t *testing.T) {
+	filters, err := ParseFilters(map[string]string{
+		"title":                   "ignored",
+		"filter[title][contains]": "go",
+		"filter[visibility][in]":  "public, unlisted",
+		"filter[created_at][gte]": "2024-01-01",
+		"filter[user_id]":         "3",
+		"filter[updated_at][lt]":  "2024-02-01T10:00:00Z",
+		"filter[description][ne]": "draft",
+		"filter[id][in]":          "1,2",
+	}, domain.NoteFields)
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.Filter{
+		{Field: "created_at", Operator: "gte", Value: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Field: "description", Operator: "ne", Value: "draft"},
+		{Field: "id", Operator: "in", Value: []interface{}{int64(1), int64(2)}},
+		{Field: "title", Operator: "contains", Value: "go"},
+		{Field: "updated_at", Operator: "lt", Value: time.Date(2024, 2, 1, 10, 0, 0, 0, time.UTC)},
+		{Field: "user_id", Operator: "eq", Value: int64(3)},
+		{Field: "visibility", Operator: "in", Value: []interface{}{"public", "unlisted"}},
+	}, filters)
+}
+
+func TestParseFilters_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		key   string
+		value string
+		error string
+	}{
+		{name: "unknown field", key: "filter[password]", value: "a", error: "filtering by password is not supported"},
+		{name: "unknown operator", key: "filter[title][like]", value: "a", error: "title does not support the like operator"},
+		{name: "operator not for type", key: "filter[created_at][contains]", value: "2024", error: "created_at does not support the contains operator"},
+		{name: "malformed", key: "filter[title]]", value: "a", error: "filter must look like filter[field][operator]"},
+		{name: "number", key: "filter[user_id]", value: "abc", error: "value must be a number"},
+		{name: "time", key: "filter[created_at][gt]", value: "yesterday", error: "value must be an RFC 3339 timestamp or a date"},
+		{name: "enum", key: "filter[visibility][in]", value: "public,secret", error: "value must be one of [public private unlisted]"},
+		{name: "empty", key: "filter[title]", value: "", error: "value must not be empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filters, err := ParseFilters(map[string]string{tt.key: tt.value}, domain.NoteFields)
+			assert.Nil(t, filters)
+			assert.Equal(t, &domain.ErrorResponse{
+				Code:  400,
+				Error: []domain.ValidationError{{Field: tt.key, Error: tt.error}},
+			}, err)
+		})
+	}
+}
+
+func TestParseSort(t *testing.T) {
+	columns, err := ParseSort("-updated_at, title,+id,title", "desc", domain.NoteFields)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.SortField{
+		{Field: "updated_at", Desc: true},
+		{Field: "title", Desc: true},
+		{Field: "id"},
+	}, columns)
+	assert.Equal(t, "-updated_at,-title,id", formatSort(columns))
+
+	columns, err = ParseSort("", "", domain.UserFields)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.SortField{{Field: "created_at"}}, columns)
+}
+
+func TestFilter(t *testing.T) {
+	_, db := newTestPagination(t)
+
+	filters, errs := ParseFilters(map[string]string{
+		"filter[title][contains]": "100%_go!",
+		"filter[visibility][in]":  "public,unlisted",
+		"filter[created_at][gte]": "2024-01-01",
+		"filter[user_id][ne]":     "3",
+	}, domain.NoteFields)
+	assert.Nil(t, errs)
+
+	stmt := db.Model(&domain.Note{}).Scopes(Filter(filters)).Find(&[]domain.Note{}).Statement
+	assert.Equal(t, `SELECT * FROM "notes" WHERE "notes"."created_at" >= $1 AND LOWER("notes"."title") LIKE $2 ESCAPE '!' AND "notes"."user_id" <> $3 AND "notes"."visibility" IN ($4,$5) AND "notes"."deleted_at" IS NULL`, stmt.SQL.String())
+	assert.Equal(t, "%100!%!_go!!%", stmt.Vars[1])
+	assert.Equal(t, int64(3), stmt.Vars[2])
+}
@@ -0,0 +1,56 @@
+package util
+
+import (
+	"bytes"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FormatFrontMatter prepends meta to body as a YAML front matter block.
+func FormatFrontMatter(meta interface{}, body string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString("---\n")
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(meta); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	buf.WriteString("---\n\n")
+	buf.WriteString(body)
+	if body != "" && body[len(body)-1] != '\n' {
+		buf.WriteByte('\n')
+	}
+
+	return buf.Bytes(), nil
+}
+
+// ParseFrontMatter decodes a leading YAML front matter block into meta and
+// returns the remaining body. Data without front matter is returned as is.
+func ParseFrontMatter(data []byte, meta interface{}) (string, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	normalized := bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))
+
+	if !bytes.HasPrefix(normalized, []byte("---\n")) {
+		return string(data), nil
+	}
+
+	rest := normalized[len("---\n"):]
+	end := bytes.Index(rest, []byte("\n---\n"))
+	if end < 0 {
+		if !bytes.HasSuffix(rest, []byte("\n---")) {
+			return string(data), nil
+		}
+		end = len(rest) - len("\n---")
+	}
+
+	if err := yaml.Unmarshal(rest[:end], meta); err != nil {
+		return "", err
+	}
+
+	body := rest[min(end+len("\n---\n"), len(rest)):]
+	return string(bytes.TrimLeft(body, "\n")), nil
+}
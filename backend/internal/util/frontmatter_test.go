@@ -0,0 +1,76 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testFrontMatter struct {
+	Title      string `yaml:"title"`
+	Visibility string `yaml:"visibility"`
+}
+
+func TestFrontMatter(t *testing.T) {
+	data, err := FormatFrontMatter(testFrontMatter{Title: "golang: lets go", Visibility: "public"}, "is the best")
+	assert.NoError(t, err)
+	assert.Equal(t, "---\ntitle: 'golang: lets go'\nvisibility: public\n---\n\nis the best\n", string(data))
+
+	var meta testFrontMatter
+	body, err := ParseFrontMatter(data, &meta)
+	assert.NoError(t, err)
+	assert.Equal(t, testFrontMatter{Title: "golang: lets go", Visibility: "public"}, meta)
+	assert.Equal(t, "is the best\n", body)
+}
+
+func TestParseFrontMatter(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		meta    testFrontMatter
+		body    string
+		wantErr bool
+	}{
+		{
+			name: "no front matter",
+			data: "# golang\n",
+			body: "# golang\n",
+		},
+		{
+			name: "windows line endings",
+			data: "---\r\ntitle: golang\r\n---\r\nis the best",
+			meta: testFrontMatter{Title: "golang"},
+			body: "is the best",
+		},
+		{
+			name: "only front matter",
+			data: "---\ntitle: golang\n---",
+			meta: testFrontMatter{Title: "golang"},
+		},
+		{
+			name: "unterminated",
+			data: "---\ntitle: golang\n",
+			body: "---\ntitle: golang\n",
+		},
+		{
+			name:    "invalid yaml",
+			data:    "---\ntitle: [golang\n---\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var meta testFrontMatter
+			body, err := ParseFrontMatter([]byte(tt.data), &meta)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.meta, meta)
+				assert.Equal(t, tt.body, body)
+			}
+		})
+	}
+}
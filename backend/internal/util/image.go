@@ -0,0 +1,363 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+
+	_ "image/gif"
+	_ "image/png"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// maxImagePixels guards against small files that decode into huge images.
+const maxImagePixels = 50_000_000
+
+type ImageVariant struct {
+	Name string
+	Size int
+	// Crop cuts the image to a square before scaling it down
+	Crop bool
+}
+
+// ImageVariants are the sizes generated for every uploaded image, the names
+// match the fields of domain.Image.
+var ImageVariants = []ImageVariant{
+	{Name: "large", Size: 1600},
+	{Name: "medium", Size: 800},
+	{Name: "small", Size: 400},
+	{Name: "thumbnail", Size: 160, Crop: true},
+}
+
+// VariantKey returns the storage key of a variant of the file stored at key.
+// Variants are always JPEG.
+func VariantKey(key, name string) string {
+	return strings.TrimSuffix(key, path.Ext(key)) + "-" + name + ".jpg"
+}
+
+// PendingKey returns where an upload stored at key is kept until it is
+// processed. Nothing under the prefix is ever public, so with S3 the bucket
+// only has to expose everything else, and the original with its metadata is
+// not reachable before it is stripped.
+func PendingKey(key string) string {
+	return "pending/" + key
+}
+
+// UploadLookup finds the uploads stored under the given keys along with their
+// variants, port.UploadRepository is one.
+type UploadLookup interface {
+	GetByKeys(ctx context.Context, keys []string) ([]domain.Upload, error)
+}
+
+// Images turns image urls into the urls of all their variants. The zero value
+// leaves every url as it is.
+type Images struct {
+	publicURL string
+	uploads   UploadLookup
+}
+
+func NewImages(cfg *config.Config, uploads UploadLookup) Images {
+	return Images{
+		publicURL: cfg.Storage.PublicURL,
+		uploads:   uploads,
+	}
+}
+
+// Resolve looks up the uploads behind urls in one query. The urls of images
+// hosted elsewhere are left alone.
+func (i Images) Resolve(ctx context.Context, urls ...string) (ImageSet, error) {
+	set := ImageSet{images: i, uploads: make(map[string]*domain.Upload)}
+
+	var keys []string
+	for _, url := range urls {
+		if key, ok := i.key(url); ok {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 || i.uploads == nil {
+		return set, nil
+	}
+
+	uploads, err := i.uploads.GetByKeys(ctx, keys)
+	if err != nil {
+		return set, err
+	}
+	for n := range uploads {
+		set.uploads[uploads[n].Key] = &uploads[n]
+	}
+
+	return set, nil
+}
+
+// Upload returns the image of an upload with the variants it has. An upload
+// that is not processed has none, not even the original, it is not served yet.
+func (i Images) Upload(upload *domain.Upload) domain.Image {
+	if upload.Status != domain.UploadReady {
+		return domain.Image{}
+	}
+
+	image := domain.Image{
+		Original: i.publicURL + "/" + upload.Key,
+	}
+	for _, variant := range upload.Variants {
+		url := i.publicURL + "/" + variant.Key
+		switch variant.Name {
+		case "large":
+			image.Large = url
+		case "medium":
+			image.Medium = url
+		case "small":
+			image.Small = url
+		case "thumbnail":
+			image.Thumbnail = url
+		}
+	}
+
+	return image
+}
+
+// key returns the storage key of an uploaded image, false for images hosted
+// elsewhere.
+func (i Images) key(url string) (string, bool) {
+	if i.publicURL == "" || !strings.HasPrefix(url, i.publicURL+"/") {
+		return "", false
+	}
+	return strings.TrimPrefix(url, i.publicURL+"/"), true
+}
+
+// ImageSet holds the uploads resolved for the urls of a response.
+type ImageSet struct {
+	images  Images
+	uploads map[string]*domain.Upload
+}
+
+// Image returns the variants of url. An uploaded image that is not ready, or
+// gone, comes back empty rather than with urls that 404.
+func (s ImageSet) Image(url string) domain.Image {
+	key, ok := s.images.key(url)
+	if !ok {
+		return domain.Image{Original: url}
+	}
+
+	upload, ok := s.uploads[key]
+	if !ok {
+		return domain.Image{}
+	}
+
+	return s.images.Upload(upload)
+}
+
+// Avatar is like Image but returns nil for users without an avatar, or with
+// one that cannot be shown yet.
+func (s ImageSet) Avatar(url string) *domain.Image {
+	if url == "" {
+		return nil
+	}
+
+	image := s.Image(url)
+	if image.Original == "" {
+		return nil
+	}
+	return &image
+}
+
+// DecodeImage decodes a JPEG, PNG, GIF or WebP image, scales it down to fit in
+// maxSize and applies the EXIF orientation of JPEGs so the pixels are upright.
+func DecodeImage(data []byte, maxSize int) (image.Image, string, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", err
+	}
+	if cfg.Width*cfg.Height > maxImagePixels {
+		return nil, "", errors.New("image dimensions are too large")
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", err
+	}
+
+	img = ResizeImage(img, maxSize, false)
+	if format == "jpeg" {
+		img = orient(img, exifOrientation(data))
+	}
+
+	return img, format, nil
+}
+
+// ResizeImage scales img down so that it fits in a size by size box, keeping
+// the aspect ratio. Images are never scaled up.
+func ResizeImage(img image.Image, size int, crop bool) image.Image {
+	src := img.Bounds()
+	if crop {
+		side := min(src.Dx(), src.Dy())
+		origin := image.Pt(src.Min.X+(src.Dx()-side)/2, src.Min.Y+(src.Dy()-side)/2)
+		src = image.Rectangle{Min: origin, Max: origin.Add(image.Pt(side, side))}
+	}
+
+	width, height := src.Dx(), src.Dy()
+	if width > size || height > size {
+		if width >= height {
+			width, height = size, max(1, height*size/width)
+		} else {
+			width, height = max(1, width*size/height), size
+		}
+	} else if !crop {
+		return img
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, src, draw.Src, nil)
+
+	return dst
+}
+
+// EncodeJPEG writes img as a JPEG. Transparent areas become white.
+func EncodeJPEG(w io.Writer, img image.Image, quality int) error {
+	if !Opaque(img) {
+		bounds := img.Bounds()
+		flat := image.NewRGBA(bounds)
+		draw.Draw(flat, bounds, image.NewUniform(color.White), image.Point{}, draw.Src)
+		draw.Draw(flat, bounds, img, bounds.Min, draw.Over)
+		img = flat
+	}
+
+	return jpeg.Encode(w, img, &jpeg.Options{Quality: quality})
+}
+
+func Opaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+	return false
+}
+
+// orient rotates and flips img according to an EXIF orientation value.
+func orient(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	if orientation >= 5 {
+		dst = image.NewRGBA(image.Rect(0, 0, height, width))
+	}
+
+	for y := 0; y < dst.Rect.Dy(); y++ {
+		for x := 0; x < dst.Rect.Dx(); x++ {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = width-1-x, y
+			case 3:
+				sx, sy = width-1-x, height-1-y
+			case 4:
+				sx, sy = x, height-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, height-1-x
+			case 7:
+				sx, sy = width-1-y, height-1-x
+			case 8:
+				sx, sy = width-1-y, x
+			}
+			dst.Set(x, y, img.At(bounds.Min.X+sx, bounds.Min.Y+sy))
+		}
+	}
+
+	return dst
+}
+
+// exifOrientation reads the orientation tag from the EXIF segment of a JPEG,
+// 1 (upright) is returned when there is none.
+func exifOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return 1
+		}
+		marker := data[i+1]
+		switch {
+		case marker == 0xFF:
+			// fill byte before a marker
+			i++
+			continue
+		case marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7):
+			i += 2
+			continue
+		case marker == 0xDA || marker == 0xD9:
+			// image data starts, metadata always comes before it
+			return 1
+		}
+
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		if length < 2 || i+2+length > len(data) {
+			return 1
+		}
+
+		segment := data[i+4 : i+2+length]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return tiffOrientation(segment[6:])
+		}
+
+		i += 2 + length
+	}
+
+	return 1
+}
+
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	offset := int(order.Uint32(tiff[4:]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return 1
+	}
+
+	count := int(order.Uint16(tiff[offset:]))
+	for n := 0; n < count; n++ {
+		entry := offset + 2 + n*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			if value := int(order.Uint16(tiff[entry+8:])); value >= 1 && value <= 8 {
+				return value
+			}
+			return 1
+		}
+	}
+
+	return 1
+}
@@ -0,0 +1,170 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"slices"
+	"testing"
+
+	"github.com/shironxn/blanknotes/internal/core/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withOrientation inserts an EXIF segment holding only the orientation tag
+// right after the SOI marker of a JPEG.
+func withOrientation(data []byte, orientation uint16) []byte {
+	var tiff bytes.Buffer
+	tiff.WriteString("MM")
+	binary.Write(&tiff, binary.BigEndian, uint16(42))
+	binary.Write(&tiff, binary.BigEndian, uint32(8))
+	binary.Write(&tiff, binary.BigEndian, uint16(1))
+	binary.Write(&tiff, binary.BigEndian, uint16(0x0112))
+	binary.Write(&tiff, binary.BigEndian, uint16(3))
+	binary.Write(&tiff, binary.BigEndian, uint32(1))
+	binary.Write(&tiff, binary.BigEndian, orientation)
+	binary.Write(&tiff, binary.BigEndian, uint16(0))
+	binary.Write(&tiff, binary.BigEndian, uint32(0))
+
+	segment := append([]byte("Exif\x00\x00"), tiff.Bytes()...)
+
+	var out bytes.Buffer
+	out.Write(data[:2])
+	out.Write([]byte{0xFF, 0xE1})
+	binary.Write(&out, binary.BigEndian, uint16(len(segment)+2))
+	out.Write(segment)
+	out.Write(data[2:])
+
+	return out.Bytes()
+}
+
+func TestDecodeImage(t *testing.T) {
+	// a landscape image with a red left half, stored sideways
+	src := image.NewRGBA(image.Rect(0, 0, 40, 20))
+	for y := 0; y < 20; y++ {
+		for x := 0; x < 40; x++ {
+			if x < 20 {
+				src.Set(x, y, color.RGBA{R: 255, A: 255})
+			} else {
+				src.Set(x, y, color.RGBA{B: 255, A: 255})
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&buf, src, &jpeg.Options{Quality: 100}))
+
+	tests := []struct {
+		name        string
+		orientation uint16
+		width       int
+		height      int
+		red         image.Point
+	}{
+		{name: "upright", orientation: 1, width: 40, height: 20, red: image.Pt(5, 10)},
+		{name: "rotated 90", orientation: 6, width: 20, height: 40, red: image.Pt(10, 5)},
+		{name: "rotated 180", orientation: 3, width: 40, height: 20, red: image.Pt(34, 10)},
+		{name: "rotated 270", orientation: 8, width: 20, height: 40, red: image.Pt(10, 34)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := withOrientation(buf.Bytes(), tt.orientation)
+			assert.Equal(t, int(tt.orientation), exifOrientation(data))
+
+			img, format, err := DecodeImage(data, 100)
+			assert.NoError(t, err)
+			assert.Equal(t, "jpeg", format)
+			assert.Equal(t, tt.width, img.Bounds().Dx())
+			assert.Equal(t, tt.height, img.Bounds().Dy())
+
+			r, _, b, _ := img.At(tt.red.X, tt.red.Y).RGBA()
+			assert.Greater(t, r, b)
+		})
+	}
+}
+
+func TestResizeImage(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 1000, 500))
+
+	tests := []struct {
+		name   string
+		size   int
+		crop   bool
+		width  int
+		height int
+	}{
+		{name: "fit", size: 400, width: 400, height: 200},
+		{name: "no upscale", size: 2000, width: 1000, height: 500},
+		{name: "crop", size: 160, crop: true, width: 160, height: 160},
+		{name: "crop without scaling", size: 2000, crop: true, width: 500, height: 500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ResizeImage(img, tt.size, tt.crop)
+			assert.Equal(t, tt.width, got.Bounds().Dx())
+			assert.Equal(t, tt.height, got.Bounds().Dy())
+		})
+	}
+}
+
+type fakeUploads []domain.Upload
+
+func (f fakeUploads) GetByKeys(ctx context.Context, keys []string) ([]domain.Upload, error) {
+	var uploads []domain.Upload
+	for _, upload := range f {
+		if slices.Contains(keys, upload.Key) {
+			uploads = append(uploads, upload)
+		}
+	}
+	return uploads, nil
+}
+
+func TestImages_Resolve(t *testing.T) {
+	images := Images{publicURL: "http://localhost:8080/uploads", uploads: fakeUploads{
+		{Key: "1/c2hpcm9u.png", Status: domain.UploadReady, Variants: []domain.UploadVariant{
+			{Name: "large", Key: "1/c2hpcm9u-large.jpg"},
+			{Name: "medium", Key: "1/c2hpcm9u-medium.jpg"},
+			{Name: "small", Key: "1/c2hpcm9u-small.jpg"},
+			{Name: "thumbnail", Key: "1/c2hpcm9u-thumbnail.jpg"},
+		}},
+		{Key: "1/cGVuZGluZw.png", Status: domain.UploadPending},
+		{Key: "1/ZmFpbGVk.png", Status: domain.UploadFailed},
+	}}
+
+	ready := "http://localhost:8080/uploads/1/c2hpcm9u.png"
+	pending := "http://localhost:8080/uploads/1/cGVuZGluZw.png"
+	failed := "http://localhost:8080/uploads/1/ZmFpbGVk.png"
+	missing := "http://localhost:8080/uploads/1/bWlzc2luZw.png"
+	external := "https://example.com/cover.jpg"
+
+	set, err := images.Resolve(context.Background(), ready, pending, failed, missing, external, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.Image{
+		Original:  "http://localhost:8080/uploads/1/c2hpcm9u.png",
+		Large:     "http://localhost:8080/uploads/1/c2hpcm9u-large.jpg",
+		Medium:    "http://localhost:8080/uploads/1/c2hpcm9u-medium.jpg",
+		Small:     "http://localhost:8080/uploads/1/c2hpcm9u-small.jpg",
+		Thumbnail: "http://localhost:8080/uploads/1/c2hpcm9u-thumbnail.jpg",
+	}, set.Image(ready))
+
+	// not served, so nothing to link to
+	for _, url := range []string{pending, failed, missing} {
+		assert.Equal(t, domain.Image{}, set.Image(url), url)
+		assert.Nil(t, set.Avatar(url), url)
+	}
+
+	assert.Equal(t, domain.Image{Original: external}, set.Image(external))
+	assert.Nil(t, set.Avatar(""))
+
+	set, err = Images{}.Resolve(context.Background(), external)
+	require.NoError(t, err)
+	assert.Equal(t, domain.Image{Original: external}, set.Image(external))
+}
@@ -0,0 +1,89 @@
+package util
+
+import (
+	"bytes"
+	"container/list"
+	"regexp"
+	"sync"
+
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	highlighting "github.com/yuin/goldmark-highlighting/v2"
+	"github.com/yuin/goldmark/extension"
+)
+
+// Markdown renders CommonMark/GFM to sanitized HTML. Rendered output is kept in
+// a small LRU cache, callers pick a key that changes whenever the source does.
+type Markdown struct {
+	md     goldmark.Markdown
+	policy *bluemonday.Policy
+
+	mu      sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+type markdownEntry struct {
+	key  string
+	html string
+}
+
+func NewMarkdown(cacheSize int) *Markdown {
+	md := goldmark.New(
+		goldmark.WithExtensions(
+			extension.GFM,
+			highlighting.NewHighlighting(
+				highlighting.WithStyle("github"),
+				highlighting.WithFormatOptions(chromahtml.TabWidth(4)),
+			),
+		),
+	)
+
+	// on top of the user generated content policy allow the inline styles
+	// written by the highlighter and the checkboxes of GFM task lists
+	policy := bluemonday.UGCPolicy()
+	policy.AllowStyles("color", "background-color", "font-weight", "font-style", "text-decoration").OnElements("span", "pre")
+	policy.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	policy.AllowAttrs("checked", "disabled").OnElements("input")
+	policy.AddTargetBlankToFullyQualifiedLinks(true)
+
+	return &Markdown{
+		md:      md,
+		policy:  policy,
+		size:    cacheSize,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+func (m *Markdown) Render(key string, source string) (string, error) {
+	m.mu.Lock()
+	if element, ok := m.entries[key]; ok {
+		m.order.MoveToFront(element)
+		m.mu.Unlock()
+		return element.Value.(*markdownEntry).html, nil
+	}
+	m.mu.Unlock()
+
+	var buf bytes.Buffer
+	if err := m.md.Convert([]byte(source), &buf); err != nil {
+		return "", err
+	}
+	html := m.policy.Sanitize(buf.String())
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.entries[key]; !ok {
+		m.entries[key] = m.order.PushFront(&markdownEntry{key: key, html: html})
+		if m.order.Len() > m.size {
+			oldest := m.order.Back()
+			m.order.Remove(oldest)
+			delete(m.entries, oldest.Value.(*markdownEntry).key)
+		}
+	}
+
+	return html, nil
+}
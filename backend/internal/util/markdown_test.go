@@ -0,0 +1,73 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarkdown_Render(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "commonmark",
+			source:   "# golang\n\nlets **go**",
+			contains: []string{"<h1", "golang</h1>", "<strong>go</strong>"},
+		},
+		{
+			name:     "gfm",
+			source:   "| a | b |\n|---|---|\n| 1 | 2 |\n\n- [x] done\n\n~~old~~",
+			contains: []string{"<table>", "<td>1</td>", `type="checkbox"`, "<del>old</del>"},
+		},
+		{
+			name:     "highlighting",
+			source:   "```go\nfunc main() {}\n```",
+			contains: []string{"<pre", `<span style="color:`, "main"},
+		},
+		{
+			name:     "raw html is removed",
+			source:   "<script>alert(1)</script>\n\n<img src=x onerror=alert(1)>",
+			excludes: []string{"<script", "onerror"},
+		},
+		{
+			name:     "unsafe links",
+			source:   "[click](javascript:alert(1)) [safe](https://go.dev)",
+			contains: []string{`href="https://go.dev"`, `rel="nofollow noopener"`},
+			excludes: []string{"javascript:"},
+		},
+	}
+
+	m := NewMarkdown(10)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := m.Render(tt.name, tt.source)
+			assert.NoError(t, err)
+
+			for _, want := range tt.contains {
+				assert.Contains(t, got, want)
+			}
+			for _, unwanted := range tt.excludes {
+				assert.NotContains(t, got, unwanted)
+			}
+		})
+	}
+}
+
+func TestMarkdown_Cache(t *testing.T) {
+	m := NewMarkdown(2)
+
+	first, _ := m.Render("1:1", "first")
+	cached, _ := m.Render("1:1", "changed without a new key")
+	assert.Equal(t, first, cached)
+
+	m.Render("2:1", "second")
+	m.Render("3:1", "third")
+
+	evicted, _ := m.Render("1:1", "rendered again")
+	assert.Contains(t, evicted, "rendered again")
+}
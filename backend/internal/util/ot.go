@@ -0,0 +1,284 @@
+package util
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+// Op is a single component of an Operation. A positive N retains N
+// characters, a negative N deletes -N characters and a non-empty S inserts S.
+type Op struct {
+	N int
+	S string
+}
+
+func (o Op) isRetain() bool { return o.S == "" && o.N > 0 }
+func (o Op) isDelete() bool { return o.S == "" && o.N < 0 }
+func (o Op) isInsert() bool { return o.S != "" }
+
+// Operation is a text operation in the ot.js format. Lengths are counted in
+// runes so that clients in any language agree on positions.
+type Operation struct {
+	Ops          []Op
+	BaseLength   int
+	TargetLength int
+}
+
+func NewOperation() *Operation {
+	return &Operation{}
+}
+
+func (o *Operation) Retain(n int) *Operation {
+	if n <= 0 {
+		return o
+	}
+	o.BaseLength += n
+	o.TargetLength += n
+	if last := len(o.Ops) - 1; last >= 0 && o.Ops[last].isRetain() {
+		o.Ops[last].N += n
+	} else {
+		o.Ops = append(o.Ops, Op{N: n})
+	}
+	return o
+}
+
+func (o *Operation) Insert(s string) *Operation {
+	if s == "" {
+		return o
+	}
+	o.TargetLength += utf8.RuneCountInString(s)
+
+	last := len(o.Ops) - 1
+	switch {
+	case last >= 0 && o.Ops[last].isInsert():
+		o.Ops[last].S += s
+	case last >= 0 && o.Ops[last].isDelete():
+		// keep inserts before deletes so equivalent operations look the same
+		if last > 0 && o.Ops[last-1].isInsert() {
+			o.Ops[last-1].S += s
+		} else {
+			o.Ops = append(o.Ops, o.Ops[last])
+			o.Ops[last] = Op{S: s}
+		}
+	default:
+		o.Ops = append(o.Ops, Op{S: s})
+	}
+	return o
+}
+
+func (o *Operation) Delete(n int) *Operation {
+	if n <= 0 {
+		return o
+	}
+	o.BaseLength += n
+	if last := len(o.Ops) - 1; last >= 0 && o.Ops[last].isDelete() {
+		o.Ops[last].N -= n
+	} else {
+		o.Ops = append(o.Ops, Op{N: -n})
+	}
+	return o
+}
+
+// IsNoop reports whether applying the operation leaves the document unchanged.
+func (o *Operation) IsNoop() bool {
+	return len(o.Ops) == 0 || (len(o.Ops) == 1 && o.Ops[0].isRetain())
+}
+
+func (o *Operation) Apply(doc string) (string, error) {
+	runes := []rune(doc)
+	if len(runes) != o.BaseLength {
+		return "", errors.New("operation base length does not match the document length")
+	}
+
+	result := make([]rune, 0, o.TargetLength)
+	index := 0
+	for _, op := range o.Ops {
+		switch {
+		case op.isRetain():
+			if index+op.N > len(runes) {
+				return "", errors.New("operation retains past the end of the document")
+			}
+			result = append(result, runes[index:index+op.N]...)
+			index += op.N
+		case op.isInsert():
+			result = append(result, []rune(op.S)...)
+		default:
+			index -= op.N
+		}
+	}
+
+	if index != len(runes) {
+		return "", errors.New("operation did not operate on the whole document")
+	}
+
+	return string(result), nil
+}
+
+// TransformIndex moves a cursor position so that it points to the same place
+// after the operation has been applied.
+func (o *Operation) TransformIndex(position int) int {
+	newPosition := position
+	remaining := position
+	for _, op := range o.Ops {
+		switch {
+		case op.isRetain():
+			remaining -= op.N
+		case op.isInsert():
+			newPosition += utf8.RuneCountInString(op.S)
+		default:
+			newPosition -= min(remaining, -op.N)
+			remaining += op.N
+		}
+		if remaining < 0 {
+			break
+		}
+	}
+	return newPosition
+}
+
+// Transform takes two operations a and b that happened concurrently and
+// produces a' and b' such that apply(apply(doc, a), b') == apply(apply(doc, b), a').
+func Transform(a, b *Operation) (*Operation, *Operation, error) {
+	if a.BaseLength != b.BaseLength {
+		return nil, nil, errors.New("both operations have to have the same base length")
+	}
+
+	aPrime, bPrime := NewOperation(), NewOperation()
+	ops1, ops2 := a.Ops, b.Ops
+	i1, i2 := 0, 0
+
+	next := func(ops []Op, i *int) *Op {
+		if *i >= len(ops) {
+			return nil
+		}
+		op := ops[*i]
+		*i++
+		return &op
+	}
+
+	op1, op2 := next(ops1, &i1), next(ops2, &i2)
+	for op1 != nil || op2 != nil {
+		if op1 != nil && op1.isInsert() {
+			aPrime.Insert(op1.S)
+			bPrime.Retain(utf8.RuneCountInString(op1.S))
+			op1 = next(ops1, &i1)
+			continue
+		}
+		if op2 != nil && op2.isInsert() {
+			aPrime.Retain(utf8.RuneCountInString(op2.S))
+			bPrime.Insert(op2.S)
+			op2 = next(ops2, &i2)
+			continue
+		}
+		if op1 == nil || op2 == nil {
+			return nil, nil, errors.New("cannot transform operations: first operation is too short")
+		}
+
+		var minLength int
+		switch {
+		case op1.isRetain() && op2.isRetain():
+			switch {
+			case op1.N > op2.N:
+				minLength = op2.N
+				op1.N -= op2.N
+				op2 = next(ops2, &i2)
+			case op1.N == op2.N:
+				minLength = op2.N
+				op1, op2 = next(ops1, &i1), next(ops2, &i2)
+			default:
+				minLength = op1.N
+				op2.N -= op1.N
+				op1 = next(ops1, &i1)
+			}
+			aPrime.Retain(minLength)
+			bPrime.Retain(minLength)
+		case op1.isDelete() && op2.isDelete():
+			switch {
+			case -op1.N > -op2.N:
+				op1.N -= op2.N
+				op2 = next(ops2, &i2)
+			case op1.N == op2.N:
+				op1, op2 = next(ops1, &i1), next(ops2, &i2)
+			default:
+				op2.N -= op1.N
+				op1 = next(ops1, &i1)
+			}
+		case op1.isDelete() && op2.isRetain():
+			switch {
+			case -op1.N > op2.N:
+				minLength = op2.N
+				op1.N += op2.N
+				op2 = next(ops2, &i2)
+			case -op1.N == op2.N:
+				minLength = op2.N
+				op1, op2 = next(ops1, &i1), next(ops2, &i2)
+			default:
+				minLength = -op1.N
+				op2.N += op1.N
+				op1 = next(ops1, &i1)
+			}
+			aPrime.Delete(minLength)
+		case op1.isRetain() && op2.isDelete():
+			switch {
+			case op1.N > -op2.N:
+				minLength = -op2.N
+				op1.N += op2.N
+				op2 = next(ops2, &i2)
+			case op1.N == -op2.N:
+				minLength = op1.N
+				op1, op2 = next(ops1, &i1), next(ops2, &i2)
+			default:
+				minLength = op1.N
+				op2.N += op1.N
+				op1 = next(ops1, &i1)
+			}
+			bPrime.Delete(minLength)
+		default:
+			return nil, nil, errors.New("cannot transform operations: unrecognized component")
+		}
+	}
+
+	return aPrime, bPrime, nil
+}
+
+// MarshalJSON encodes the operation as an ot.js array such as [5, "abc", -2].
+func (o Operation) MarshalJSON() ([]byte, error) {
+	components := make([]interface{}, 0, len(o.Ops))
+	for _, op := range o.Ops {
+		if op.isInsert() {
+			components = append(components, op.S)
+		} else {
+			components = append(components, op.N)
+		}
+	}
+	return json.Marshal(components)
+}
+
+func (o *Operation) UnmarshalJSON(data []byte) error {
+	var components []interface{}
+	if err := json.Unmarshal(data, &components); err != nil {
+		return err
+	}
+
+	*o = Operation{}
+	for _, component := range components {
+		switch v := component.(type) {
+		case string:
+			o.Insert(v)
+		case float64:
+			if v != float64(int(v)) || v == 0 {
+				return fmt.Errorf("invalid operation component %v", v)
+			}
+			if v > 0 {
+				o.Retain(int(v))
+			} else {
+				o.Delete(int(-v))
+			}
+		default:
+			return fmt.Errorf("invalid operation component %v", v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package util
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperation_Apply(t *testing.T) {
+	tests := []struct {
+		name    string
+		op      *Operation
+		doc     string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "insert",
+			op:   NewOperation().Retain(5).Insert(", world"),
+			doc:  "hello",
+			want: "hello, world",
+		},
+		{
+			name: "delete",
+			op:   NewOperation().Retain(5).Delete(7),
+			doc:  "hello, world",
+			want: "hello",
+		},
+		{
+			name: "unicode",
+			op:   NewOperation().Retain(2).Insert("🙂").Retain(1),
+			doc:  "héy",
+			want: "hé🙂y",
+		},
+		{
+			name:    "length mismatch",
+			op:      NewOperation().Retain(3),
+			doc:     "hello",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.op.Apply(tt.doc)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTransform(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		a    *Operation
+		b    *Operation
+		want string
+	}{
+		{
+			name: "concurrent inserts",
+			doc:  "go",
+			a:    NewOperation().Insert("lets ").Retain(2),
+			b:    NewOperation().Retain(2).Insert("!"),
+			want: "lets go!",
+		},
+		{
+			name: "insert inside deleted range",
+			doc:  "hello world",
+			a:    NewOperation().Retain(5).Delete(6),
+			b:    NewOperation().Retain(8).Insert("X").Retain(3),
+			want: "helloX",
+		},
+		{
+			name: "overlapping deletes",
+			doc:  "abcdef",
+			a:    NewOperation().Retain(1).Delete(3).Retain(2),
+			b:    NewOperation().Retain(2).Delete(3).Retain(1),
+			want: "af",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aPrime, bPrime, err := Transform(tt.a, tt.b)
+			require.NoError(t, err)
+
+			afterA, err := tt.a.Apply(tt.doc)
+			require.NoError(t, err)
+			left, err := bPrime.Apply(afterA)
+			require.NoError(t, err)
+
+			afterB, err := tt.b.Apply(tt.doc)
+			require.NoError(t, err)
+			right, err := aPrime.Apply(afterB)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, left)
+			assert.Equal(t, left, right)
+		})
+	}
+}
+
+func TestOperation_TransformIndex(t *testing.T) {
+	op := NewOperation().Insert("ab").Retain(3).Delete(2).Retain(1)
+
+	assert.Equal(t, 2, op.TransformIndex(0))
+	assert.Equal(t, 5, op.TransformIndex(3))
+	assert.Equal(t, 5, op.TransformIndex(4))
+	assert.Equal(t, 6, op.TransformIndex(6))
+}
+
+func TestOperation_JSON(t *testing.T) {
+	var op Operation
+	require.NoError(t, json.Unmarshal([]byte(`[3, "abc", -2, 1]`), &op))
+
+	assert.Equal(t, 6, op.BaseLength)
+	assert.Equal(t, 7, op.TargetLength)
+
+	data, err := json.Marshal(op)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[3, "abc", -2, 1]`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`[1.5]`), &op))
+	assert.Error(t, json.Unmarshal([]byte(`[true]`), &op))
+}
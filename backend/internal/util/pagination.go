@@ -1,57 +1,356 @@
 package util
 
 import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/config"
 	"github.com/shironxn/blanknotes/internal/core/domain"
+
+	"github.com/gofiber/fiber/v2"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
 )
 
 type Pagination struct {
 	validator *Validator
+	secret    []byte
+	schemas   *sync.Map
 }
 
-func NewPagination(validator *Validator) Pagination {
+func NewPagination(validator *Validator, cfg *config.Config) Pagination {
 	return Pagination{
 		validator: validator,
+		secret:    []byte(cfg.Pagination.Secret),
+		schemas:   &sync.Map{},
 	}
 }
 
-func (p *Pagination) Paginate(metadata *domain.Metadata) func(db *gorm.DB) *gorm.DB {
+// cursor points at the first or last row of a page. It is sent to clients
+// signed so the sort fields and values cannot be tampered with.
+type cursor struct {
+	Sort   string   `json:"s"`
+	Values []string `json:"v"`
+	Prev   bool     `json:"p,omitempty"`
+}
+
+// Paginate pages by metadata.Cursor when it is set and by page number
+// otherwise. Rows are ordered by the sort fields, which must be in fields, and
+// then the id so cursors always point at a single row. One extra row is
+// fetched to find out if there is a next page, call Cursors on the result.
+func (p *Pagination) Paginate(metadata *domain.Metadata, fields domain.Fields) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
-		switch {
-		case metadata.Limit > 100:
-			metadata.Limit = 100
-		case metadata.Limit < 1:
-			metadata.Limit = 10
+		var c *cursor
+		if metadata.Cursor != "" {
+			var err error
+			if c, err = p.decode(metadata.Cursor); err != nil {
+				db.AddError(err)
+				return db
+			}
+			metadata.Sort = c.Sort
+			metadata.Page = 0
+		}
+
+		p.normalize(metadata)
+
+		columns, err := ParseSort(metadata.Sort, metadata.Order, fields)
+		if err != nil {
+			if c != nil {
+				err = fiber.NewError(fiber.StatusBadRequest, "invalid cursor")
+			}
+			db.AddError(err)
+			return db
+		}
+		metadata.Sort = formatSort(columns)
+		keys := sortKeys(columns)
+
+		// a sparse fieldset still has to read the values cursors are made of
+		if selects := db.Statement.Selects; len(selects) > 0 {
+			for _, key := range keys {
+				if !slices.Contains(selects, key.Field) {
+					selects = append(slices.Clip(selects), key.Field)
+				}
+			}
+			db = db.Select(selects)
+		}
+
+		if c == nil {
+			for _, key := range keys {
+				db = db.Order(clause.OrderByColumn{Column: clause.Column{Table: clause.CurrentTable, Name: key.Field}, Desc: key.Desc})
+			}
+
+			return db.
+				Offset((metadata.Page - 1) * metadata.Limit).
+				Limit(metadata.Limit + 1)
+		}
+
+		if len(c.Values) != len(keys) {
+			db.AddError(fiber.NewError(fiber.StatusBadRequest, "invalid cursor"))
+			return db
+		}
+
+		if err := db.Statement.Parse(db.Statement.Model); err != nil {
+			db.AddError(err)
+			return db
+		}
+
+		values := make([]interface{}, len(keys))
+		for i, key := range keys {
+			field := db.Statement.Schema.LookUpField(key.Field)
+			if field == nil {
+				db.AddError(fiber.NewError(fiber.StatusBadRequest, "invalid cursor"))
+				return db
+			}
+
+			value, err := parseCursorValue(field, c.Values[i])
+			if err != nil {
+				db.AddError(fiber.NewError(fiber.StatusBadRequest, "invalid cursor"))
+				return db
+			}
+			values[i] = value
+		}
+
+		// rows after the cursor are those greater in the first key, or equal in
+		// it and greater in the next one, and so on. Going back walks the rows in
+		// reverse, Cursors puts them in order again.
+		var conditions []clause.Expression
+		for i, key := range keys {
+			var and []clause.Expression
+			for j := 0; j < i; j++ {
+				and = append(and, clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: keys[j].Field}, Value: values[j]})
+			}
+
+			column := clause.Column{Table: clause.CurrentTable, Name: key.Field}
+			if key.Desc != c.Prev {
+				and = append(and, clause.Lt{Column: column, Value: values[i]})
+			} else {
+				and = append(and, clause.Gt{Column: column, Value: values[i]})
+			}
+
+			conditions = append(conditions, clause.And(and...))
+		}
+		db = db.Where(clause.Or(conditions...))
+
+		for _, key := range keys {
+			db = db.Order(clause.OrderByColumn{Column: clause.Column{Table: clause.CurrentTable, Name: key.Field}, Desc: key.Desc != c.Prev})
+		}
+
+		return db.Limit(metadata.Limit + 1)
+	}
+}
+
+// Cursors drops the extra row fetched by Paginate from rows, a pointer to a
+// slice of models, and sets the next and previous cursors of metadata.
+func (p *Pagination) Cursors(db *gorm.DB, metadata *domain.Metadata, rows interface{}) error {
+	slice := reflect.ValueOf(rows).Elem()
+
+	var prev bool
+	if metadata.Cursor != "" {
+		c, err := p.decode(metadata.Cursor)
+		if err != nil {
+			return err
+		}
+		prev = c.Prev
+	}
+
+	more := slice.Len() > metadata.Limit
+	if more {
+		slice.Set(slice.Slice(0, metadata.Limit))
+	}
+
+	if prev {
+		for i, j := 0, slice.Len()-1; i < j; i, j = i+1, j-1 {
+			a, b := slice.Index(i).Interface(), slice.Index(j).Interface()
+			slice.Index(i).Set(reflect.ValueOf(b))
+			slice.Index(j).Set(reflect.ValueOf(a))
+		}
+	}
+
+	if slice.Len() == 0 {
+		return nil
+	}
+
+	hasNext, hasPrev := more, metadata.Page > 1
+	if metadata.Cursor != "" {
+		hasNext, hasPrev = more || prev, !prev || more
+	}
+
+	s, err := schema.Parse(slice.Index(0).Addr().Interface(), p.schemas, db.NamingStrategy)
+	if err != nil {
+		return err
+	}
+
+	// Paginate has already validated the sort, it only needs splitting again
+	keys := sortKeys(splitSort(metadata.Sort, metadata.Order))
+	fields := make([]*schema.Field, len(keys))
+	for i, key := range keys {
+		if fields[i] = s.LookUpField(key.Field); fields[i] == nil {
+			return nil
+		}
+	}
+
+	var encodeErr error
+	encode := func(row reflect.Value, prev bool) string {
+		values := make([]string, len(fields))
+		for i, field := range fields {
+			value, _ := field.ValueOf(context.Background(), row)
+
+			formatted, ok := formatCursorValue(value)
+			if !ok {
+				// rows cannot be compared to a NULL, so there is no cursor
+				return ""
+			}
+			values[i] = formatted
+		}
+
+		token, err := p.encode(cursor{
+			Sort:   metadata.Sort,
+			Values: values,
+			Prev:   prev,
+		})
+		if err != nil {
+			encodeErr = err
+		}
+		return token
+	}
+
+	if hasNext {
+		metadata.NextCursor = encode(slice.Index(slice.Len()-1), false)
+	}
+	if hasPrev {
+		metadata.PrevCursor = encode(slice.Index(0), true)
+	}
+
+	return encodeErr
+}
+
+// sortKeys appends the id to columns as the last tie breaker, in the direction
+// of the first column.
+func sortKeys(columns []domain.SortField) []domain.SortField {
+	for _, column := range columns {
+		if column.Field == "id" {
+			return columns
 		}
+	}
 
+	return append(slices.Clip(columns), domain.SortField{Field: "id", Desc: columns[0].Desc})
+}
+
+func (p *Pagination) normalize(metadata *domain.Metadata) {
+	switch {
+	case metadata.Limit > 100:
+		metadata.Limit = 100
+	case metadata.Limit < 1:
+		metadata.Limit = 10
+	}
+
+	if !metadata.SkipCount {
 		metadata.TotalPages = (int(metadata.TotalRecords) + metadata.Limit - 1) / metadata.Limit
 		if metadata.TotalPages < 1 {
 			metadata.TotalPages = 1
 		}
+	}
 
+	if metadata.Cursor == "" {
 		switch {
-		case metadata.Page > metadata.TotalPages:
+		case !metadata.SkipCount && metadata.Page > metadata.TotalPages:
 			metadata.Page = metadata.TotalPages
 		case metadata.Page < 1:
 			metadata.Page = 1
 		}
+	}
 
-		if err := p.validator.Validate(metadata); err != nil {
-			for _, error := range err.Error.([]domain.ValidationError) {
-				switch error.Field {
-				case "Sort":
-					metadata.Sort = "created_at"
-				case "Order":
-					metadata.Order = "asc"
-
-				}
+	if err := p.validator.Validate(metadata); err != nil {
+		for _, error := range err.Error.([]domain.ValidationError) {
+			if error.Field == "Order" {
+				metadata.Order = "asc"
 			}
 		}
+	}
+}
+
+func (p *Pagination) encode(c cursor) (string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+
+	payload := base64.RawURLEncoding.EncodeToString(data)
+	return payload + "." + base64.RawURLEncoding.EncodeToString(p.sign(payload)), nil
+}
+
+func (p *Pagination) decode(token string) (*cursor, error) {
+	invalid := fiber.NewError(fiber.StatusBadRequest, "invalid cursor")
+
+	payload, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, invalid
+	}
+
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(mac, p.sign(payload)) {
+		return nil, invalid
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, invalid
+	}
+
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, invalid
+	}
+
+	return &c, nil
+}
+
+func (p *Pagination) sign(payload string) []byte {
+	mac := hmac.New(sha256.New, p.secret)
+	mac.Write([]byte("cursor:" + payload))
+	return mac.Sum(nil)
+}
+
+func formatCursorValue(value interface{}) (string, bool) {
+	if valuer, ok := value.(driver.Valuer); ok {
+		v, err := valuer.Value()
+		if err != nil {
+			return "", false
+		}
+		value = v
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return "", false
+	case time.Time:
+		return v.Format(time.RFC3339Nano), true
+	default:
+		return fmt.Sprint(v), true
+	}
+}
 
-		offset := (metadata.Page - 1) * metadata.Limit
-		return db.
-			Order(metadata.Sort + " " + metadata.Order).
-			Offset(offset).
-			Limit(metadata.Limit)
+func parseCursorValue(field *schema.Field, value string) (interface{}, error) {
+	switch field.DataType {
+	case schema.Time:
+		return time.Parse(time.RFC3339Nano, value)
+	case schema.Int:
+		return strconv.ParseInt(value, 10, 64)
+	case schema.Uint:
+		return strconv.ParseUint(value, 10, 64)
+	default:
+		return value, nil
 	}
 }
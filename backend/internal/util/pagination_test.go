@@ -0,0 +1,142 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shironxn/blanknotes/internal/config"
+	"github.com/shironxn/blanknotes/internal/core/domain"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func newTestPagination(t *testing.T) (Pagination, *gorm.DB) {
+	validator, err := NewValidator()
+	assert.NoError(t, err)
+
+	cfg := &config.Config{}
+	cfg.JWT.Access = "secret"
+
+	// statements are only built, never sent to a database
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	assert.NoError(t, err)
+
+	return NewPagination(validator, cfg), db
+}
+
+func notes(ids ...uint) []domain.Note {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var notes []domain.Note
+	for _, id := range ids {
+		note := domain.Note{Title: "golang"}
+		note.ID = id
+		note.CreatedAt = base.Add(time.Duration(id) * time.Minute)
+		notes = append(notes, note)
+	}
+	return notes
+}
+
+func TestPagination_Cursors(t *testing.T) {
+	p, db := newTestPagination(t)
+
+	// first page by number, one extra row means there is a next page
+	metadata := domain.Metadata{Sort: "created_at", Order: "asc", Limit: 2, Page: 1, SkipCount: true}
+	stmt := db.Model(&domain.Note{}).Scopes(p.Paginate(&metadata, domain.NoteFields)).Find(&[]domain.Note{}).Statement
+	assert.Equal(t, `SELECT * FROM "notes" WHERE "notes"."deleted_at" IS NULL ORDER BY "notes"."created_at","notes"."id" LIMIT $1`, stmt.SQL.String())
+
+	rows := notes(1, 2, 3)
+	assert.NoError(t, p.Cursors(db, &metadata, &rows))
+	assert.Len(t, rows, 2)
+	assert.NotEmpty(t, metadata.NextCursor)
+	assert.Empty(t, metadata.PrevCursor)
+
+	// the next cursor continues after the last row
+	next := domain.Metadata{Limit: 2, Cursor: metadata.NextCursor}
+	stmt = db.Model(&domain.Note{}).Scopes(p.Paginate(&next, domain.NoteFields)).Find(&[]domain.Note{}).Statement
+	assert.Equal(t, `SELECT * FROM "notes" WHERE ("notes"."created_at" > $1 OR ("notes"."created_at" = $2 AND "notes"."id" > $3)) AND "notes"."deleted_at" IS NULL ORDER BY "notes"."created_at","notes"."id" LIMIT $4`, stmt.SQL.String())
+	assert.Equal(t, notes(2)[0].CreatedAt, stmt.Vars[0])
+	assert.Equal(t, uint64(2), stmt.Vars[2])
+
+	rows = notes(3, 4)
+	assert.NoError(t, p.Cursors(db, &next, &rows))
+	assert.Len(t, rows, 2)
+	assert.Empty(t, next.NextCursor)
+	assert.NotEmpty(t, next.PrevCursor)
+
+	// going back reads in reverse and puts the rows in order again
+	prev := domain.Metadata{Limit: 2, Cursor: next.PrevCursor}
+	stmt = db.Model(&domain.Note{}).Scopes(p.Paginate(&prev, domain.NoteFields)).Find(&[]domain.Note{}).Statement
+	assert.Contains(t, stmt.SQL.String(), `"notes"."created_at" < $1`)
+	assert.Contains(t, stmt.SQL.String(), `ORDER BY "notes"."created_at" DESC,"notes"."id" DESC`)
+
+	rows = notes(2, 1)
+	assert.NoError(t, p.Cursors(db, &prev, &rows))
+	assert.Equal(t, []uint{1, 2}, []uint{rows[0].ID, rows[1].ID})
+	assert.NotEmpty(t, prev.NextCursor)
+	assert.Empty(t, prev.PrevCursor)
+}
+
+func TestPagination_InvalidCursor(t *testing.T) {
+	p, db := newTestPagination(t)
+
+	token, err := p.encode(cursor{Sort: "created_at", Values: []string{"2024-01-01T00:00:00Z", "1"}})
+	assert.NoError(t, err)
+
+	other := Pagination{validator: p.validator, secret: []byte("other")}
+	forged, err := other.encode(cursor{Sort: "password", Values: []string{"a", "1"}})
+	assert.NoError(t, err)
+
+	// signed, but not for a sort the resource allows
+	unsupported, err := p.encode(cursor{Sort: "password", Values: []string{"a", "1"}})
+	assert.NoError(t, err)
+
+	// signed, but missing the id tie breaker
+	short, err := p.encode(cursor{Sort: "created_at", Values: []string{"2024-01-01T00:00:00Z"}})
+	assert.NoError(t, err)
+
+	for _, cursor := range []string{"abc", token[:len(token)-2], forged, unsupported, short} {
+		metadata := domain.Metadata{Cursor: cursor}
+		err := db.Model(&domain.Note{}).Scopes(p.Paginate(&metadata, domain.NoteFields)).Find(&[]domain.Note{}).Error
+		assert.EqualError(t, err, "invalid cursor", cursor)
+	}
+}
+
+func TestPagination_MultipleColumns(t *testing.T) {
+	p, db := newTestPagination(t)
+
+	metadata := domain.Metadata{Sort: "-updated_at, title", Limit: 2, Page: 1, SkipCount: true}
+	stmt := db.Model(&domain.Note{}).Scopes(p.Paginate(&metadata, domain.NoteFields)).Find(&[]domain.Note{}).Statement
+	assert.Equal(t, `SELECT * FROM "notes" WHERE "notes"."deleted_at" IS NULL ORDER BY "notes"."updated_at" DESC,"notes"."title","notes"."id" DESC LIMIT $1`, stmt.SQL.String())
+	assert.Equal(t, "-updated_at,title", metadata.Sort)
+
+	rows := notes(3, 2, 1)
+	assert.NoError(t, p.Cursors(db, &metadata, &rows))
+	assert.NotEmpty(t, metadata.NextCursor)
+
+	// the cursor keeps the sort, whatever the next request asks for
+	next := domain.Metadata{Sort: "id", Order: "asc", Limit: 2, Cursor: metadata.NextCursor}
+	stmt = db.Model(&domain.Note{}).Scopes(p.Paginate(&next, domain.NoteFields)).Find(&[]domain.Note{}).Statement
+	assert.Equal(t, `SELECT * FROM "notes" WHERE ("notes"."updated_at" < $1 OR ("notes"."updated_at" = $2 AND "notes"."title" > $3) OR ("notes"."updated_at" = $4 AND "notes"."title" = $5 AND "notes"."id" < $6)) AND "notes"."deleted_at" IS NULL ORDER BY "notes"."updated_at" DESC,"notes"."title","notes"."id" DESC LIMIT $7`, stmt.SQL.String())
+	assert.Equal(t, "golang", stmt.Vars[2])
+	assert.Equal(t, uint64(2), stmt.Vars[5])
+}
+
+func TestPagination_InvalidSort(t *testing.T) {
+	p, db := newTestPagination(t)
+
+	for sort, message := range map[string]string{
+		"password":                    "sorting by password is not supported",
+		"-title,name":                 "sorting by name is not supported",
+		"id,title,user_id,created_at": "sort must not have more than 3 fields",
+	} {
+		metadata := domain.Metadata{Sort: sort}
+		err := db.Model(&domain.Note{}).Scopes(p.Paginate(&metadata, domain.NoteFields)).Find(&[]domain.Note{}).Error
+		assert.EqualError(t, err, message, sort)
+	}
+}
@@ -0,0 +1,34 @@
+package util
+
+import (
+	"sync"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// handlers maps every app to the first handlers of its routes that are not
+// middlewares. It is filled on the first request, when every route is
+// registered.
+var handlers sync.Map
+
+// Route returns the template of the route that handled the request, like
+// /api/v1/notes/:id. It is false when no route matched, then the last route
+// run is a middleware and its path says nothing about the request.
+func Route(ctx *fiber.Ctx) (string, bool) {
+	routes, ok := handlers.Load(ctx.App())
+	if !ok {
+		first := map[*fiber.Handler]bool{}
+		for _, route := range ctx.App().GetRoutes(true) {
+			if len(route.Handlers) > 0 {
+				first[&route.Handlers[0]] = true
+			}
+		}
+		routes, _ = handlers.LoadOrStore(ctx.App(), first)
+	}
+
+	route := ctx.Route()
+	if len(route.Handlers) == 0 || !routes.(map[*fiber.Handler]bool)[&route.Handlers[0]] {
+		return "", false
+	}
+	return route.Path, true
+}
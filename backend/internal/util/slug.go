@@ -0,0 +1,29 @@
+package util
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Slugify turns a title into a lowercase, dash separated name that is safe to
+// use as a file name.
+func Slugify(title string) string {
+	var b strings.Builder
+
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			dash = false
+		} else if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+
+	slug := strings.TrimSuffix(b.String(), "-")
+	if slug == "" {
+		return "untitled"
+	}
+	return slug
+}
@@ -0,0 +1,26 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{title: "Golang", want: "golang"},
+		{title: "  Lets Go! v1.22  ", want: "lets-go-v1-22"},
+		{title: "Catatan Harian ✨", want: "catatan-harian"},
+		{title: "../../etc/passwd", want: "etc-passwd"},
+		{title: "!!!", want: "untitled"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			assert.Equal(t, tt.want, Slugify(tt.title))
+		})
+	}
+}
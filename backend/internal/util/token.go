@@ -0,0 +1,14 @@
+package util
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+)
+
+func GenerateToken(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
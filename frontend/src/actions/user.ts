@@ -26,7 +26,7 @@ const GetUserMe = async () => {
 
 const GetUserByName = async (name: string) => {
   try {
-    const res = await fetch(`${BASE_API_URL}/users?name=${name}&details=true`, {
+    const res = await fetch(`${BASE_API_URL}/users?name=${name}`, {
       cache: "no-store",
     });
 
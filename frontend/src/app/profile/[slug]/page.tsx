@@ -2,6 +2,7 @@ import { GetUserByName } from "@/actions/user";
 import { GetNotes } from "@/actions/note";
 import { NoteCard } from "@/components/note/note-card";
 import { Avatar, AvatarFallback, AvatarImage } from "@/components/ui/avatar";
+import { imageURL } from "@/lib/utils";
 import { NotePagination } from "@/components/note/note-pagination";
 
 export default async function Page({
@@ -30,7 +31,7 @@ export default async function Page({
       <div className="flex items-center justify-center mt-8">
         <div className="flex flex-col items-center">
           <Avatar className="h-24 w-24 md:h-32 md:w-32">
-            <AvatarImage src={user.avatar_url} />
+            <AvatarImage src={imageURL(user.avatar, "small")} />
             <AvatarFallback>
               {user.name.slice(0, 2).toUpperCase()}
             </AvatarFallback>
@@ -1,4 +1,5 @@
 import { Avatar, AvatarFallback, AvatarImage } from "@/components/ui/avatar";
+import { imageURL } from "@/lib/utils";
 import { UserUpdateDrawer } from "@/components/user-drawer";
 import { GetUserMe } from "@/actions/user";
 import { GetNotes } from "@/actions/note";
@@ -35,7 +36,7 @@ export default async function Page({
         <div className="flex items-center justify-center mt-8">
           <div className="flex flex-col items-center">
             <Avatar className="h-24 w-24 md:h-32 md:w-32">
-              <AvatarImage src={user.data.avatar_url} />
+              <AvatarImage src={imageURL(user.data.avatar, "small")} />
               <AvatarFallback>
                 {user.data.name?.slice(0, 2).toUpperCase()}
               </AvatarFallback>
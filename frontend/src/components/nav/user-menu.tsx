@@ -2,6 +2,7 @@
 
 import Link from "next/link";
 import { Avatar, AvatarFallback, AvatarImage } from "@/components/ui/avatar";
+import { imageURL } from "@/lib/utils";
 import {
   DropdownMenu,
   DropdownMenuContent,
@@ -64,7 +65,7 @@ const UserMenu = ({ user }: { user: User }) => {
     <DropdownMenu>
       <DropdownMenuTrigger asChild>
         <Avatar className="h-12 w-12 cursor-pointer">
-          <AvatarImage src={user.avatar_url} />
+          <AvatarImage src={imageURL(user.avatar, "thumbnail")} />
           <AvatarFallback>
             {user.name?.slice(0, 2).toUpperCase()}
           </AvatarFallback>
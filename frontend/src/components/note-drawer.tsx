@@ -245,6 +245,7 @@ function NoteForm({ className, note }: { className?: string; note?: Note }) {
                           <SelectContent>
                             <SelectItem value="public">public</SelectItem>
                             <SelectItem value="private">private</SelectItem>
+                            <SelectItem value="unlisted">unlisted</SelectItem>
                           </SelectContent>
                         </Select>
                       ) : (
@@ -22,6 +22,7 @@ import { AspectRatio } from "@/components/ui/aspect-ratio";
 import Image from "next/image";
 import { Note, NotePagination as NoteData } from "@/lib/schema/note";
 import { Avatar, AvatarFallback, AvatarImage } from "@/components/ui/avatar";
+import { imageURL } from "@/lib/utils";
 import { Button } from "@/components/ui/button";
 import { Skeleton } from "@/components/ui/skeleton";
 import { NoteMenu } from "@/components/note-drawer";
@@ -73,12 +74,14 @@ export function NoteCard({ data }: { data: NoteData }) {
             <CardHeader>
               <div className="w-full">
                 <AspectRatio ratio={5 / 1}>
-                  <Image
-                    src={item.cover_url}
-                    alt=""
-                    className="object-cover rounded-t-md"
-                    fill
-                  />
+                  {imageURL(item.cover, "medium") && (
+                    <Image
+                      src={imageURL(item.cover, "medium")!}
+                      alt=""
+                      className="object-cover rounded-t-md"
+                      fill
+                    />
+                  )}
                 </AspectRatio>
               </div>
             </CardHeader>
@@ -103,7 +106,7 @@ export function NoteCard({ data }: { data: NoteData }) {
             <CardFooter className="justify-between">
               <div className="flex text-center items-center space-x-4">
                 <Avatar className="h-10 w-10">
-                  <AvatarImage src={item.author.avatar_url} />
+                  <AvatarImage src={imageURL(item.author.avatar, "thumbnail")} />
                   <AvatarFallback>
                     {item.author.name.slice(0, 2).toUpperCase()}
                   </AvatarFallback>
@@ -136,12 +139,14 @@ export function NoteCard({ data }: { data: NoteData }) {
                       </DialogDescription>
                       <div className="w-full">
                         <AspectRatio ratio={4 / 2}>
-                          <Image
-                            src={item.cover_url}
-                            alt=""
-                            className="object-cover"
-                            fill
-                          />
+                          {imageURL(item.cover, "large") && (
+                            <Image
+                              src={imageURL(item.cover, "large")!}
+                              alt=""
+                              className="object-cover"
+                              fill
+                            />
+                          )}
                         </AspectRatio>
                       </div>
                     </DialogHeader>
@@ -159,7 +164,7 @@ export function NoteCard({ data }: { data: NoteData }) {
                       <div className="justify-between flex items-center w-full">
                         <div className="flex text-center items-center space-x-4">
                           <Avatar className="h-12 w-12">
-                            <AvatarImage src={item.author.avatar_url} />
+                            <AvatarImage src={imageURL(item.author.avatar, "thumbnail")} />
                             <AvatarFallback>
                               {item.author.name.slice(0, 2).toUpperCase()}
                             </AvatarFallback>
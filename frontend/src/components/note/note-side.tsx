@@ -1,5 +1,6 @@
 import { GetNotes, GetNotesByID } from "@/actions/note";
 import { Avatar, AvatarFallback, AvatarImage } from "@/components/ui/avatar";
+import { imageURL } from "@/lib/utils";
 import { AspectRatio } from "@/components/ui/aspect-ratio";
 import Image from "next/image";
 import { Separator } from "../ui/separator";
@@ -16,19 +17,21 @@ const NoteSide = async ({ id }: { id: string }) => {
         </h2>
         <p className="leading-7 pb-3">{note.description}</p>
         <AspectRatio ratio={16 / 9}>
-          <Image
-            src={note.cover_url}
-            alt="Image"
-            className="rounded-md object-cover"
-            fill
-          />
+          {imageURL(note.cover, "medium") && (
+            <Image
+              src={imageURL(note.cover, "medium")!}
+              alt="Image"
+              className="rounded-md object-cover"
+              fill
+            />
+          )}
         </AspectRatio>
       </div>
 
       <div>
         <div className="flex text-center items-center space-x-4">
           <Avatar className="h-10 w-10">
-            <AvatarImage src={note.author.avatar_url} />
+            <AvatarImage src={imageURL(note.author.avatar, "thumbnail")} />
             <AvatarFallback>
               {note.author.name.slice(0, 2).toUpperCase()}
             </AvatarFallback>
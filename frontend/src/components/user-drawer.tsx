@@ -120,7 +120,7 @@ const UserUpdateDrawer = ({ user }: { user: User }) => {
 function ProfileForm({ className, user }: { className?: string; user: User }) {
   const form = useForm<UserRequest>({
     resolver: zodResolver(userRequestSchema),
-    defaultValues: { bio: user.bio, avatar_url: user.avatar_url },
+    defaultValues: { bio: user.bio, avatar_url: user.avatar?.original },
   });
 
   const [{ data, loading, error }, execute] = useAxios(
@@ -0,0 +1,13 @@
+import { z } from "zod";
+
+// the variants are only set once an upload is processed, images from
+// elsewhere only have the original
+export const imageSchema = z.object({
+  original: z.string().url().or(z.literal("")),
+  large: z.string().url().optional(),
+  medium: z.string().url().optional(),
+  small: z.string().url().optional(),
+  thumbnail: z.string().url().optional(),
+});
+
+export type Image = z.infer<typeof imageSchema>;
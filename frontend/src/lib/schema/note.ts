@@ -1,25 +1,18 @@
 import { z } from "zod";
+import { imageSchema } from "./image";
 import { metadataSchema } from "./metadata";
 
 export const noteSchema = z.object({
   id: z.number(),
   title: z.string().max(30),
   description: z.string().max(100),
-  cover_url: z
-    .string()
-    .url()
-    .endsWith(".jpg" || ".png"),
+  cover: imageSchema,
   content: z.string(),
-  visibility: z.enum(["public", "private"]),
+  visibility: z.enum(["public", "private", "unlisted"]),
   author: z.object({
     id: z.number(),
     name: z.string(),
-    avatar_url: z
-      .string()
-      .url()
-      .endsWith(".jpg" || ".png")
-      .optional()
-      .or(z.literal("")),
+    avatar: imageSchema.optional(),
   }),
   created_at: z.string().datetime(),
   updated_at: z.string().datetime(),
@@ -30,7 +23,7 @@ export const noteCreateSchema = z.object({
   description: z.string().min(1).max(50),
   cover_url: z.string().url().endsWith(".jpg").or(z.string().endsWith(".png")),
   content: z.string().min(1),
-  visibility: z.enum(["public", "private"]),
+  visibility: z.enum(["public", "private", "unlisted"]),
 });
 
 export const noteUpdateSchema = z.object({
@@ -42,12 +35,12 @@ export const noteUpdateSchema = z.object({
     .endsWith(".jpg" || ".png")
     .optional(),
   content: z.string().min(1).optional(),
-  visibility: z.enum(["public", "private"]).optional(),
+  visibility: z.enum(["public", "private", "unlisted"]).optional(),
 });
 
 export const noteQuerySchema = z.object({
   title: z.string().optional(),
-  visibility: z.enum(["public", "private"]).optional(),
+  visibility: z.enum(["public", "private", "unlisted"]).optional(),
   user_id: z.number().optional(),
   search: z.string().optional(),
   author: z.string().optional(),
@@ -1,4 +1,5 @@
 import { z } from "zod";
+import { imageSchema } from "./image";
 import { metadataSchema } from "./metadata";
 
 export const userSchema = z.object({
@@ -7,12 +8,7 @@ export const userSchema = z.object({
   email: z.string(),
   password: z.string(),
   bio: z.string().optional(),
-  avatar_url: z
-    .string()
-    .url()
-    .endsWith(".jpg" || ".png")
-    .optional()
-    .or(z.literal("")),
+  avatar: imageSchema.optional(),
   created_at: z.string().datetime(),
   updated_at: z.string().datetime(),
   tokens: z
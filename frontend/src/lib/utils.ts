@@ -1,6 +1,16 @@
 import { type ClassValue, clsx } from "clsx"
 import { twMerge } from "tailwind-merge"
+import type { Image } from "./schema/image"
 
 export function cn(...inputs: ClassValue[]) {
   return twMerge(clsx(inputs))
 }
+
+// imageURL picks a variant of an image, falling back to the original for
+// images that have none
+export function imageURL(
+  image: Image | undefined,
+  size: "large" | "medium" | "small" | "thumbnail"
+) {
+  return image?.[size] || image?.original || undefined
+}